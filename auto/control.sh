@@ -4,7 +4,6 @@ Pwd=`pwd`
 Usage="sh ./control.sh {stop|start|restart|forcestop}"
 Self="control.sh"
 AppName="xuperos"
-ClientName="xchain-cli"
 
 # 默认启动环境
 LogDir="$Pwd/logs"
@@ -13,7 +12,6 @@ TmpDir="$Pwd/tmp"
 AppPidFile="$TmpDir/$AppName.pid"
 ConfDir="$Pwd/conf"
 AppConf="env.yaml"
-RootChainDir="$Pwd/data/blockchain/xuper"
 
 # check param
 [ -f "$ConfDir/$AppConf" ] || { echo "env.yaml not exist!"; exit 1; }
@@ -30,10 +28,8 @@ fi
 
 # file check
 BinPath="$Pwd/bin/$AppName"
-ClientPath="$Pwd/bin/$ClientName"
 ConfPath="$ConfDir/$AppConf"
 [ -f "$BinPath" ] || { echo "app bin not exist!"; exit; }
-[ -f "$ClientPath" ] || { echo "client bin not exist!"; exit; }
 [ -f "$ConfPath" ] || { echo "config not exist!"; exit; }
 echo $BinPath
 echo $ConfPath
@@ -47,44 +43,32 @@ start() {
         exit 1
     fi
 
-    if [ ! -d "$RootChainDir" ]; then
-        $ClientPath createChain
-        if [ $? -ne 0 ]; then
-            echo "create root chain failed!"
-            exit 1
-        fi
-    fi
-
     if [ ! -d "$LogDir" ];then
         mkdir "$LogDir"
     fi
 
-    if [ ! -d "$TmpDir" ];then
-        mkdir "$TmpDir"
-    fi
-
-    cmd="nohup $BinPath startup --conf $ConfPath >$LogDir/nohup.out 2>&1 &"
+    # 根链不存在时由startup使用data/genesis下的创世配置创建，pid文件由startup写入
+    cmd="nohup $BinPath startup --conf $ConfPath --pidfile $AppPidFile >$LogDir/nohup.out 2>&1 &"
     echo "start $AppName. cmd:$cmd"
 
-    nohup $BinPath startup --conf $ConfPath >"$LogDir/nohup.out" 2>&1 &
+    nohup $BinPath startup --conf $ConfPath --pidfile "$AppPidFile" >"$LogDir/nohup.out" 2>&1 &
     
     # 检查确保经常启动运行
     waitRun
     if [ "$?" != "0" ]; then
         echo "start timeout,force stop app."
-        forceStop
+        forcestop
         echo "start fail."
         exit 1
     fi
 
     pid=$(getpid)
-    echo "$pid" > "$AppPidFile"
     echo "start finish.pid:$pid"
 }
 
 forcestop() {
     echo "force stop $AppName."
-    killProc -9
+    $BinPath stop --pidfile "$AppPidFile" --force
     if [ "$?" != "0" ]; then
         echo "force stop failed"
         exit 1
@@ -95,7 +79,7 @@ forcestop() {
 
 stop() {
     echo "stop $AppName."
-    killProc -15
+    $BinPath stop --pidfile "$AppPidFile"
     if [ "$?" != "0" ]; then
         echo "stop failed"
         exit 1
@@ -104,28 +88,6 @@ stop() {
     echo "stop succ"
 }
 
-killProc() {
-    signal=$1
-
-    pid=$(getpid)
-    if [ "$pid" != "" ]; then
-        echo "$BinPath"
-        echo "kill $signal $pid"
-        kill "$signal" "$pid"
-
-        # 等待进程退出
-        waitExit "$pid" "$BinPath"
-        if [ "$?" != "0" ]; then
-            echo "proc stop timeout,exit.pid:$pid bin:$BinPath"
-            exit 1
-        fi
-    fi
-
-    if [ -f "$AppPidFile" ];then
-        rm "$AppPidFile"
-    fi
-}
-
 procIsRun() {
     pid1=$(getpid)
     if [ "$pid1" == "" ]; then
@@ -168,34 +130,6 @@ waitRun() {
     return 1
 }
 
-waitExit() {
-    pid=$1
-    bin=$2
-    if [ "$pid" == "" ]; then
-        echo "pid is empty!"
-        return 1
-    fi
-
-    if [ "$bin" == "" ]; then
-        echo "bin name is empty!"
-        return 1
-    fi
-
-    for((i=0;i<60;i++));
-    do
-        echo -n "."
-        p=`ps -ef | grep "$pid" | grep "$bin" | grep -v grep | awk -F' ' '{print $2}'`
-        if [ "$p" == "" ]; then
-            echo "exit finish!"
-            return 0
-        fi
-        sleep 1s
-    done
-
-    echo "exit timeout!"
-    return 1
-}
-
 case "$1" in
     start)
         start
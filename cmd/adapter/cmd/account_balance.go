@@ -6,6 +6,7 @@ package cmd
 
 import (
 	"context"
+	"encoding/hex"
 	"errors"
 	"fmt"
 
@@ -16,9 +17,11 @@ import (
 
 // AccountBalanceCommand account balance command
 type AccountBalanceCommand struct {
-	cli    *Cli
-	cmd    *cobra.Command
-	frozen bool
+	cli     *Cli
+	cmd     *cobra.Command
+	frozen  bool
+	height  int64
+	blockid string
 }
 
 // NewAccountBalanceCommand new function
@@ -53,15 +56,24 @@ func NewAccountBalanceCommand(cli *Cli) *cobra.Command {
 
 func (b *AccountBalanceCommand) addFlags() {
 	b.cmd.Flags().BoolVarP(&b.frozen, "frozen", "Z", false, "Get frozen balance.")
+	b.cmd.Flags().Int64Var(&b.height, "height", 0, "Get balance at the given block height, current state if unset.")
+	b.cmd.Flags().StringVar(&b.blockid, "blockid", "", "Get balance at the given block id, take precedence over height.")
 }
 
 func (b *AccountBalanceCommand) queryBalance(ctx context.Context, account string) error {
 	client := b.cli.XchainClient()
+	blockid, err := hex.DecodeString(b.blockid)
+	if err != nil {
+		return fmt.Errorf("invalid blockid:%v", err)
+	}
 	addrstatus := &pb.AddressStatus{
 		Address: account,
 		Bcs: []*pb.TokenDetail{
 			{Bcname: b.cli.RootOptions.Name},
 		},
+		Height:    b.height,
+		Blockid:   blockid,
+		HasHeight: b.cmd.Flags().Changed("height"),
 	}
 
 	fGetBalance := client.GetBalance
@@ -6,6 +6,7 @@ package cmd
 
 import (
 	"context"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -22,6 +23,8 @@ type ACLQueryCommand struct {
 	accountName  string
 	contractName string
 	methodName   string
+	height       int64
+	blockid      string
 }
 
 // NewACLQueryCommand new acl query cmd
@@ -45,10 +48,16 @@ func (t *ACLQueryCommand) addFlags() {
 	t.cmd.Flags().StringVar(&t.accountName, "account", "", "contract account name")
 	t.cmd.Flags().StringVar(&t.contractName, "contract", "", "contract name")
 	t.cmd.Flags().StringVar(&t.methodName, "method", "", "method name")
+	t.cmd.Flags().Int64Var(&t.height, "height", 0, "query acl at the given block height, current state if unset")
+	t.cmd.Flags().StringVar(&t.blockid, "blockid", "", "query acl at the given block id, take precedence over height")
 }
 
 func (t *ACLQueryCommand) queryACL(ctx context.Context) error {
 	client := t.cli.XchainClient()
+	blockid, err := hex.DecodeString(t.blockid)
+	if err != nil {
+		return fmt.Errorf("invalid blockid:%v", err)
+	}
 	aclStatus := &pb.AclStatus{
 		Header: &pb.Header{
 			Logid: utils.GenLogId(),
@@ -57,6 +66,9 @@ func (t *ACLQueryCommand) queryACL(ctx context.Context) error {
 		AccountName:  t.accountName,
 		ContractName: t.contractName,
 		MethodName:   t.methodName,
+		Height:       t.height,
+		Blockid:      blockid,
+		HasHeight:    t.cmd.Flags().Changed("height"),
 	}
 	if len(t.accountName) == 0 && len(t.contractName) == 0 {
 		return errors.New("param error")
@@ -0,0 +1,110 @@
+/*
+ * Copyright (c) 2021, Baidu.com, Inc. All Rights Reserved.
+ */
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"github.com/xuperchain/xupercore/lib/utils"
+	"github.com/xuperchain/xuperos/common/xupospb"
+)
+
+// ChainCommand 通过节点管理服务管理平行链
+type ChainCommand struct {
+	cli       *Cli
+	adminHost string
+}
+
+// NewChainCommand new chain cmd
+func NewChainCommand(cli *Cli) *cobra.Command {
+	c := &ChainCommand{cli: cli}
+	cmd := &cobra.Command{
+		Use:   "chain",
+		Short: "Manage parallel chains of local node, list|load|stop|unload",
+	}
+	cmd.PersistentFlags().StringVar(&c.adminHost, "admin", cli.CliConf.AdminHost,
+		"node admin service ip:port or unix:///path/to/socket")
+
+	cmd.AddCommand(NewChainListCommand(c))
+	cmd.AddCommand(c.newManageCommand("load", "Load a newly created chain into running node"))
+	cmd.AddCommand(c.newManageCommand("stop", "Stop syncing and mining of a chain, the chain can still be queried"))
+	cmd.AddCommand(c.newManageCommand("unload", "Stop a chain and remove it from running node"))
+	return cmd
+}
+
+// 创建节点管理服务客户端，调用方负责关闭连接
+func (c *ChainCommand) adminClient() (xupospb.XuperAdminClient, *grpc.ClientConn, error) {
+	conn, err := grpc.Dial(c.adminHost, grpc.WithInsecure())
+	if err != nil {
+		return nil, nil, err
+	}
+	return xupospb.NewXuperAdminClient(conn), conn, nil
+}
+
+func (c *ChainCommand) reqHeader() *xupospb.ReqHeader {
+	return &xupospb.ReqHeader{
+		LogId:    utils.GenLogId(),
+		SelfName: "xchain-cli",
+	}
+}
+
+func (c *ChainCommand) newManageCommand(op, short string) *cobra.Command {
+	return &cobra.Command{
+		Use:     op + " <bcname>",
+		Short:   short,
+		Example: "chain " + op + " hello",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.manageChain(context.TODO(), op, args[0])
+		},
+	}
+}
+
+func (c *ChainCommand) manageChain(ctx context.Context, op, bcName string) error {
+	client, conn, err := c.adminClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	req := &xupospb.ChainReq{
+		Header: c.reqHeader(),
+		BcName: bcName,
+	}
+	var reply *xupospb.BaseResp
+	switch op {
+	case "load":
+		reply, err = client.LoadChain(ctx, req)
+	case "stop":
+		reply, err = client.StopChain(ctx, req)
+	case "unload":
+		reply, err = client.UnloadChain(ctx, req)
+	}
+	if err != nil {
+		return err
+	}
+	if err := adminRespError(reply.GetHeader()); err != nil {
+		return err
+	}
+
+	fmt.Printf("%s chain %s succeed\n", op, bcName)
+	return nil
+}
+
+// 管理服务通过header返回错误
+func adminRespError(header *xupospb.RespHeader) error {
+	if header.GetErrCode() != 0 {
+		return fmt.Errorf("%s, logid:%s", header.GetErrMsg(), header.GetLogId())
+	}
+	return nil
+}
+
+func init() {
+	AddCommand(NewChainCommand)
+}
@@ -0,0 +1,77 @@
+/*
+ * Copyright (c) 2021, Baidu.com, Inc. All Rights Reserved.
+ */
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/xuperchain/xuperos/common/xupospb"
+)
+
+// ChainListCommand list chains of local node
+type ChainListCommand struct {
+	chain *ChainCommand
+	cmd   *cobra.Command
+}
+
+// NewChainListCommand new chain list cmd
+func NewChainListCommand(chain *ChainCommand) *cobra.Command {
+	c := &ChainListCommand{chain: chain}
+	c.cmd = &cobra.Command{
+		Use:     "list",
+		Short:   "List chains in node data dir and their status",
+		Example: "chain list",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.list(context.TODO())
+		},
+	}
+	return c.cmd
+}
+
+func (c *ChainListCommand) list(ctx context.Context) error {
+	client, conn, err := c.chain.adminClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	reply, err := client.ListChains(ctx, &xupospb.BaseReq{Header: c.chain.reqHeader()})
+	if err != nil {
+		return err
+	}
+	if err := adminRespError(reply.GetHeader()); err != nil {
+		return err
+	}
+
+	type ChainStatus struct {
+		Name        string `json:"name"`
+		State       string `json:"state"`
+		IsRoot      bool   `json:"isRoot"`
+		TrunkHeight int64  `json:"trunkHeight"`
+		TipBlockid  HexID  `json:"tipBlockid"`
+	}
+	chains := make([]ChainStatus, 0, len(reply.GetChains()))
+	for _, chain := range reply.GetChains() {
+		chains = append(chains, ChainStatus{
+			Name:        chain.GetBcName(),
+			State:       strings.ToLower(strings.TrimPrefix(chain.GetState().String(), "CHAIN_")),
+			IsRoot:      chain.GetIsRoot(),
+			TrunkHeight: chain.GetTrunkHeight(),
+			TipBlockid:  chain.GetTipBlockid(),
+		})
+	}
+
+	output, err := json.MarshalIndent(chains, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(output))
+	return nil
+}
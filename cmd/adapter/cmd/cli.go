@@ -100,7 +100,7 @@ func (c *Cli) initFlags() error {
 	c.CliConf = cliCfg
 
 	// 设置命令行参数和默认值
-	rootFlag.StringP("host", "H", c.CliConf.Host, "server node ip:port")
+	rootFlag.StringP("host", "H", c.CliConf.Host, "server node ip:port or unix:///path/to/socket")
 	rootFlag.String("name", c.CliConf.Name, "block chain name")
 	rootFlag.String("keys", c.CliConf.Keys, "directory of keys")
 	rootFlag.String("crypto", c.CliConf.Crypto, "crypto type")
@@ -341,6 +341,16 @@ func assembleTxSupportAccount(ctx context.Context, client pb.XchainClient, opt *
 		FrozenHeight: opt.FrozenHeight,
 	}
 	accounts := []*pb.TxDataAccount{account}
+	if opt.Fee == FeeAuto {
+		authRequire, err := genAuthRequire(opt.From, opt.AccountPath)
+		if err != nil {
+			return nil, err
+		}
+		opt.Fee, err = estimateFee(ctx, client, opt.BlockchainName, nil, initAddr, authRequire, opt.Amount)
+		if err != nil {
+			return nil, err
+		}
+	}
 	if opt.Fee != "" && opt.Fee != "0" {
 		accounts = append(accounts, newFeeAccount(opt.Fee))
 	}
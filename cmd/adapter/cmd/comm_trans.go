@@ -66,6 +66,8 @@ type CommTrans struct {
 	// DebugTx if enabled, tx will be printed instead of being posted
 	DebugTx bool
 	CliConf *CliConfig
+	// WaitOpt if set, wait tx confirmation after posting
+	WaitOpt *WaitTxOptions
 }
 
 // GenerateTx generate raw tx
@@ -141,6 +143,9 @@ func (c *CommTrans) GenPreExeRes(ctx context.Context) (
 			return nil, nil, fmt.Errorf("Get auth require error: %s", err.Error())
 		}
 	}
+	if err := c.resolveAutoFee(ctx, preExeRPCReq); err != nil {
+		return nil, nil, err
+	}
 	preExeRPCRes, err := c.XchainClient.PreExec(ctx, preExeRPCReq)
 	if err != nil {
 		return nil, nil, fmt.Errorf("PreExe contract response : %v, logid:%s", err, preExeRPCReq.Header.Logid)
@@ -478,7 +483,7 @@ func (c *CommTrans) SendTx(ctx context.Context, tx *pb.Transaction) error {
 	}
 	fmt.Printf("Tx id: %s\n", txid)
 
-	return nil
+	return waitTx(ctx, c.XchainClient, c.ChainName, txid, c.WaitOpt)
 }
 
 func (c *CommTrans) genInitSign(tx *pb.Transaction) ([]*pb.SignatureInfo, error) {
@@ -788,6 +793,9 @@ func (c *CommTrans) GenPreExeWithSelectUtxoRes(ctx context.Context) (
 			return nil, fmt.Errorf("Get auth require error: %s", err.Error())
 		}
 	}
+	if err := c.resolveAutoFee(ctx, preExeRPCReq); err != nil {
+		return nil, err
+	}
 	extraAmount := int64(c.CliConf.ComplianceCheck.ComplianceCheckEndorseServiceFee)
 	if c.Fee != "" && c.Fee != "0" {
 		fee, err := strconv.ParseInt(c.Fee, 10, 64)
@@ -855,7 +863,7 @@ func (c *CommTrans) GenCompleteTxAndPost(ctx context.Context, preExeResp *pb.Pre
 	}
 	fmt.Printf("Tx id: %s\n", txid)
 
-	return nil
+	return waitTx(ctx, c.XchainClient, c.ChainName, txid, c.WaitOpt)
 }
 
 func (c *CommTrans) GenRealTx(response *pb.PreExecWithSelectUTXOResponse,
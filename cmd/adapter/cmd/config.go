@@ -17,6 +17,8 @@ type CliConfig struct {
 	EndorseServiceHost string                `yaml:"endorseServiceHost,omitempty"`
 	ComplianceCheck    ComplianceCheckConfig `yaml:"complianceCheck,omitempty"`
 	MinNewChainAmount  string                `yaml:"minNewChainAmount,omitempty"`
+	// 节点管理服务地址，只能访问本机节点
+	AdminHost string `yaml:"adminHost,omitempty"`
 }
 
 // TLSOptions TLS part
@@ -91,4 +93,5 @@ func (nc *CliConfig) setDefaultConf() {
 		ComplianceCheckEndorseServiceAddr: "jknGxa6eyum1JrATWvSJKW3thJ9GKHA9n",
 	}
 	nc.MinNewChainAmount = "100"
+	nc.AdminHost = "127.0.0.1:36901"
 }
@@ -58,7 +58,7 @@ func (c *ContractDeployCommand) addFlags() {
 	c.cmd.Flags().StringVarP(&c.contractName, "cname", "n", "", "contract name")
 	c.cmd.Flags().StringVarP(&c.account, "account", "", "", "account name")
 	c.cmd.Flags().StringVarP(&c.runtime, "runtime", "", "c", "if contract code use go lang, then go or if use c lang, then c")
-	c.cmd.Flags().StringVar(&c.fee, "fee", "", "fee of one tx")
+	c.cmd.Flags().StringVar(&c.fee, "fee", FeeAuto, "fee of one tx, auto means estimated by node")
 	c.cmd.Flags().BoolVarP(&c.isMulti, "isMulti", "m", false, "multisig scene")
 	c.cmd.Flags().StringVarP(&c.multiAddrs, "multiAddrs", "A", "data/acl/addrs", "multiAddrs if multisig scene")
 	c.cmd.Flags().StringVarP(&c.output, "output", "o", "./tx.out", "tx draw data")
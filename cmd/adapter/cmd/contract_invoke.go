@@ -34,6 +34,7 @@ type ContractInvokeCommand struct {
 	amount     string
 	debug      bool
 	abiFile    string
+	wait       WaitTxOptions
 }
 
 // NewContractInvokeCommand new wasm/native/evm invoke cmd
@@ -58,13 +59,14 @@ func NewContractInvokeCommand(cli *Cli, module string) *cobra.Command {
 func (c *ContractInvokeCommand) addFlags() {
 	c.cmd.Flags().StringVarP(&c.args, "args", "a", "{}", "contract method args")
 	c.cmd.Flags().StringVarP(&c.account, "account", "", "", "account name")
-	c.cmd.Flags().StringVar(&c.fee, "fee", "", "fee of one tx")
+	c.cmd.Flags().StringVar(&c.fee, "fee", FeeAuto, "fee of one tx, auto means estimated by node")
 	c.cmd.Flags().BoolVarP(&c.isMulti, "isMulti", "m", false, "multisig scene")
 	c.cmd.Flags().StringVarP(&c.multiAddrs, "multiAddrs", "A", "data/acl/addrs", "multiAddrs if multisig scene")
 	c.cmd.Flags().StringVarP(&c.output, "output", "o", "./tx.out", "tx draw data")
 	c.cmd.Flags().StringVarP(&c.methodName, "method", "", "invoke", "contract method name")
 	c.cmd.Flags().StringVarP(&c.amount, "amount", "", "", "the amount transfer to contract")
 	c.cmd.Flags().BoolVarP(&c.debug, "debug", "", false, "debug print tx instead of posting")
+	c.wait.AddFlags(c.cmd.Flags())
 	if c.module == string(bridge.TypeEvm) {
 		c.cmd.Flags().StringVarP(&c.abiFile, "abi", "", "", "the abi file of contract")
 	}
@@ -95,6 +97,7 @@ func (c *ContractInvokeCommand) invoke(ctx context.Context, codeName string) err
 		CryptoType:   c.cli.RootOptions.Crypto,
 		DebugTx:      c.debug,
 		CliConf:      c.cli.CliConf,
+		WaitOpt:      &c.wait,
 	}
 	// transfer to contract
 	if c.amount != "" {
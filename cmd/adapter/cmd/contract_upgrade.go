@@ -46,7 +46,7 @@ func NewContractUpgradeCommand(cli *Cli) *cobra.Command {
 func (c *ContractUpgradeCommand) addFlags() {
 	c.cmd.Flags().StringVarP(&c.contractName, "cname", "n", "", "contract name")
 	c.cmd.Flags().StringVarP(&c.account, "account", "", "", "account name")
-	c.cmd.Flags().StringVar(&c.fee, "fee", "", "fee of one tx")
+	c.cmd.Flags().StringVar(&c.fee, "fee", FeeAuto, "fee of one tx, auto means estimated by node")
 	c.cmd.Flags().BoolVarP(&c.isMulti, "isMulti", "m", false, "multisig scene")
 	c.cmd.Flags().StringVarP(&c.multiAddrs, "multiAddrs", "A", "data/acl/addrs", "multiAddrs if multisig scene")
 	c.cmd.Flags().StringVarP(&c.output, "output", "o", "./tx.out", "tx draw data")
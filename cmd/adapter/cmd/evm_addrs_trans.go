@@ -8,8 +8,10 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hyperledger/burrow/crypto"
 	"github.com/spf13/cobra"
-	//"github.com/hyperledger/burrow/crypto"
+
+	"github.com/xuperchain/xuperos/common/evm"
 )
 
 // EVMAddrTransCommand address translation between EVM and xchain
@@ -48,22 +50,20 @@ func (c *EVMAddrTransCommand) trans(ctx context.Context) error {
 		return fmt.Errorf("wrong transType, must be x2e or e2x")
 	}
 
-	//todo evm
-	/*var addr, addrType string
-	var err error
+	var addr, addrType string
 	switch c.transType {
 	case "x2e":
-		addr, addrType, err = evm.DetermineXchainAddress(c.from)
+		evmAddr, typ, err := evm.DetermineXchainAddress(c.from)
 		if err != nil {
 			return err
 		}
-
+		addr, addrType = evmAddr.String(), typ
 	case "e2x":
 		evmAddr, err := crypto.AddressFromHexString(c.from)
 		if err != nil {
 			return err
 		}
-		addr, addrType, err = evm.DetermineEVMAddress(evmAddr)
+		addr, addrType, err = evm.DetermineEVMAddress(evmAddr, c.cli.RootOptions.Name)
 		if err != nil {
 			return err
 		}
@@ -71,7 +71,6 @@ func (c *EVMAddrTransCommand) trans(ctx context.Context) error {
 		return fmt.Errorf("wrong transType, must be x2e or e2x")
 	}
 
-	fmt.Printf("result, %s\t%s\n", addr, addrType)*/
-
+	fmt.Printf("result, %s\t%s\n", addr, addrType)
 	return nil
 }
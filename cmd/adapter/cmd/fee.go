@@ -0,0 +1,66 @@
+/*
+ * Copyright (c) 2021. Baidu Inc. All Rights Reserved.
+ */
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/xuperchain/xupercore/lib/utils"
+	"github.com/xuperchain/xuperos/common/xupospb/pb"
+)
+
+// FeeAuto estimate fee by EstimateFee rpc when --fee is auto
+const FeeAuto = "auto"
+
+// estimateFee estimate fee of invoke requests and return it as decimal string
+func estimateFee(ctx context.Context, client pb.XchainClient, bcname string, reqs []*pb.InvokeRequest,
+	initiator string, authRequire []string, amount string) (string, error) {
+	req := &pb.EstimateFeeRequest{
+		Header: &pb.Header{
+			Logid: utils.GenLogId(),
+		},
+		Bcname:      bcname,
+		Requests:    reqs,
+		Initiator:   initiator,
+		AuthRequire: authRequire,
+		Amount:      amount,
+	}
+	reply, err := client.EstimateFee(ctx, req)
+	if err != nil {
+		return "", fmt.Errorf("estimate fee error: %v", err)
+	}
+	if reply.Header.Error != pb.XChainErrorEnum_SUCCESS {
+		return "", errors.New(reply.Header.Error.String())
+	}
+
+	fmt.Printf("The estimated fee is: %d, utxo count: %d\n", reply.GetFee(), reply.GetUtxoCount())
+	if !reply.GetUtxoEnough() {
+		fmt.Printf("Warning: balance of %s may be not enough, need %s\n", initiator, reply.GetTotalNeed())
+	}
+	return strconv.FormatInt(reply.GetFee(), 10), nil
+}
+
+// resolveAutoFee replace auto fee with estimated fee before generating tx
+func (c *CommTrans) resolveAutoFee(ctx context.Context, preExeRPCReq *pb.InvokeRPCRequest) error {
+	if c.Fee != FeeAuto {
+		return nil
+	}
+
+	// 转给合约的金额已在合约请求中
+	amount := "0"
+	if c.To != "" && c.To != c.ContractName {
+		amount = c.Amount
+	}
+	fee, err := estimateFee(ctx, c.XchainClient, c.ChainName, preExeRPCReq.GetRequests(),
+		preExeRPCReq.GetInitiator(), preExeRPCReq.GetAuthRequire(), amount)
+	if err != nil {
+		return err
+	}
+	c.Fee = fee
+	return nil
+}
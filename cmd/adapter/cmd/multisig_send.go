@@ -29,6 +29,7 @@ type MultisigSendCommand struct {
 
 	tx       string
 	signType string
+	wait     WaitTxOptions
 }
 
 // NewMultisigSendCommand multisig gen init method
@@ -66,6 +67,7 @@ If signtype is "multi":
 func (c *MultisigSendCommand) addFlags() {
 	c.cmd.Flags().StringVar(&c.tx, "tx", "./tx.out", "Serialized transaction data file")
 	c.cmd.Flags().StringVar(&c.signType, "signtype", "", "type of signature, support multi/ring")
+	c.wait.AddFlags(c.cmd.Flags())
 }
 
 // send 命令的主入口
@@ -103,7 +105,7 @@ func (c *MultisigSendCommand) send(ctx context.Context, initPath string, authPat
 	}
 	fmt.Printf("Tx id: %s\n", txid)
 
-	return nil
+	return waitTx(ctx, c.cli.XchainClient(), c.cli.RootOptions.Name, txid, &c.wait)
 }
 
 // sendXuper process XuperSign
@@ -177,7 +179,7 @@ func (c *MultisigSendCommand) sendXuper(ctx context.Context, signs string) error
 	}
 	fmt.Printf("Tx id: %s\n", txid)
 
-	return nil
+	return waitTx(ctx, c.cli.XchainClient(), c.cli.RootOptions.Name, txid, &c.wait)
 }
 
 // getSigns 读文件，填充pb.SignatureInfo
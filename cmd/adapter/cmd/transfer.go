@@ -61,6 +61,7 @@ type TransferCommand struct {
 	from        string
 	accountPath string
 	debug 		bool
+	wait        WaitTxOptions
 }
 
 // NewTransferCommand new transfer cmd
@@ -83,12 +84,13 @@ func (t *TransferCommand) addFlags() {
 	t.cmd.Flags().StringVar(&t.to, "to", "", "common transfer transaction to whom")
 	t.cmd.Flags().StringVar(&t.amount, "amount", "0", "transfer tokens")
 	t.cmd.Flags().StringVar(&t.descfile, "desc", "", "desc file of tx, eg. contract or tdpos consensus")
-	t.cmd.Flags().StringVar(&t.fee, "fee", "0", "fee of one tx")
+	t.cmd.Flags().StringVar(&t.fee, "fee", FeeAuto, "fee of one tx, auto means estimated by node")
 	t.cmd.Flags().Int64Var(&t.frozenHeight, "frozen", 0, "frozen height of one tx")
 	t.cmd.Flags().Int32Var(&t.version, "txversion", utxo.TxVersion, "tx version")
 	t.cmd.Flags().StringVar(&t.from, "from", "", "account name")
 	t.cmd.Flags().StringVar(&t.accountPath, "accountPath", "", "key path of account")
 	t.cmd.Flags().BoolVar(&t.debug, "debug", false, "debug print tx instead of posting")
+	t.wait.AddFlags(t.cmd.Flags())
 }
 
 func readKeys(file string) (string, error) {
@@ -157,7 +159,10 @@ func (t *TransferCommand) transfer(ctx context.Context) error {
 		return err
 	}
 	fmt.Printf("%s\n", txid)
-	return nil
+	if t.debug {
+		return nil
+	}
+	return waitTx(ctx, t.cli.XchainClient(), t.cli.RootOptions.Name, txid, &t.wait)
 }
 
 func init() {
@@ -0,0 +1,170 @@
+/*
+ * Copyright (c) 2021. Baidu Inc. All Rights Reserved.
+ */
+
+package cmd
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/xuperchain/xupercore/lib/utils"
+	"github.com/xuperchain/xuperos/common/xupospb/pb"
+)
+
+// TxPoolCommand tx pool cmd
+type TxPoolCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+
+	txids     bool
+	initiator string
+	cursor    string
+	limit     int32
+}
+
+// NewTxPoolCommand new tx pool cmd
+func NewTxPoolCommand(cli *Cli) *cobra.Command {
+	t := new(TxPoolCommand)
+	t.cli = cli
+	t.cmd = &cobra.Command{
+		Use:   "pool",
+		Short: "Inspect unconfirmed tx pool: summary by default, list txids or pending txs by flags",
+		Example: "tx pool\n  tx pool --txids --limit 50\n" +
+			"  tx pool --initiator TeyyPLpp9L7QAcxHangtcHTu7HUZ6iydY",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.TODO()
+			if t.initiator != "" {
+				return t.listTxs(ctx)
+			}
+			if t.txids {
+				return t.listTxids(ctx)
+			}
+			return t.printStatus(ctx)
+		},
+	}
+	t.addFlags()
+	return t.cmd
+}
+
+func (t *TxPoolCommand) addFlags() {
+	t.cmd.Flags().BoolVar(&t.txids, "txids", false, "list unconfirmed txids ordered by timestamp")
+	t.cmd.Flags().StringVar(&t.initiator, "initiator", "", "list unconfirmed txs of the initiator")
+	t.cmd.Flags().StringVar(&t.cursor, "cursor", "", "page cursor returned by last query")
+	t.cmd.Flags().Int32Var(&t.limit, "limit", 20, "page size, max 100")
+}
+
+func (t *TxPoolCommand) printStatus(ctx context.Context) error {
+	client := t.cli.XchainClient()
+	req := &pb.TxPoolStatusRequest{
+		Header: &pb.Header{
+			Logid: utils.GenLogId(),
+		},
+		Bcname: t.cli.RootOptions.Name,
+	}
+	reply, err := client.GetTxPoolStatus(ctx, req)
+	if err != nil {
+		return err
+	}
+	if reply.Header.Error != pb.XChainErrorEnum_SUCCESS {
+		return errors.New(reply.Header.Error.String())
+	}
+
+	type Conflict struct {
+		RefTxid   HexID   `json:"refTxid"`
+		RefOffset int32   `json:"refOffset"`
+		Txids     []HexID `json:"txids"`
+	}
+	type PoolStatus struct {
+		TxCount         int64                     `json:"txCount"`
+		OldestTimestamp int64                     `json:"oldestTimestamp"`
+		NewestTimestamp int64                     `json:"newestTimestamp"`
+		MaxAgeSeconds   int64                     `json:"maxAgeSeconds"`
+		TopInitiators   []*pb.TxPoolInitiatorStat `json:"topInitiators"`
+		Conflicts       []Conflict                `json:"conflicts"`
+	}
+	status := PoolStatus{
+		TxCount:         reply.GetTxCount(),
+		OldestTimestamp: reply.GetOldestTimestamp(),
+		NewestTimestamp: reply.GetNewestTimestamp(),
+		MaxAgeSeconds:   reply.GetMaxAgeSeconds(),
+		TopInitiators:   reply.GetTopInitiators(),
+		Conflicts:       []Conflict{},
+	}
+	for _, conflict := range reply.GetConflicts() {
+		c := Conflict{RefTxid: conflict.GetRefTxid(), RefOffset: conflict.GetRefOffset()}
+		for _, txid := range conflict.GetTxids() {
+			c.Txids = append(c.Txids, txid)
+		}
+		status.Conflicts = append(status.Conflicts, c)
+	}
+
+	output, err := json.MarshalIndent(status, "", "  ")
+	if err != nil {
+		fmt.Println(err)
+	}
+	fmt.Println(string(output))
+	return nil
+}
+
+func (t *TxPoolCommand) listTxids(ctx context.Context) error {
+	client := t.cli.XchainClient()
+	reply, err := client.GetUnconfirmedTxids(ctx, t.newRequest())
+	if err != nil {
+		return err
+	}
+	if reply.Header.Error != pb.XChainErrorEnum_SUCCESS {
+		return errors.New(reply.Header.Error.String())
+	}
+
+	for _, txid := range reply.GetTxids() {
+		fmt.Println(hex.EncodeToString(txid))
+	}
+	fmt.Printf("total: %d\n", reply.GetTotal())
+	if reply.GetNextCursor() != "" {
+		fmt.Printf("next cursor: %s\n", reply.GetNextCursor())
+	}
+	return nil
+}
+
+func (t *TxPoolCommand) listTxs(ctx context.Context) error {
+	client := t.cli.XchainClient()
+	reply, err := client.GetUnconfirmedTxs(ctx, t.newRequest())
+	if err != nil {
+		return err
+	}
+	if reply.Header.Error != pb.XChainErrorEnum_SUCCESS {
+		return errors.New(reply.Header.Error.String())
+	}
+
+	txs := make([]*Transaction, 0, len(reply.GetTxs()))
+	for _, tx := range reply.GetTxs() {
+		txs = append(txs, FromPBTx(tx))
+	}
+	output, err := json.MarshalIndent(txs, "", "  ")
+	if err != nil {
+		fmt.Println(err)
+	}
+	fmt.Println(string(output))
+	if reply.GetNextCursor() != "" {
+		fmt.Printf("next cursor: %s\n", reply.GetNextCursor())
+	}
+	return nil
+}
+
+func (t *TxPoolCommand) newRequest() *pb.UnconfirmedTxsRequest {
+	return &pb.UnconfirmedTxsRequest{
+		Header: &pb.Header{
+			Logid: utils.GenLogId(),
+		},
+		Bcname:    t.cli.RootOptions.Name,
+		Initiator: t.initiator,
+		Cursor:    t.cursor,
+		Limit:     t.limit,
+	}
+}
@@ -0,0 +1,72 @@
+/*
+ * Copyright (c) 2021. Baidu Inc. All Rights Reserved.
+ */
+
+package cmd
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/pflag"
+
+	"github.com/xuperchain/xupercore/lib/utils"
+	"github.com/xuperchain/xuperos/common/xupospb/pb"
+)
+
+// WaitTxOptions wait tx confirmation options
+type WaitTxOptions struct {
+	Wait          bool
+	Confirmations int64
+	Timeout       int64
+}
+
+// AddFlags add wait flags to command
+func (w *WaitTxOptions) AddFlags(flags *pflag.FlagSet) {
+	flags.BoolVar(&w.Wait, "wait", false, "wait until tx is confirmed after posting")
+	flags.Int64Var(&w.Confirmations, "confirmations", 0, "blocks on top of tx block when waiting")
+	flags.Int64Var(&w.Timeout, "wait-timeout", 30, "wait timeout in seconds, max 300")
+}
+
+// waitTx 等待交易确认，被丢弃或超时返回错误
+func waitTx(ctx context.Context, client pb.XchainClient, bcname, txid string, opt *WaitTxOptions) error {
+	if opt == nil || !opt.Wait {
+		return nil
+	}
+	rawTxid, err := hex.DecodeString(txid)
+	if err != nil {
+		return fmt.Errorf("bad txid:%s", txid)
+	}
+
+	req := &pb.WaitTxRequest{
+		Header: &pb.Header{
+			Logid: utils.GenLogId(),
+		},
+		Bcname:         bcname,
+		Txid:           rawTxid,
+		Confirmations:  opt.Confirmations,
+		TimeoutSeconds: opt.Timeout,
+	}
+	reply, err := client.WaitTx(ctx, req)
+	if err != nil {
+		return err
+	}
+	if reply.Header.Error != pb.XChainErrorEnum_SUCCESS {
+		return errors.New(reply.Header.Error.String())
+	}
+
+	switch reply.GetResult() {
+	case pb.WaitTxResult_WAIT_CONFIRMED:
+		fmt.Printf("Tx confirmed, blockid: %s, confirmations: %d\n",
+			hex.EncodeToString(reply.GetBlockid()), reply.GetConfirmations())
+		return nil
+	case pb.WaitTxResult_WAIT_DROPPED:
+		return fmt.Errorf("tx dropped, txid:%s", txid)
+	case pb.WaitTxResult_WAIT_TIMEOUT:
+		return fmt.Errorf("wait tx timeout, txid:%s, status:%s, confirmations:%d",
+			txid, reply.GetStatus(), reply.GetConfirmations())
+	}
+	return fmt.Errorf("unexpected wait result:%s", reply.GetResult())
+}
@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/ledger"
+	"github.com/xuperchain/xupercore/lib/logs"
+
+	"github.com/xuperchain/xuperos/service/index"
+
+	"github.com/spf13/cobra"
+)
+
+type IndexCmd struct {
+	BaseCmd
+}
+
+func GetIndexCmd() *IndexCmd {
+	indexCmdIns := new(IndexCmd)
+
+	indexCmdIns.Cmd = &cobra.Command{
+		Use:   "index",
+		Short: "Manage service layer indexes.",
+	}
+	indexCmdIns.Cmd.AddCommand(getIndexRebuildCmd())
+
+	return indexCmdIns
+}
+
+func getIndexRebuildCmd() *cobra.Command {
+	// 定义命令行参数变量
+	var envCfgPath string
+	var bcName string
+
+	rebuildCmd := &cobra.Command{
+		Use:           "rebuild",
+		Short:         "Rebuild indexes from existing ledgers, node must be stopped.",
+		Example:       "xuperos index rebuild --conf /home/rd/xuperos/conf/env.yaml --name xuper",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return rebuildIndex(envCfgPath, bcName)
+		},
+	}
+
+	// 设置命令行参数并绑定变量
+	rebuildCmd.Flags().StringVarP(&envCfgPath, "conf", "c", "",
+		"engine environment config file path")
+	rebuildCmd.Flags().StringVar(&bcName, "name", "",
+		"block chain name, empty means all chains")
+
+	return rebuildCmd
+}
+
+// 清空并按账本主干重建索引，账本和索引库被节点占用时打开会失败
+func rebuildIndex(envCfgPath, bcName string) error {
+	envConf, servConf, err := loadConf(envCfgPath)
+	if err != nil {
+		return err
+	}
+	logs.InitLog(envConf.GenConfFilePath(envConf.LogConf), envConf.GenDirAbsPath(envConf.LogDir))
+
+	bcNames := []string{bcName}
+	if bcName == "" {
+		dirs, err := ioutil.ReadDir(envConf.GenDataAbsPath(envConf.ChainDir))
+		if err != nil {
+			return fmt.Errorf("read chain dir failed.err:%v", err)
+		}
+		bcNames = bcNames[:0]
+		for _, dir := range dirs {
+			if dir.IsDir() {
+				bcNames = append(bcNames, dir.Name())
+			}
+		}
+	}
+
+	indexer, err := index.OpenIndexer(servConf, envConf)
+	if err != nil {
+		return err
+	}
+	defer indexer.Close()
+
+	for _, name := range bcNames {
+		lctx, err := ledger.NewLedgerCtx(envConf, name)
+		if err != nil {
+			return err
+		}
+		l, err := ledger.OpenLedger(lctx)
+		if err != nil {
+			return fmt.Errorf("open ledger failed, make sure node is stopped.bcName:%s,err:%v", name, err)
+		}
+
+		err = indexer.Reset(name)
+		if err == nil {
+			err = indexer.SyncLedger(name, l)
+		}
+		height := l.GetMeta().GetTrunkHeight()
+		l.Close()
+		if err != nil {
+			return fmt.Errorf("rebuild index failed.bcName:%s,err:%v", name, err)
+		}
+		fmt.Printf("rebuild index succ.bcName:%s,height:%d\n", name, height)
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/xuperchain/xuperos/common/scaffold"
+
+	"github.com/spf13/cobra"
+)
+
+type InitCmd struct {
+	BaseCmd
+}
+
+func GetInitCmd() *InitCmd {
+	initCmdIns := new(InitCmd)
+
+	// 定义命令行参数变量
+	conf := scaffold.GetDefNodeConf()
+
+	initCmdIns.Cmd = &cobra.Command{
+		Use:           "init",
+		Short:         "Generate configs, keys and genesis of a node directory.",
+		Example:       "xuperos init --root ./node1 --consensus tdpos --p2p-port 47101",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return initNode(conf)
+		},
+	}
+
+	// 设置命令行参数并绑定变量
+	flags := initCmdIns.Cmd.Flags()
+	flags.StringVar(&conf.RootPath, "root", conf.RootPath, "node root directory")
+	flags.StringVar(&conf.BcName, "name", conf.BcName, "root chain name")
+	flags.StringVar(&conf.Consensus, "consensus", conf.Consensus,
+		"genesis consensus template, "+strings.Join(scaffold.Consensuses(), "|"))
+	flags.StringVar(&conf.CryptoType, "crypto", conf.CryptoType, "crypto type of node account")
+	flags.StringVar(&conf.Host, "host", conf.Host, "p2p listen ip, also used in node neturl")
+	flags.IntVar(&conf.P2PPort, "p2p-port", conf.P2PPort, "p2p listen port")
+	flags.IntVar(&conf.RpcPort, "rpc-port", conf.RpcPort, "rpc service port")
+	flags.IntVar(&conf.AdapterRpcPort, "adapter-rpc-port", conf.AdapterRpcPort, "adapter rpc service port")
+	flags.IntVar(&conf.AdapterGWPort, "adapter-gw-port", conf.AdapterGWPort, "adapter gateway port")
+	flags.IntVar(&conf.MetricPort, "metric-port", conf.MetricPort, "metric port")
+	flags.IntVar(&conf.AdminPort, "admin-port", conf.AdminPort, "admin service port on 127.0.0.1, 0 means disabled")
+	flags.StringVar(&conf.P2PModule, "p2p-module", "",
+		"p2p module, p2pv1|p2pv2, default p2pv2 with bootnodes and p2pv1 without")
+	flags.StringSliceVar(&conf.BootNodes, "bootnodes", nil, "bootstrap peer neturls, comma separated")
+	flags.BoolVarP(&conf.Force, "force", "f", false,
+		"regenerate configs and genesis of an initialized node dir, existing keys are kept")
+
+	return initCmdIns
+}
+
+// 初始化节点目录
+func initNode(conf *scaffold.NodeConf) error {
+	info, err := scaffold.InitNode(conf)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("init node succ.root:%s\n", conf.RootPath)
+	fmt.Printf("address: %s\n", info.Address)
+	fmt.Printf("neturl: %s\n", info.NetURL)
+	fmt.Printf("genesis: %s\n", scaffold.GenesisPath(conf.RootPath, conf.BcName))
+	return nil
+}
@@ -0,0 +1,316 @@
+package cmd
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	"github.com/xuperchain/xupercore/kernel/common/xconfig"
+	"github.com/xuperchain/xupercore/lib/logs"
+
+	cli "github.com/xuperchain/xuperos/cmd/adapter/cmd"
+	"github.com/xuperchain/xuperos/common/chaindata"
+	"github.com/xuperchain/xuperos/common/daemon"
+	"github.com/xuperchain/xuperos/common/xupospb/pb"
+	acom "github.com/xuperchain/xuperos/service/adapter/common"
+
+	"github.com/spf13/cobra"
+)
+
+type LedgerCmd struct {
+	BaseCmd
+}
+
+// 子命令共用的链数据参数
+type ledgerFlags struct {
+	envCfgPath string
+	bcName     string
+}
+
+func GetLedgerCmd() *LedgerCmd {
+	ledgerCmdIns := new(LedgerCmd)
+
+	flags := new(ledgerFlags)
+	ledgerCmdIns.Cmd = &cobra.Command{
+		Use:   "ledger",
+		Short: "Inspect ledger and state of a stopped node, output is same as xchain-cli.",
+	}
+	ledgerCmdIns.Cmd.PersistentFlags().StringVarP(&flags.envCfgPath, "conf", "c", "",
+		"engine environment config file path")
+	ledgerCmdIns.Cmd.PersistentFlags().StringVar(&flags.bcName, "chain", "xuper", "block chain name")
+	ledgerCmdIns.Cmd.AddCommand(getLedgerBlockCmd(flags))
+	ledgerCmdIns.Cmd.AddCommand(getLedgerTxCmd(flags))
+	ledgerCmdIns.Cmd.AddCommand(getLedgerStatusCmd(flags))
+	ledgerCmdIns.Cmd.AddCommand(getLedgerUtxoCmd(flags))
+	ledgerCmdIns.Cmd.AddCommand(getLedgerVerifyCmd(flags))
+
+	return ledgerCmdIns
+}
+
+// 加载节点配置并初始化日志
+func (f *ledgerFlags) loadEnv() (*xconfig.EnvConf, error) {
+	envConf, _, err := loadConf(f.envCfgPath)
+	if err != nil {
+		return nil, err
+	}
+	logs.InitLog(envConf.GenConfFilePath(envConf.LogConf), envConf.GenDirAbsPath(envConf.LogDir))
+	return envConf, nil
+}
+
+// 加载配置并独占数据目录，用于可能修改链数据的命令，锁在命令进程退出时释放
+func (f *ledgerFlags) lockEnv() (*xconfig.EnvConf, error) {
+	envConf, err := f.loadEnv()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := daemon.LockDir(envConf.GenDataAbsPath("")); err != nil {
+		return nil, err
+	}
+	return envConf, nil
+}
+
+// 只读打开链数据，不加锁也不构建状态机，节点运行时数据库被占用会打开失败
+func (f *ledgerFlags) openReader() (*chaindata.ChainReader, error) {
+	envConf, err := f.loadEnv()
+	if err != nil {
+		return nil, err
+	}
+	return chaindata.OpenChainReader(envConf, f.bcName)
+}
+
+func getLedgerBlockCmd(flags *ledgerFlags) *cobra.Command {
+	var byHeight bool
+	blockCmd := &cobra.Command{
+		Use:           "block [OPTIONS] blockid or height",
+		Short:         "Dump a block by block id or trunk height.",
+		Example:       "xuperos ledger block --conf ./conf/env.yaml -N 100",
+		Args:          cobra.ExactArgs(1),
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			chain, err := flags.openReader()
+			if err != nil {
+				return err
+			}
+			defer chain.Close()
+
+			var blockid []byte
+			if byHeight {
+				height, err := strconv.ParseInt(args[0], 10, 64)
+				if err != nil {
+					return fmt.Errorf("height format error.err:%v", err)
+				}
+				block, err := chain.Ledger.QueryBlockByHeight(height)
+				if err != nil {
+					return fmt.Errorf("query block failed.height:%d,err:%v", height, err)
+				}
+				blockid = block.GetBlockid()
+			} else {
+				blockid, err = hex.DecodeString(args[0])
+				if err != nil {
+					return fmt.Errorf("blockid format error.err:%v", err)
+				}
+			}
+			block, err := chain.Ledger.QueryBlock(blockid)
+			if err != nil {
+				return fmt.Errorf("query block failed.blockid:%x,err:%v", blockid, err)
+			}
+
+			xblock, err := acom.BlockToXchain(block)
+			if err != nil {
+				return fmt.Errorf("convert block failed.err:%v", err)
+			}
+			return printJSON(cli.FromInternalBlockPB(xblock))
+		},
+	}
+	blockCmd.Flags().BoolVarP(&byHeight, "byHeight", "N", false, "get block by height")
+
+	return blockCmd
+}
+
+func getLedgerTxCmd(flags *ledgerFlags) *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:           "tx txid",
+		Short:         "Dump a confirmed or unconfirmed transaction.",
+		Example:       "xuperos ledger tx --conf ./conf/env.yaml <txid>",
+		Args:          cobra.ExactArgs(1),
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txid, err := hex.DecodeString(args[0])
+			if err != nil {
+				return fmt.Errorf("txid format error.err:%v", err)
+			}
+			chain, err := flags.openReader()
+			if err != nil {
+				return err
+			}
+			defer chain.Close()
+
+			tx, _, err := chain.QueryTx(txid)
+			if err != nil {
+				return fmt.Errorf("query tx failed.txid:%x,err:%v", txid, err)
+			}
+			xtx, err := acom.TxToXchain(tx)
+			if err != nil {
+				return fmt.Errorf("convert tx failed.err:%v", err)
+			}
+			return printJSON(cli.FromPBTx(xtx))
+		},
+	}
+
+	return txCmd
+}
+
+func getLedgerStatusCmd(flags *ledgerFlags) *cobra.Command {
+	statusCmd := &cobra.Command{
+		Use:           "status",
+		Short:         "Print tip block, branch heads, ledger meta and utxo meta.",
+		Example:       "xuperos ledger status --conf ./conf/env.yaml",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			chain, err := flags.openReader()
+			if err != nil {
+				return err
+			}
+			defer chain.Close()
+
+			ledgerMeta, err := acom.LedgerMetaToXchain(chain.Ledger.GetMeta())
+			if err != nil {
+				return fmt.Errorf("convert ledger meta failed.err:%v", err)
+			}
+			meta, err := chain.GetUtxoMeta()
+			if err != nil {
+				return fmt.Errorf("query utxo meta failed.err:%v", err)
+			}
+			utxoMeta, err := acom.UtxoMetaToXchain(meta)
+			if err != nil {
+				return fmt.Errorf("convert utxo meta failed.err:%v", err)
+			}
+			// 与节点查询链状态保持一致，列出所有分支头
+			branchIds, err := chain.Ledger.GetBranchInfo([]byte("0"), int64(0))
+			if err != nil {
+				return fmt.Errorf("query branch info failed.err:%v", err)
+			}
+			bcStatus := &pb.BCStatus{
+				Bcname:        chain.BcName,
+				Meta:          ledgerMeta,
+				UtxoMeta:      utxoMeta,
+				BranchBlockid: make([]string, 0, len(branchIds)),
+			}
+			for _, branchId := range branchIds {
+				bcStatus.BranchBlockid = append(bcStatus.BranchBlockid, fmt.Sprintf("%x", branchId))
+			}
+
+			status := cli.FromSystemStatusPB(&pb.SystemsStatus{BcsStatus: []*pb.BCStatus{bcStatus}})
+			return printJSON(status.ChainStatus[0])
+		},
+	}
+
+	return statusCmd
+}
+
+func getLedgerUtxoCmd(flags *ledgerFlags) *cobra.Command {
+	var addr string
+	var num int64
+	utxoCmd := &cobra.Command{
+		Use:           "utxo",
+		Short:         "List utxo records of an address.",
+		Example:       "xuperos ledger utxo --conf ./conf/env.yaml -A <address> -N 10",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if addr == "" {
+				return fmt.Errorf("address is required")
+			}
+			chain, err := flags.openReader()
+			if err != nil {
+				return err
+			}
+			defer chain.Close()
+
+			record, err := chain.QueryUtxoRecord(addr, num)
+			if err != nil {
+				return fmt.Errorf("query utxo record failed.err:%v", err)
+			}
+			return printJSON(&pb.UtxoRecordDetail{
+				Bcname:           chain.BcName,
+				AccountName:      addr,
+				OpenUtxoRecord:   acom.UtxoRecordToXchain(record.GetOpenUtxo()),
+				LockedUtxoRecord: acom.UtxoRecordToXchain(record.GetLockedUtxo()),
+				FrozenUtxoRecord: acom.UtxoRecordToXchain(record.GetFrozenUtxo()),
+				DisplayCount:     num,
+			})
+		},
+	}
+	utxoCmd.Flags().StringVarP(&addr, "address", "A", "", "address")
+	utxoCmd.Flags().Int64VarP(&num, "num", "N", 1, "utxo items to be displayed")
+
+	return utxoCmd
+}
+
+func getLedgerVerifyCmd(flags *ledgerFlags) *cobra.Command {
+	var start, end int64
+	var checkUtxo, truncate bool
+	verifyCmd := &cobra.Command{
+		Use:           "verify",
+		Short:         "Verify block ids, tx ids, merkle roots, hash links and utxo set, report first corrupt height.",
+		Example:       "xuperos ledger verify --conf ./conf/env.yaml --start 0 --end 1000",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			envConf, err := flags.lockEnv()
+			if err != nil {
+				return err
+			}
+			result, err := chaindata.VerifyChain(envConf, flags.bcName, start, end, checkUtxo)
+			if err != nil {
+				return err
+			}
+			if err := printJSON(result); err != nil {
+				return err
+			}
+			if result.IsGood() {
+				return nil
+			}
+			if !truncate {
+				if result.BadHeight < 0 {
+					return fmt.Errorf("utxo table corrupted")
+				}
+				return fmt.Errorf("ledger corrupted at height %d", result.BadHeight)
+			}
+			return truncateCorrupted(envConf, flags.bcName, result)
+		},
+	}
+	verifyCmd.Flags().Int64Var(&start, "start", 0, "start trunk height")
+	verifyCmd.Flags().Int64Var(&end, "end", -1, "end trunk height, -1 means tip block")
+	verifyCmd.Flags().BoolVar(&checkUtxo, "utxo", true, "replay trunk blocks from genesis and compare with utxo table")
+	verifyCmd.Flags().BoolVar(&truncate, "truncate-to", false,
+		"roll ledger and state back to the last good block before first corrupt height")
+
+	return verifyCmd
+}
+
+// 裁剪到第一个异常区块的前一个区块，utxo表异常无法通过裁剪修复
+func truncateCorrupted(envConf *xconfig.EnvConf, bcName string, result *chaindata.VerifyResult) error {
+	if result.BadHeight < 0 {
+		return fmt.Errorf("utxo table corrupted, truncate can not repair it, import snapshot or resync instead")
+	}
+	if result.BadHeight == 0 {
+		return fmt.Errorf("genesis block corrupted, nothing to truncate to")
+	}
+
+	chain, err := chaindata.OpenChain(envConf, bcName)
+	if err != nil {
+		return err
+	}
+	defer chain.Close()
+
+	height := result.BadHeight - 1
+	if err := chain.TruncateTo(height); err != nil {
+		return fmt.Errorf("truncate ledger failed.height:%d,err:%v", height, err)
+	}
+	fmt.Printf("truncate ledger succ.bcName:%s,height:%d\n", bcName, height)
+	return nil
+}
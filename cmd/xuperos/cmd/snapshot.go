@@ -0,0 +1,123 @@
+package cmd
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/xuperchain/xupercore/lib/logs"
+
+	"github.com/xuperchain/xuperos/common/chaindata"
+	"github.com/xuperchain/xuperos/common/daemon"
+
+	"github.com/spf13/cobra"
+)
+
+type SnapshotCmd struct {
+	BaseCmd
+}
+
+func GetSnapshotCmd() *SnapshotCmd {
+	snapshotCmdIns := new(SnapshotCmd)
+
+	snapshotCmdIns.Cmd = &cobra.Command{
+		Use:   "snapshot",
+		Short: "Export or import ledger and state snapshot, node must be stopped.",
+	}
+	snapshotCmdIns.Cmd.AddCommand(getSnapshotExportCmd())
+	snapshotCmdIns.Cmd.AddCommand(getSnapshotImportCmd())
+
+	return snapshotCmdIns
+}
+
+func getSnapshotExportCmd() *cobra.Command {
+	// 定义命令行参数变量
+	var envCfgPath, bcName, outPath string
+	var height int64
+
+	exportCmd := &cobra.Command{
+		Use:           "export",
+		Short:         "Export ledger and state at a trunk height into a checksummed archive.",
+		Example:       "xuperos snapshot export --conf ./conf/env.yaml --chain xuper --height 10000",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			envConf, _, err := loadConf(envCfgPath)
+			if err != nil {
+				return err
+			}
+			logs.InitLog(envConf.GenConfFilePath(envConf.LogConf), envConf.GenDirAbsPath(envConf.LogDir))
+			lock, err := daemon.LockDir(envConf.GenDataAbsPath(""))
+			if err != nil {
+				return err
+			}
+			defer lock.Unlock()
+
+			if outPath == "" {
+				outPath = fmt.Sprintf("%s.snapshot.tar.gz", bcName)
+			}
+			manifest, err := chaindata.ExportSnapshot(envConf, bcName, height, outPath)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("export snapshot succ.bcName:%s,height:%d,blockid:%s,file:%s\n",
+				manifest.BcName, manifest.Height, manifest.Blockid, outPath)
+			return nil
+		},
+	}
+
+	// 设置命令行参数并绑定变量
+	exportCmd.Flags().StringVarP(&envCfgPath, "conf", "c", "",
+		"engine environment config file path")
+	exportCmd.Flags().StringVar(&bcName, "chain", "xuper", "block chain name")
+	exportCmd.Flags().Int64Var(&height, "height", -1, "trunk height of snapshot, -1 means tip block")
+	exportCmd.Flags().StringVarP(&outPath, "out", "o", "", "archive file path, default <chain>.snapshot.tar.gz")
+
+	return exportCmd
+}
+
+func getSnapshotImportCmd() *cobra.Command {
+	// 定义命令行参数变量
+	var envCfgPath, bcName, inPath, blockid string
+
+	importCmd := &cobra.Command{
+		Use:           "import",
+		Short:         "Restore snapshot archive into chain dir, the chain must not exist.",
+		Example:       "xuperos snapshot import --conf ./conf/env.yaml --in xuper.snapshot.tar.gz --blockid <hex>",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			trustBlockid, err := hex.DecodeString(blockid)
+			if err != nil {
+				return fmt.Errorf("blockid format error.err:%v", err)
+			}
+			envConf, _, err := loadConf(envCfgPath)
+			if err != nil {
+				return err
+			}
+			logs.InitLog(envConf.GenConfFilePath(envConf.LogConf), envConf.GenDirAbsPath(envConf.LogDir))
+			lock, err := daemon.LockDir(envConf.GenDataAbsPath(""))
+			if err != nil {
+				return err
+			}
+			defer lock.Unlock()
+
+			manifest, err := chaindata.ImportSnapshot(envConf, inPath, bcName, trustBlockid)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("import snapshot succ.bcName:%s,height:%d,blockid:%s\n",
+				manifest.BcName, manifest.Height, manifest.Blockid)
+			return nil
+		},
+	}
+
+	// 设置命令行参数并绑定变量
+	importCmd.Flags().StringVarP(&envCfgPath, "conf", "c", "",
+		"engine environment config file path")
+	importCmd.Flags().StringVarP(&inPath, "in", "i", "", "snapshot archive file path")
+	importCmd.Flags().StringVar(&bcName, "chain", "", "expected block chain name, empty means chain in snapshot")
+	importCmd.Flags().StringVar(&blockid, "blockid", "",
+		"trusted block id at snapshot height from other nodes, hex encoded")
+
+	return importCmd
+}
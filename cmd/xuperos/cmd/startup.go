@@ -1,16 +1,25 @@
 package cmd
 
 import (
+	"fmt"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"sync"
 	"syscall"
 
+	lutils "github.com/xuperchain/xupercore/bcs/ledger/xledger/utils"
 	econf "github.com/xuperchain/xupercore/kernel/common/xconfig"
 	"github.com/xuperchain/xupercore/kernel/engines"
 	"github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+	engconf "github.com/xuperchain/xupercore/kernel/engines/xuperos/config"
 	"github.com/xuperchain/xupercore/lib/logs"
+	"github.com/xuperchain/xupercore/lib/utils"
+	"github.com/xuperchain/xuperos/common/chaindata"
+	"github.com/xuperchain/xuperos/common/chainmgr"
 	sconf "github.com/xuperchain/xuperos/common/config"
+	"github.com/xuperchain/xuperos/common/daemon"
+	"github.com/xuperchain/xuperos/common/def"
 	"github.com/xuperchain/xuperos/service"
 
 	// import要使用的内核核心组件驱动
@@ -34,11 +43,21 @@ type StartupCmd struct {
 	BaseCmd
 }
 
+// StartupOptions 节点启动参数
+type StartupOptions struct {
+	// 环境配置文件路径
+	EnvCfgPath string
+	// pid文件路径，为空时不写pid文件
+	PidFile string
+	// 根链不存在时使用的创世块配置，为空时使用数据目录下genesis/<rootChain>.json
+	GenesisPath string
+}
+
 func GetStartupCmd() *StartupCmd {
 	startupCmdIns := new(StartupCmd)
 
 	// 定义命令行参数变量
-	var envCfgPath string
+	opts := new(StartupOptions)
 
 	startupCmdIns.Cmd = &cobra.Command{
 		Use:           "startup",
@@ -47,21 +66,25 @@ func GetStartupCmd() *StartupCmd {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return StartupXchain(envCfgPath)
+			return StartupXchain(opts)
 		},
 	}
 
 	// 设置命令行参数并绑定变量
-	startupCmdIns.Cmd.Flags().StringVarP(&envCfgPath, "conf", "c", "",
+	startupCmdIns.Cmd.Flags().StringVarP(&opts.EnvCfgPath, "conf", "c", "",
 		"engine environment config file path")
+	startupCmdIns.Cmd.Flags().StringVar(&opts.PidFile, "pidfile", "",
+		"write process id to file, fail if pid in file is still running")
+	startupCmdIns.Cmd.Flags().StringVar(&opts.GenesisPath, "genesis", "",
+		"genesis config used to create root chain when missing, default <dataDir>/genesis/<rootChain>.json")
 
 	return startupCmdIns
 }
 
 // 启动节点
-func StartupXchain(envCfgPath string) error {
+func StartupXchain(opts *StartupOptions) error {
 	// 加载基础配置
-	envConf, servConf, err := loadConf(envCfgPath)
+	envConf, servConf, err := loadConf(opts.EnvCfgPath)
 	if err != nil {
 		return err
 	}
@@ -69,8 +92,30 @@ func StartupXchain(envCfgPath string) error {
 	// 初始化日志
 	logs.InitLog(envConf.GenConfFilePath(envConf.LogConf), envConf.GenDirAbsPath(envConf.LogDir))
 
+	// 独占数据目录，避免多个进程同时读写
+	lock, err := daemon.LockDir(envConf.GenDataAbsPath(""))
+	if err != nil {
+		return err
+	}
+	defer lock.Unlock()
+	if opts.PidFile != "" {
+		if err := daemon.WritePidFile(opts.PidFile); err != nil {
+			return err
+		}
+		defer daemon.RemovePidFile(opts.PidFile)
+	}
+
+	if err := createRootChain(envConf, opts.GenesisPath); err != nil {
+		return err
+	}
+
 	// 实例化区块链引擎
-	engine, err := engines.CreateBCEngine(common.BCEngineName, envConf)
+	bcEngine, err := engines.CreateBCEngine(common.BCEngineName, envConf)
+	if err != nil {
+		return err
+	}
+	// 支持运行时加载和卸载链
+	engine, err := chainmgr.NewEngine(bcEngine)
 	if err != nil {
 		return err
 	}
@@ -87,7 +132,9 @@ func StartupXchain(envCfgPath string) error {
 	servChan := runServ(serv)
 
 	// 阻塞等待进程退出指令
-	sigChan := make(chan os.Signal)
+	// 关键服务组件无法恢复时，服务返回错误，进程以非0状态退出
+	var servErr error
+	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM, syscall.SIGQUIT)
 	go func() {
 		// 退出调用幂等
@@ -96,7 +143,8 @@ func StartupXchain(envCfgPath string) error {
 			case <-engChan:
 				wg.Done()
 				serv.Exit()
-			case <-servChan:
+			case err := <-servChan:
+				servErr = err
 				wg.Done()
 				engine.Exit()
 			case <-sigChan:
@@ -108,6 +156,33 @@ func StartupXchain(envCfgPath string) error {
 
 	// 等待异步任务全部退出
 	wg.Wait()
+	return servErr
+}
+
+// 根链不存在时使用创世块配置创建
+func createRootChain(envConf *econf.EnvConf, genesisPath string) error {
+	engConf, err := engconf.LoadEngineConf(envConf.GenConfFilePath(envConf.EngineConf))
+	if err != nil {
+		return err
+	}
+	rootChain := engConf.RootChain
+	if utils.FileIsExist(chaindata.ChainPath(envConf, rootChain)) {
+		return nil
+	}
+
+	if genesisPath == "" {
+		genesisPath = envConf.GenDataAbsPath(filepath.Join("genesis", rootChain+".json"))
+	}
+	if !utils.FileIsExist(genesisPath) {
+		return fmt.Errorf("root chain not exist and genesis config not found.bcName:%s,genesis:%s",
+			rootChain, genesisPath)
+	}
+	if err := lutils.CreateLedger(rootChain, genesisPath, envConf); err != nil {
+		return fmt.Errorf("create root chain failed.bcName:%s,err:%v", rootChain, err)
+	}
+	if log, err := logs.NewLogger("", def.SubModName); err == nil {
+		log.Info("create root chain succ", "bcName", rootChain, "genesis", genesisPath)
+	}
 	return nil
 }
 
@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+	"time"
+
+	"github.com/xuperchain/xuperos/common/daemon"
+
+	"github.com/spf13/cobra"
+)
+
+type StopCmd struct {
+	BaseCmd
+}
+
+func GetStopCmd() *StopCmd {
+	stopCmdIns := new(StopCmd)
+
+	// 定义命令行参数变量
+	var pidFile string
+	var timeout time.Duration
+	var force bool
+
+	stopCmdIns.Cmd = &cobra.Command{
+		Use:           "stop",
+		Short:         "Stop the node process recorded in pid file.",
+		Example:       "xuperos stop --pidfile ./tmp/xuperos.pid",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if pidFile == "" {
+				return fmt.Errorf("pidfile is required")
+			}
+			pid, err := daemon.ReadPidFile(pidFile)
+			if err != nil {
+				return err
+			}
+			if !daemon.Alive(pid) {
+				// 进程已经退出，清理残留的pid文件
+				os.Remove(pidFile)
+				fmt.Println("process not running.")
+				return nil
+			}
+
+			sig := syscall.SIGTERM
+			if force {
+				sig = syscall.SIGKILL
+			}
+			if err := daemon.Stop(pid, sig, timeout); err != nil {
+				return err
+			}
+			os.Remove(pidFile)
+			fmt.Printf("process stopped.pid:%d\n", pid)
+			return nil
+		},
+	}
+
+	// 设置命令行参数并绑定变量
+	stopCmdIns.Cmd.Flags().StringVar(&pidFile, "pidfile", "", "pid file written by startup --pidfile")
+	stopCmdIns.Cmd.Flags().DurationVar(&timeout, "timeout", time.Minute, "max time waiting for process exit")
+	stopCmdIns.Cmd.Flags().BoolVar(&force, "force", false, "kill process with SIGKILL")
+
+	return stopCmdIns
+}
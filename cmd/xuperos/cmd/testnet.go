@@ -0,0 +1,152 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/xuperchain/xuperos/common/scaffold"
+	"github.com/xuperchain/xuperos/common/testnet"
+
+	"github.com/spf13/cobra"
+)
+
+type TestnetCmd struct {
+	BaseCmd
+}
+
+func GetTestnetCmd() *TestnetCmd {
+	testnetCmdIns := new(TestnetCmd)
+
+	var rootPath string
+	testnetCmdIns.Cmd = &cobra.Command{
+		Use:   "testnet",
+		Short: "Run a multi-node local test network, up|down|status.",
+	}
+	testnetCmdIns.Cmd.PersistentFlags().StringVar(&rootPath, "root", "./testnet", "test network root directory")
+	testnetCmdIns.Cmd.AddCommand(getTestnetUpCmd(&rootPath))
+	testnetCmdIns.Cmd.AddCommand(getTestnetDownCmd(&rootPath))
+	testnetCmdIns.Cmd.AddCommand(getTestnetStatusCmd(&rootPath))
+
+	return testnetCmdIns
+}
+
+func getTestnetUpCmd(rootPath *string) *cobra.Command {
+	// 定义命令行参数变量
+	conf := &testnet.Conf{}
+	var timeout time.Duration
+
+	upCmd := &cobra.Command{
+		Use:           "up",
+		Short:         "Generate (first time only) and start nodes, wait until all nodes agree on tip block.",
+		Example:       "xuperos testnet up --nodes 3 --consensus tdpos",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			conf.RootPath = *rootPath
+			return testnetUp(conf, timeout)
+		},
+	}
+
+	// 设置命令行参数并绑定变量
+	flags := upCmd.Flags()
+	flags.IntVar(&conf.Nodes, "nodes", 3, "node number, ignored if test network already generated")
+	flags.StringVar(&conf.Consensus, "consensus", "tdpos",
+		"genesis consensus template, "+strings.Join(scaffold.Consensuses(), "|"))
+	flags.StringVar(&conf.BcName, "name", "xuper", "root chain name")
+	flags.IntVar(&conf.PortOffset, "port-offset", 0, "offset added to default node ports")
+	flags.StringVar(&conf.BinPath, "bin", "", "xuperos binary path, default is current executable")
+	flags.DurationVar(&timeout, "timeout", 2*time.Minute, "max time waiting for nodes agreement")
+
+	return upCmd
+}
+
+func getTestnetDownCmd(rootPath *string) *cobra.Command {
+	var clean bool
+	downCmd := &cobra.Command{
+		Use:           "down",
+		Short:         "Stop all nodes of test network.",
+		Example:       "xuperos testnet down --clean",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			tn, err := testnet.Load(*rootPath)
+			if err != nil {
+				return err
+			}
+			if err := tn.Stop(); err != nil {
+				return err
+			}
+			fmt.Println("testnet stopped.")
+			if clean {
+				return os.RemoveAll(tn.Conf().RootPath)
+			}
+			return nil
+		},
+	}
+	downCmd.Flags().BoolVar(&clean, "clean", false, "remove test network directory after stopped")
+
+	return downCmd
+}
+
+func getTestnetStatusCmd(rootPath *string) *cobra.Command {
+	return &cobra.Command{
+		Use:           "status",
+		Short:         "Show process and tip block of each node.",
+		Example:       "xuperos testnet status",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			tn, err := testnet.Load(*rootPath)
+			if err != nil {
+				return err
+			}
+			return printJSON(tn.Status(context.Background()))
+		},
+	}
+}
+
+// 启动测试网络，已生成时沿用已有节点目录
+func testnetUp(conf *testnet.Conf, timeout time.Duration) error {
+	var tn *testnet.Testnet
+	var err error
+	if testnet.Exist(conf.RootPath) {
+		tn, err = testnet.Load(conf.RootPath)
+		if tn != nil {
+			tn.Conf().BinPath = conf.BinPath
+		}
+	} else {
+		tn, err = testnet.New(conf)
+		if err == nil {
+			err = tn.Init()
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := tn.Start(); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	height, err := tn.WaitAgreement(ctx, 1)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("testnet up.root:%s nodes:%d height:%d\n", tn.Conf().RootPath, len(tn.Nodes()), height)
+	return printJSON(tn.Status(context.Background()))
+}
+
+func printJSON(v interface{}) error {
+	output, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(output))
+	return nil
+}
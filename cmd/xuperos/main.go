@@ -5,6 +5,7 @@ import (
 	"log"
 
 	"github.com/xuperchain/xuperos/cmd/xuperos/cmd"
+	"github.com/xuperchain/xuperos/common/version"
 
 	"github.com/spf13/cobra"
 )
@@ -16,6 +17,9 @@ var (
 )
 
 func main() {
+	// 编译信息供管理接口查询
+	version.SetBuildInfo(Version, CommitID, BuildTime)
+
 	rootCmd, err := NewServiceCommand()
 	if err != nil {
 		log.Fatalf("start service failed.err:%v", err)
@@ -36,8 +40,20 @@ func NewServiceCommand() (*cobra.Command, error) {
 		Example:       "xuperos startup --conf /home/rd/xuperos/conf/env.yaml",
 	}
 
+	// cmd init
+	rootCmd.AddCommand(cmd.GetInitCmd().GetCmd())
 	// cmd service
 	rootCmd.AddCommand(cmd.GetStartupCmd().GetCmd())
+	// cmd stop
+	rootCmd.AddCommand(cmd.GetStopCmd().GetCmd())
+	// cmd testnet
+	rootCmd.AddCommand(cmd.GetTestnetCmd().GetCmd())
+	// cmd index
+	rootCmd.AddCommand(cmd.GetIndexCmd().GetCmd())
+	// cmd snapshot
+	rootCmd.AddCommand(cmd.GetSnapshotCmd().GetCmd())
+	// cmd ledger
+	rootCmd.AddCommand(cmd.GetLedgerCmd().GetCmd())
 	// cmd version
 	rootCmd.AddCommand(GetVersionCmd().GetCmd())
 
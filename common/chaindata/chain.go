@@ -0,0 +1,168 @@
+// Package chaindata 节点停止时离线操作链数据，包括账本裁剪和快照导出导入
+package chaindata
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/ledger"
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/state"
+	sctx "github.com/xuperchain/xupercore/bcs/ledger/xledger/state/context"
+	lpb "github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
+	"github.com/xuperchain/xupercore/kernel/common/xconfig"
+	cryptoClient "github.com/xuperchain/xupercore/lib/crypto/client"
+	_ "github.com/xuperchain/xupercore/lib/storage/kvdb/leveldb"
+	"github.com/xuperchain/xupercore/lib/utils"
+)
+
+// Chain 离线打开的链账本和状态机
+type Chain struct {
+	BcName string
+	Ledger *ledger.Ledger
+	State  *state.State
+}
+
+// 链名称会作为数据目录名，只允许字母、数字、下划线、中划线和点
+var chainNameRegex = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.-]{0,63}$`)
+
+// CheckChainName 校验链名称，拒绝路径分隔符和..，避免访问链数据目录以外的路径
+func CheckChainName(bcName string) error {
+	if strings.ContainsAny(bcName, `/\`) || strings.Contains(bcName, "..") ||
+		!chainNameRegex.MatchString(bcName) {
+		return fmt.Errorf("invalid chain name.bcName:%q", bcName)
+	}
+	return nil
+}
+
+// ChainPath 链数据目录
+func ChainPath(envConf *xconfig.EnvConf, bcName string) string {
+	return filepath.Join(envConf.GenDataAbsPath(envConf.ChainDir), bcName)
+}
+
+// OpenChain 打开链账本和状态机，节点运行时数据库被占用会打开失败，需要提前初始化日志
+func OpenChain(envConf *xconfig.EnvConf, bcName string) (*Chain, error) {
+	if envConf == nil || bcName == "" {
+		return nil, fmt.Errorf("param error")
+	}
+	if err := CheckChainName(bcName); err != nil {
+		return nil, err
+	}
+	if !utils.FileIsExist(ChainPath(envConf, bcName)) {
+		return nil, fmt.Errorf("chain not exist.bcName:%s", bcName)
+	}
+
+	lctx, err := ledger.NewLedgerCtx(envConf, bcName)
+	if err != nil {
+		return nil, err
+	}
+	l, err := ledger.OpenLedger(lctx)
+	if err != nil {
+		return nil, fmt.Errorf("open ledger failed, make sure node is stopped.bcName:%s,err:%v", bcName, err)
+	}
+
+	crypt, err := cryptoClient.CreateCryptoClient(l.GetGenesisBlock().GetConfig().GetCryptoType())
+	if err != nil {
+		l.Close()
+		return nil, fmt.Errorf("create crypto client failed.err:%v", err)
+	}
+	stateCtx, err := sctx.NewStateCtx(envConf, bcName, l, crypt)
+	if err != nil {
+		l.Close()
+		return nil, err
+	}
+	s, err := state.NewState(stateCtx)
+	if err != nil {
+		l.Close()
+		return nil, fmt.Errorf("open state failed, make sure node is stopped.bcName:%s,err:%v", bcName, err)
+	}
+
+	return &Chain{BcName: bcName, Ledger: l, State: s}, nil
+}
+
+func (c *Chain) Close() {
+	c.State.Close()
+	c.Ledger.Close()
+}
+
+// TruncateTo 把状态机回滚并把账本裁剪到主干指定高度，高度以上的主干和分支区块及其交易都会删除，
+// 未确认交易会被丢弃
+func (c *Chain) TruncateTo(height int64) error {
+	meta := c.Ledger.GetMeta()
+	if height < 0 || height > meta.GetTrunkHeight() {
+		return fmt.Errorf("height out of trunk range.height:%d,trunkHeight:%d", height, meta.GetTrunkHeight())
+	}
+	target, err := c.Ledger.QueryBlockByHeight(height)
+	if err != nil {
+		return fmt.Errorf("query block failed.height:%d,err:%v", height, err)
+	}
+
+	// 丢弃未确认交易，避免walk后异步回放未确认交易
+	if _, _, err := c.State.RollBackUnconfirmedTx(); err != nil {
+		return fmt.Errorf("rollback unconfirmed tx failed.err:%v", err)
+	}
+	// 状态机回滚依赖账本中的区块，需要先于账本裁剪
+	if !bytes.Equal(c.State.GetLatestBlockid(), target.GetBlockid()) {
+		if err := c.State.Walk(target.GetBlockid(), true); err != nil {
+			return fmt.Errorf("walk state failed.err:%v", err)
+		}
+	}
+	if !bytes.Equal(c.State.GetLatestBlockid(), target.GetBlockid()) {
+		return fmt.Errorf("state not match target block after walk")
+	}
+
+	txids, err := c.txidsAbove(target)
+	if err != nil {
+		return err
+	}
+	if err := c.Ledger.Truncate(target.GetBlockid()); err != nil {
+		return fmt.Errorf("truncate ledger failed.err:%v", err)
+	}
+	return c.removeTxs(txids)
+}
+
+// 收集目标高度以上所有分支区块中的交易
+func (c *Chain) txidsAbove(target *lpb.InternalBlock) ([][]byte, error) {
+	tips, err := c.Ledger.GetBranchInfo(target.GetBlockid(), target.GetHeight())
+	if err != nil {
+		return nil, fmt.Errorf("query branch info failed.err:%v", err)
+	}
+
+	txids := make([][]byte, 0)
+	visited := make(map[string]bool)
+	for _, tip := range tips {
+		blockid := []byte(tip)
+		for !visited[string(blockid)] {
+			visited[string(blockid)] = true
+			block, err := c.Ledger.QueryBlock(blockid)
+			if err != nil {
+				// 孤块的前序区块可能不存在
+				break
+			}
+			if block.GetHeight() <= target.GetHeight() {
+				break
+			}
+			for _, tx := range block.GetTransactions() {
+				txids = append(txids, tx.GetTxid())
+			}
+			blockid = block.GetPreHash()
+		}
+	}
+	return txids, nil
+}
+
+// 账本裁剪不会删除交易，删除所在区块已经被裁剪的交易，避免重新同步区块时被当作重复交易
+func (c *Chain) removeTxs(txids [][]byte) error {
+	db := c.Ledger.GetBaseDB()
+	batch := db.NewBatch()
+	for _, txid := range txids {
+		tx, err := c.Ledger.QueryTransaction(txid)
+		if err != nil || c.Ledger.ExistBlock(tx.GetBlockid()) {
+			continue
+		}
+		batch.Delete(append([]byte(lpb.ConfirmedTablePrefix), txid...))
+	}
+	return batch.Write()
+}
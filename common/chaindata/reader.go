@@ -0,0 +1,261 @@
+package chaindata
+
+import (
+	"fmt"
+	"math/big"
+	"path/filepath"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/def"
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/ledger"
+	sctx "github.com/xuperchain/xupercore/bcs/ledger/xledger/state/context"
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/state/meta"
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/state/utxo"
+	lpb "github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
+	"github.com/xuperchain/xupercore/kernel/common/xconfig"
+	"github.com/xuperchain/xupercore/lib/logs"
+	"github.com/xuperchain/xupercore/lib/storage/kvdb"
+	"github.com/xuperchain/xupercore/lib/utils"
+)
+
+// 只读打开leveldb的存储引擎，账本通过该引擎打开时不会写入数据
+const readOnlyKVEngine = "chaindata-readonly"
+
+var errReadOnly = fmt.Errorf("chain data opened read only")
+
+func init() {
+	kvdb.Register(readOnlyKVEngine, func(param *kvdb.KVParameter) (kvdb.Database, error) {
+		db := new(readOnlyDB)
+		if err := db.Open(param.GetDBPath(), nil); err != nil {
+			return nil, err
+		}
+		return db, nil
+	})
+}
+
+// ChainReader 只读打开的链数据，不加数据目录锁，不构建状态机，只用于离线查询
+type ChainReader struct {
+	BcName  string
+	Ledger  *ledger.Ledger
+	stateDB kvdb.Database
+	log     logs.Logger
+}
+
+// OpenChainReader 以只读方式打开账本和状态机存储，节点运行时数据库被占用会打开失败
+func OpenChainReader(envConf *xconfig.EnvConf, bcName string) (*ChainReader, error) {
+	if envConf == nil || bcName == "" {
+		return nil, fmt.Errorf("param error")
+	}
+	if err := CheckChainName(bcName); err != nil {
+		return nil, err
+	}
+	if !utils.FileIsExist(ChainPath(envConf, bcName)) {
+		return nil, fmt.Errorf("chain not exist.bcName:%s", bcName)
+	}
+
+	lctx, err := ledger.NewLedgerCtx(envConf, bcName)
+	if err != nil {
+		return nil, err
+	}
+	if lctx.LedgerCfg.KVEngineType != kvdb.KVEngineTypeLDB || len(lctx.LedgerCfg.OtherPaths) > 0 {
+		return nil, fmt.Errorf("read only open only support single disk leveldb")
+	}
+	lctx.LedgerCfg.KVEngineType = readOnlyKVEngine
+	l, err := ledger.OpenLedger(lctx)
+	if err != nil {
+		return nil, fmt.Errorf("open ledger failed, make sure node is stopped.bcName:%s,err:%v", bcName, err)
+	}
+
+	stateDB := new(readOnlyDB)
+	err = stateDB.Open(filepath.Join(ChainPath(envConf, bcName), def.StateStrgDirName), nil)
+	if err != nil {
+		l.Close()
+		return nil, fmt.Errorf("open state db failed, make sure node is stopped.bcName:%s,err:%v", bcName, err)
+	}
+
+	return &ChainReader{BcName: bcName, Ledger: l, stateDB: stateDB, log: lctx.XLog}, nil
+}
+
+func (c *ChainReader) Close() {
+	c.stateDB.Close()
+	c.Ledger.Close()
+}
+
+// QueryTx 先查未确认交易表再查账本，返回交易是否已确认
+func (c *ChainReader) QueryTx(txid []byte) (*lpb.Transaction, bool, error) {
+	buf, err := c.stateDB.Get(append([]byte(lpb.UnconfirmedTablePrefix), txid...))
+	if err == nil {
+		tx := &lpb.Transaction{}
+		if err := proto.Unmarshal(buf, tx); err != nil {
+			return nil, false, err
+		}
+		return tx, false, nil
+	}
+	if def.NormalizedKVError(err) != def.ErrKVNotFound {
+		return nil, false, err
+	}
+
+	tx, err := c.Ledger.QueryTransaction(txid)
+	if err != nil {
+		return nil, false, err
+	}
+	return tx, true, nil
+}
+
+// GetUtxoMeta 从状态机meta表读取，未设置的参数取创世配置，不统计内存中的交易延迟
+func (c *ChainReader) GetUtxoMeta() (*lpb.UtxoMeta, error) {
+	stateCtx := &sctx.StateCtx{Ledger: c.Ledger}
+	stateCtx.XLog = c.log
+	metaHD, err := meta.NewMeta(stateCtx, c.stateDB)
+	if err != nil {
+		return nil, err
+	}
+	res := proto.Clone(metaHD.Meta).(*lpb.UtxoMeta)
+
+	res.LatestBlockid, err = c.getMeta(utxo.LatestBlockKey)
+	if err != nil {
+		return nil, err
+	}
+	totalBuf, err := c.getMeta(utxo.UTXOTotalKey)
+	if err != nil {
+		return nil, err
+	}
+	res.UtxoTotal = new(big.Int).SetBytes(totalBuf).String()
+
+	it := c.stateDB.NewIteratorWithPrefix([]byte(lpb.UnconfirmedTablePrefix))
+	defer it.Release()
+	for it.Next() {
+		res.UnconfirmTxAmount++
+	}
+	return res, it.Error()
+}
+
+// QueryUtxoRecord 统计地址的可用和冻结utxo，离线时没有被锁定的utxo
+func (c *ChainReader) QueryUtxoRecord(addr string, displayCount int64) (*lpb.UtxoRecordDetail, error) {
+	open := newUtxoRecord()
+	locked := newUtxoRecord()
+	frozen := newUtxoRecord()
+	trunkHeight := c.Ledger.GetMeta().GetTrunkHeight()
+
+	it := c.stateDB.NewIteratorWithPrefix([]byte(fmt.Sprintf("%s%s_", lpb.UTXOTablePrefix, addr)))
+	defer it.Release()
+	for it.Next() {
+		item := new(utxo.UtxoItem)
+		if err := item.Loads(it.Value()); err != nil {
+			continue
+		}
+		record := open
+		if item.FrozenHeight > trunkHeight || item.FrozenHeight == -1 {
+			record = frozen
+		}
+		record.add(item.Amount)
+		if displayCount > 0 {
+			key := append([]byte{}, it.Key()...)
+			record.Item = append(record.Item, utxo.MakeUtxoKey(key, item.Amount.String()))
+			displayCount--
+		}
+	}
+	if err := it.Error(); err != nil {
+		return nil, err
+	}
+
+	return &lpb.UtxoRecordDetail{
+		OpenUtxo:   open.record(),
+		LockedUtxo: locked.record(),
+		FrozenUtxo: frozen.record(),
+	}, nil
+}
+
+// 读取状态机meta表，不存在返回nil
+func (c *ChainReader) getMeta(key string) ([]byte, error) {
+	val, err := c.stateDB.Get([]byte(lpb.MetaTablePrefix + key))
+	if def.NormalizedKVError(err) == def.ErrKVNotFound {
+		return nil, nil
+	}
+	return val, err
+}
+
+type utxoRecord struct {
+	*lpb.UtxoRecord
+	count  *big.Int
+	amount *big.Int
+}
+
+func newUtxoRecord() *utxoRecord {
+	return &utxoRecord{
+		UtxoRecord: &lpb.UtxoRecord{Item: []*lpb.UtxoKey{}},
+		count:      new(big.Int),
+		amount:     new(big.Int),
+	}
+}
+
+func (r *utxoRecord) add(amount *big.Int) {
+	r.count.Add(r.count, big.NewInt(1))
+	r.amount.Add(r.amount, amount)
+}
+
+func (r *utxoRecord) record() *lpb.UtxoRecord {
+	r.UtxoCount = r.count.String()
+	r.UtxoAmount = r.amount.String()
+	return r.UtxoRecord
+}
+
+// 以ReadOnly选项打开的leveldb，写操作返回错误
+type readOnlyDB struct {
+	db *leveldb.DB
+}
+
+func (t *readOnlyDB) Open(path string, options map[string]interface{}) error {
+	db, err := leveldb.OpenFile(path, &opt.Options{ReadOnly: true, ErrorIfMissing: true})
+	if err != nil {
+		return err
+	}
+	t.db = db
+	return nil
+}
+
+func (t *readOnlyDB) Put(key []byte, value []byte) error {
+	return errReadOnly
+}
+
+func (t *readOnlyDB) Get(key []byte) ([]byte, error) {
+	return t.db.Get(key, nil)
+}
+
+func (t *readOnlyDB) Has(key []byte) (bool, error) {
+	return t.db.Has(key, nil)
+}
+
+func (t *readOnlyDB) Delete(key []byte) error {
+	return errReadOnly
+}
+
+func (t *readOnlyDB) Close() {
+	t.db.Close()
+}
+
+func (t *readOnlyDB) NewBatch() kvdb.Batch {
+	return readOnlyBatch{}
+}
+
+func (t *readOnlyDB) NewIteratorWithRange(start []byte, limit []byte) kvdb.Iterator {
+	return t.db.NewIterator(&util.Range{Start: start, Limit: limit}, nil)
+}
+
+func (t *readOnlyDB) NewIteratorWithPrefix(prefix []byte) kvdb.Iterator {
+	return t.db.NewIterator(util.BytesPrefix(prefix), nil)
+}
+
+// 账本打开时会创建批次，只读时写入返回错误
+type readOnlyBatch struct{}
+
+func (b readOnlyBatch) ValueSize() int                      { return 0 }
+func (b readOnlyBatch) Write() error                        { return errReadOnly }
+func (b readOnlyBatch) Reset()                              {}
+func (b readOnlyBatch) Put(key []byte, value []byte) error  { return errReadOnly }
+func (b readOnlyBatch) Delete(key []byte) error             { return errReadOnly }
+func (b readOnlyBatch) PutIfAbsent(key, value []byte) error { return errReadOnly }
+func (b readOnlyBatch) Exist(key []byte) bool               { return false }
@@ -0,0 +1,84 @@
+package chaindata
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/utils"
+
+	"github.com/xuperchain/xuperos/common/scaffold"
+)
+
+func TestChainReader(t *testing.T) {
+	root, err := ioutil.TempDir("", "chaindata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	envConf := newTestNode(t, root)
+	if err := utils.CreateLedger("xuper", scaffold.GenesisPath(root, "xuper"), envConf); err != nil {
+		t.Fatal(err)
+	}
+	appendTestBlocks(t, envConf, root, 2)
+	address, err := ioutil.ReadFile(filepath.Join(root, "data", "keys", "address"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 以完整状态机的查询结果为准
+	chain, err := OpenChain(envConf, "xuper")
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantMeta := chain.State.GetMeta()
+	wantRecord, err := chain.State.QueryUtxoRecord(string(address), 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, err := chain.Ledger.QueryBlockByHeight(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain.Close()
+
+	if _, err := OpenChainReader(envConf, "../xuper"); err == nil {
+		t.Fatal("open reader with invalid chain name should fail")
+	}
+	reader, err := OpenChainReader(envConf, "xuper")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+
+	gotBlock, err := reader.Ledger.QueryBlockByHeight(2)
+	if err != nil || !proto.Equal(gotBlock, block) {
+		t.Fatalf("query block error.err:%v", err)
+	}
+	txid := block.GetTransactions()[0].GetTxid()
+	tx, confirmed, err := reader.QueryTx(txid)
+	if err != nil || !confirmed || !proto.Equal(tx, block.GetTransactions()[0]) {
+		t.Fatalf("query tx error.confirmed:%v,err:%v", confirmed, err)
+	}
+	meta, err := reader.GetUtxoMeta()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(meta, wantMeta) {
+		t.Fatalf("utxo meta not match.got:%v,want:%v", meta, wantMeta)
+	}
+	record, err := reader.QueryUtxoRecord(string(address), 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(record, wantRecord) {
+		t.Fatalf("utxo record not match.got:%v,want:%v", record, wantRecord)
+	}
+
+	if err := reader.stateDB.Put([]byte("key"), []byte("value")); err != errReadOnly {
+		t.Fatalf("write read only db should fail.err:%v", err)
+	}
+}
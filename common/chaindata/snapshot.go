@@ -0,0 +1,418 @@
+package chaindata
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	lconf "github.com/xuperchain/xupercore/bcs/ledger/xledger/config"
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/def"
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/ledger"
+	"github.com/xuperchain/xupercore/kernel/common/xconfig"
+	"github.com/xuperchain/xupercore/lib/utils"
+)
+
+// 快照清单，作为最后一个文件写入归档
+const manifestName = "manifest.json"
+
+// Manifest 快照清单
+type Manifest struct {
+	BcName      string     `json:"bcName"`
+	Height      int64      `json:"height"`
+	Blockid     string     `json:"blockid"`
+	RootBlockid string     `json:"rootBlockid"`
+	CreateTime  int64      `json:"createTime"`
+	Files       []*FileSum `json:"files"`
+}
+
+// FileSum 快照文件校验和，路径相对链数据目录
+type FileSum struct {
+	Path   string `json:"path"`
+	Size   int64  `json:"size"`
+	Sha256 string `json:"sha256"`
+}
+
+// ExportSnapshot 导出链在主干高度height的账本和状态机数据，height小于0时为最新高度，
+// 在链数据副本上回滚裁剪，不修改节点数据
+func ExportSnapshot(envConf *xconfig.EnvConf, bcName string, height int64, outPath string) (*Manifest, error) {
+	if envConf == nil || bcName == "" || outPath == "" {
+		return nil, fmt.Errorf("param error")
+	}
+	if err := checkStorage(envConf); err != nil {
+		return nil, err
+	}
+
+	// 确认节点已停止，检查高度范围
+	chain, err := OpenChain(envConf, bcName)
+	if err != nil {
+		return nil, err
+	}
+	trunkHeight := chain.Ledger.GetMeta().GetTrunkHeight()
+	chain.Close()
+	if height < 0 {
+		height = trunkHeight
+	}
+	if height > trunkHeight {
+		return nil, fmt.Errorf("height out of trunk range.height:%d,trunkHeight:%d", height, trunkHeight)
+	}
+
+	workEnv, workDir, err := newWorkEnv(envConf)
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(workDir)
+
+	workPath := ChainPath(workEnv, bcName)
+	if err := copyChainData(ChainPath(envConf, bcName), workPath); err != nil {
+		return nil, fmt.Errorf("copy chain data failed.err:%v", err)
+	}
+	chain, err = OpenChain(workEnv, bcName)
+	if err != nil {
+		return nil, err
+	}
+	err = chain.TruncateTo(height)
+	meta := chain.Ledger.GetMeta()
+	manifest := &Manifest{
+		BcName:      bcName,
+		Height:      meta.GetTrunkHeight(),
+		Blockid:     hex.EncodeToString(meta.GetTipBlockid()),
+		RootBlockid: hex.EncodeToString(meta.GetRootBlockid()),
+		CreateTime:  time.Now().Unix(),
+	}
+	chain.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := writeArchive(workPath, outPath, manifest); err != nil {
+		return nil, fmt.Errorf("write snapshot failed.err:%v", err)
+	}
+	return manifest, nil
+}
+
+// ImportSnapshot 把快照恢复到链数据目录，链不能已经存在。校验文件校验和，
+// 并校验快照账本最新区块为清单中高度的区块，trustBlockid不为空时同时校验与可信区块一致
+func ImportSnapshot(envConf *xconfig.EnvConf, inPath, bcName string, trustBlockid []byte) (*Manifest, error) {
+	if envConf == nil || inPath == "" {
+		return nil, fmt.Errorf("param error")
+	}
+	if err := checkStorage(envConf); err != nil {
+		return nil, err
+	}
+
+	workEnv, workDir, err := newWorkEnv(envConf)
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(workDir)
+
+	extractPath := filepath.Join(workDir, ".extract")
+	manifest, err := readArchive(inPath, extractPath)
+	if err != nil {
+		return nil, fmt.Errorf("read snapshot failed.err:%v", err)
+	}
+	// 清单中的链名称来自归档，拼接路径前必须校验
+	if err := CheckChainName(manifest.BcName); err != nil {
+		return nil, err
+	}
+	if bcName != "" && bcName != manifest.BcName {
+		return nil, fmt.Errorf("snapshot chain not match.bcName:%s,snapshot:%s", bcName, manifest.BcName)
+	}
+	if len(trustBlockid) > 0 && hex.EncodeToString(trustBlockid) != manifest.Blockid {
+		return nil, fmt.Errorf("snapshot block not match trust block.blockid:%s,trust:%x",
+			manifest.Blockid, trustBlockid)
+	}
+	chainPath := ChainPath(envConf, manifest.BcName)
+	if utils.FileIsExist(chainPath) {
+		return nil, fmt.Errorf("chain already exist.path:%s", chainPath)
+	}
+
+	if err := os.Rename(extractPath, ChainPath(workEnv, manifest.BcName)); err != nil {
+		return nil, err
+	}
+	if err := verifySnapshot(workEnv, manifest); err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(chainPath), 0755); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(ChainPath(workEnv, manifest.BcName), chainPath); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// 校验快照账本和状态机都停在清单中的区块，并重新计算区块哈希和签名
+func verifySnapshot(envConf *xconfig.EnvConf, manifest *Manifest) error {
+	chain, err := OpenChain(envConf, manifest.BcName)
+	if err != nil {
+		return err
+	}
+	defer chain.Close()
+
+	meta := chain.Ledger.GetMeta()
+	blockid := hex.EncodeToString(meta.GetTipBlockid())
+	if meta.GetTrunkHeight() != manifest.Height || blockid != manifest.Blockid ||
+		hex.EncodeToString(meta.GetRootBlockid()) != manifest.RootBlockid {
+		return fmt.Errorf("ledger meta not match manifest.height:%d,blockid:%s", meta.GetTrunkHeight(), blockid)
+	}
+	if hex.EncodeToString(chain.State.GetLatestBlockid()) != manifest.Blockid {
+		return fmt.Errorf("state not match manifest.blockid:%x", chain.State.GetLatestBlockid())
+	}
+
+	block, err := chain.Ledger.QueryBlockByHeight(manifest.Height)
+	if err != nil {
+		return fmt.Errorf("query block failed.height:%d,err:%v", manifest.Height, err)
+	}
+	realid, err := ledger.MakeBlockID(block)
+	if err != nil || !bytes.Equal(realid, block.GetBlockid()) || hex.EncodeToString(realid) != manifest.Blockid {
+		return fmt.Errorf("block hash not match.height:%d,blockid:%x", manifest.Height, block.GetBlockid())
+	}
+	// 创世块没有签名
+	if manifest.Height > 0 {
+		if ok, _ := chain.Ledger.VerifyBlock(block, utils.GenLogId()); !ok {
+			return fmt.Errorf("verify block failed.height:%d", manifest.Height)
+		}
+	}
+	return nil
+}
+
+// 多盘存储的数据不在链数据目录下，暂不支持
+func checkStorage(envConf *xconfig.EnvConf) error {
+	lcfg, err := lconf.LoadLedgerConf(envConf.GenConfFilePath(envConf.LedgerConf))
+	if err != nil {
+		return err
+	}
+	if len(lcfg.OtherPaths) > 0 {
+		return fmt.Errorf("snapshot not support multi disk storage")
+	}
+	return nil
+}
+
+// 在数据目录下创建临时链目录，导入时可以直接rename到链数据目录
+func newWorkEnv(envConf *xconfig.EnvConf) (*xconfig.EnvConf, string, error) {
+	dataDir := envConf.GenDataAbsPath("")
+	if err := os.MkdirAll(dataDir, 0755); err != nil {
+		return nil, "", err
+	}
+	workDir, err := ioutil.TempDir(dataDir, ".snapshot-")
+	if err != nil {
+		return nil, "", err
+	}
+	workEnv := *envConf
+	workEnv.ChainDir = filepath.Base(workDir)
+	return &workEnv, workDir, nil
+}
+
+// 快照只包含创世配置、账本和状态机，合约缓存在节点启动后重新生成
+func snapshotFile(rel string) bool {
+	if rel == filepath.Base(rel) {
+		return strings.HasSuffix(rel, ".json")
+	}
+	base := filepath.Base(rel)
+	if base == "LOCK" || base == "LOG" || base == "LOG.old" {
+		return false
+	}
+	dir := strings.Split(rel, string(filepath.Separator))[0]
+	return dir == def.LedgerStrgDirName || dir == def.StateStrgDirName
+}
+
+func copyChainData(src, dst string) error {
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil || !snapshotFile(rel) {
+			return err
+		}
+		return copyFile(path, filepath.Join(dst, rel))
+	})
+}
+
+func copyFile(src, dst string) error {
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return err
+	}
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// 写入tar.gz归档，先写临时文件，完成后rename
+func writeArchive(chainPath, outPath string, manifest *Manifest) error {
+	tmpPath := outPath + ".tmp"
+	f, err := os.Create(tmpPath)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpPath)
+
+	gw := gzip.NewWriter(f)
+	tw := tar.NewWriter(gw)
+	err = filepath.Walk(chainPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(chainPath, path)
+		if err != nil || !snapshotFile(rel) {
+			return err
+		}
+		sum, err := addArchiveFile(tw, path, filepath.ToSlash(rel), info)
+		if err != nil {
+			return err
+		}
+		manifest.Files = append(manifest.Files, sum)
+		return nil
+	})
+	if err == nil {
+		err = addArchiveManifest(tw, manifest)
+	}
+	if err == nil {
+		err = tw.Close()
+	}
+	if err == nil {
+		err = gw.Close()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, outPath)
+}
+
+func addArchiveFile(tw *tar.Writer, path, name string, info os.FileInfo) (*FileSum, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	hdr := &tar.Header{Name: name, Mode: 0644, Size: info.Size(), ModTime: info.ModTime()}
+	if err := tw.WriteHeader(hdr); err != nil {
+		return nil, err
+	}
+	h := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(tw, h), f); err != nil {
+		return nil, err
+	}
+	return &FileSum{Path: name, Size: info.Size(), Sha256: hex.EncodeToString(h.Sum(nil))}, nil
+}
+
+func addArchiveManifest(tw *tar.Writer, manifest *Manifest) error {
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	hdr := &tar.Header{Name: manifestName, Mode: 0644, Size: int64(len(data)), ModTime: time.Now()}
+	if err := tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+	_, err = tw.Write(data)
+	return err
+}
+
+// 解压归档到目标目录，按清单校验所有文件
+func readArchive(inPath, dst string) (*Manifest, error) {
+	f, err := os.Open(inPath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	gr, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, err
+	}
+	defer gr.Close()
+
+	var manifest *Manifest
+	sums := make(map[string]*FileSum)
+	tr := tar.NewReader(gr)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			return nil, fmt.Errorf("unexpected entry type.name:%s", hdr.Name)
+		}
+
+		if hdr.Name == manifestName {
+			manifest = new(Manifest)
+			if err := json.NewDecoder(tr).Decode(manifest); err != nil {
+				return nil, fmt.Errorf("parse manifest failed.err:%v", err)
+			}
+			continue
+		}
+		rel := filepath.FromSlash(hdr.Name)
+		if filepath.IsAbs(rel) || rel != filepath.Clean(rel) || strings.HasPrefix(rel, "..") || !snapshotFile(rel) {
+			return nil, fmt.Errorf("unexpected file.name:%s", hdr.Name)
+		}
+		sum, err := extractFile(tr, filepath.Join(dst, rel))
+		if err != nil {
+			return nil, err
+		}
+		sum.Path = hdr.Name
+		sums[hdr.Name] = sum
+	}
+	if manifest == nil || manifest.BcName == "" {
+		return nil, fmt.Errorf("manifest not found")
+	}
+
+	// 清单和归档中的文件必须完全一致
+	if len(manifest.Files) != len(sums) {
+		return nil, fmt.Errorf("file count not match manifest.files:%d,manifest:%d", len(sums), len(manifest.Files))
+	}
+	for _, want := range manifest.Files {
+		got, ok := sums[want.Path]
+		if !ok || got.Size != want.Size || got.Sha256 != want.Sha256 {
+			return nil, fmt.Errorf("checksum not match.file:%s", want.Path)
+		}
+	}
+	return manifest, nil
+}
+
+func extractFile(r io.Reader, path string) (*FileSum, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, err
+	}
+	out, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_EXCL, 0644)
+	if err != nil {
+		return nil, err
+	}
+	defer out.Close()
+
+	h := sha256.New()
+	size, err := io.Copy(io.MultiWriter(out, h), r)
+	if err != nil {
+		return nil, err
+	}
+	return &FileSum{Size: size, Sha256: hex.EncodeToString(h.Sum(nil))}, nil
+}
@@ -0,0 +1,194 @@
+package chaindata
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/tx"
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/utils"
+	lpb "github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
+	"github.com/xuperchain/xupercore/kernel/common/xconfig"
+	cryptoClient "github.com/xuperchain/xupercore/lib/crypto/client"
+	"github.com/xuperchain/xupercore/lib/logs"
+
+	"github.com/xuperchain/xuperos/common/scaffold"
+)
+
+func newTestNode(t *testing.T, root string) *xconfig.EnvConf {
+	conf := scaffold.GetDefNodeConf()
+	conf.RootPath = root
+	if _, err := scaffold.InitNode(conf); err != nil {
+		t.Fatal(err)
+	}
+	envConf, err := xconfig.LoadEnvConf(filepath.Join(root, "conf", "env.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	logs.InitLog(envConf.GenConfFilePath(envConf.LogConf), envConf.GenDirAbsPath(envConf.LogDir))
+	return envConf
+}
+
+// 使用节点账户在主干上追加只包含出块奖励的区块
+func appendTestBlocks(t *testing.T, envConf *xconfig.EnvConf, root string, count int) {
+	chain, err := OpenChain(envConf, "xuper")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer chain.Close()
+
+	keyDir := filepath.Join(root, "data", "keys")
+	address, err := ioutil.ReadFile(filepath.Join(keyDir, "address"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sk, err := ioutil.ReadFile(filepath.Join(keyDir, "private.key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesis := chain.Ledger.GetGenesisBlock()
+	crypt, err := cryptoClient.CreateCryptoClient(genesis.GetConfig().GetCryptoType())
+	if err != nil {
+		t.Fatal(err)
+	}
+	privateKey, err := crypt.GetEcdsaPrivateKeyFromJsonStr(string(sk))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < count; i++ {
+		meta := chain.Ledger.GetMeta()
+		height := meta.GetTrunkHeight() + 1
+		awardTx, err := tx.GenerateAwardTx(string(address), genesis.CalcAward(height).String(), []byte("award"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		block, err := chain.Ledger.FormatMinerBlock([]*lpb.Transaction{awardTx}, address, privateKey,
+			time.Now().UnixNano(), 0, 0, meta.GetTipBlockid(), 0, chain.State.GetTotal(), nil, nil, height)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if status := chain.Ledger.ConfirmBlock(block, false); !status.Succ {
+			t.Fatalf("confirm block failed.height:%d", height)
+		}
+		if err := chain.State.PlayForMiner(block.GetBlockid()); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestSnapshot(t *testing.T) {
+	root, err := ioutil.TempDir("", "chaindata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	srcEnv := newTestNode(t, filepath.Join(root, "src"))
+	genesis := scaffold.GenesisPath(filepath.Join(root, "src"), "xuper")
+	if err := utils.CreateLedger("xuper", genesis, srcEnv); err != nil {
+		t.Fatal(err)
+	}
+
+	appendTestBlocks(t, srcEnv, filepath.Join(root, "src"), 3)
+
+	archive := filepath.Join(root, "xuper.snapshot.tar.gz")
+	if _, err := ExportSnapshot(srcEnv, "xuper", 4, archive); err == nil {
+		t.Fatal("export height higher than trunk should fail")
+	}
+	// 导出中间高度，在副本上裁剪，节点数据不变
+	manifest, err := ExportSnapshot(srcEnv, "xuper", 2, archive)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if manifest.Height != 2 || len(manifest.Files) == 0 {
+		t.Fatalf("manifest error.manifest:%+v", manifest)
+	}
+	src, err := OpenChain(srcEnv, "xuper")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectBlock, _ := src.Ledger.QueryBlockByHeight(2)
+	srcHeight := src.Ledger.GetMeta().GetTrunkHeight()
+	src.Close()
+	if srcHeight != 3 {
+		t.Fatalf("source ledger changed after export.height:%d", srcHeight)
+	}
+
+	dstEnv := newTestNode(t, filepath.Join(root, "dst"))
+	if _, err := ImportSnapshot(dstEnv, archive, "", []byte{0x01}); err == nil {
+		t.Fatal("import with wrong trust block should fail")
+	}
+	if _, err := ImportSnapshot(dstEnv, archive, "hello", nil); err == nil {
+		t.Fatal("import with wrong chain name should fail")
+	}
+	if _, err := ImportSnapshot(dstEnv, archive, "xuper", nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ImportSnapshot(dstEnv, archive, "xuper", nil); err == nil {
+		t.Fatal("import existing chain should fail")
+	}
+
+	chain, err := OpenChain(dstEnv, "xuper")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer chain.Close()
+	meta := chain.Ledger.GetMeta()
+	if meta.GetTrunkHeight() != 2 || !bytes.Equal(meta.GetTipBlockid(), expectBlock.GetBlockid()) ||
+		!bytes.Equal(chain.State.GetLatestBlockid(), expectBlock.GetBlockid()) {
+		t.Fatal("imported ledger height error")
+	}
+	if _, err := chain.Ledger.QueryBlockByHeight(3); err == nil {
+		t.Fatal("block above snapshot height not truncated")
+	}
+
+	// 临时目录需要清理
+	dirs, _ := filepath.Glob(filepath.Join(dstEnv.GenDataAbsPath(""), ".snapshot-*"))
+	if len(dirs) != 0 {
+		t.Fatal("work dir not removed")
+	}
+}
+
+func TestImportSnapshotChainName(t *testing.T) {
+	root, err := ioutil.TempDir("", "chaindata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	srcEnv := newTestNode(t, filepath.Join(root, "src"))
+	genesis := scaffold.GenesisPath(filepath.Join(root, "src"), "xuper")
+	if err := utils.CreateLedger("xuper", genesis, srcEnv); err != nil {
+		t.Fatal(err)
+	}
+
+	// 清单中的链名称指向数据目录之外
+	archive := filepath.Join(root, "evil.snapshot.tar.gz")
+	manifest := &Manifest{BcName: "../../evil"}
+	if err := writeArchive(ChainPath(srcEnv, "xuper"), archive, manifest); err != nil {
+		t.Fatal(err)
+	}
+	dstEnv := newTestNode(t, filepath.Join(root, "dst"))
+	if _, err := ImportSnapshot(dstEnv, archive, "", nil); err == nil {
+		t.Fatal("import snapshot with invalid chain name should fail")
+	}
+	matches, _ := filepath.Glob(filepath.Join(root, "*", "evil"))
+	if _, err := os.Stat(filepath.Join(root, "evil")); err == nil || len(matches) > 0 {
+		t.Fatal("snapshot written outside data dir")
+	}
+
+	for _, name := range []string{"", ".", "..", "a/b", `a\b`, "../x", "a..b", ".hidden"} {
+		if CheckChainName(name) == nil {
+			t.Errorf("chain name %q should be invalid", name)
+		}
+	}
+	for _, name := range []string{"xuper", "hello_1", "para-chain.v2"} {
+		if err := CheckChainName(name); err != nil {
+			t.Error(err)
+		}
+	}
+}
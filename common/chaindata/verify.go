@@ -0,0 +1,335 @@
+package chaindata
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"path/filepath"
+	"sort"
+
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/def"
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/ledger"
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/state"
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/state/utxo"
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/state/utxo/txhash"
+	lpb "github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
+	"github.com/xuperchain/xupercore/kernel/common/xconfig"
+	"github.com/xuperchain/xupercore/lib/storage/kvdb"
+	"github.com/xuperchain/xupercore/lib/utils"
+)
+
+// VerifyResult 账本校验结果
+type VerifyResult struct {
+	BcName  string `json:"bcName"`
+	Start   int64  `json:"start"`
+	End     int64  `json:"end"`
+	Checked int64  `json:"checked"`
+	// BadHeight 第一个异常区块高度，-1表示没有发现异常
+	BadHeight int64  `json:"badHeight"`
+	Reason    string `json:"reason,omitempty"`
+	// Utxo utxo表校验结果，没有校验utxo时为空
+	Utxo *UtxoResult `json:"utxo,omitempty"`
+}
+
+// UtxoResult utxo表和回放主干区块输出的比对结果
+type UtxoResult struct {
+	// Height 回放到的状态机最新区块高度
+	Height   int64  `json:"height"`
+	Total    int    `json:"total"`
+	Mismatch int    `json:"mismatch"`
+	Reason   string `json:"reason,omitempty"`
+}
+
+// IsGood 校验区间内没有发现异常
+func (r *VerifyResult) IsGood() bool {
+	return r.BadHeight < 0 && (r.Utxo == nil || r.Utxo.Mismatch == 0)
+}
+
+func (r *VerifyResult) fail(height int64, format string, args ...interface{}) *VerifyResult {
+	r.BadHeight = height
+	r.Reason = fmt.Sprintf(format, args...)
+	return r
+}
+
+// VerifyChain 离线校验链数据，checkUtxo为true时同时从创世块回放主干区块输出和utxo表比对
+func VerifyChain(envConf *xconfig.EnvConf, bcName string, start, end int64, checkUtxo bool) (*VerifyResult, error) {
+	var table map[string]*utxo.UtxoItem
+	if checkUtxo {
+		// 状态机打开后独占数据库，需要先读取utxo表
+		var err error
+		table, err = loadUtxoTable(envConf, bcName)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	chain, err := OpenChain(envConf, bcName)
+	if err != nil {
+		return nil, err
+	}
+	defer chain.Close()
+
+	result, err := chain.VerifyBlocks(start, end)
+	if err != nil || !checkUtxo {
+		return result, err
+	}
+	result.Utxo, err = chain.verifyUtxo(table, result.BadHeight)
+	return result, err
+}
+
+// VerifyBlocks 校验主干[start, end]高度区间内区块，end为-1表示到最新区块。
+// 重新计算区块id和交易id，校验默克尔根、区块高度和前后哈希链接
+func (c *Chain) VerifyBlocks(start, end int64) (*VerifyResult, error) {
+	meta := c.Ledger.GetMeta()
+	if end < 0 {
+		end = meta.GetTrunkHeight()
+	}
+	if start < 0 || start > end || end > meta.GetTrunkHeight() {
+		return nil, fmt.Errorf("height range error.start:%d,end:%d,trunkHeight:%d",
+			start, end, meta.GetTrunkHeight())
+	}
+
+	result := &VerifyResult{BcName: c.BcName, Start: start, End: end, BadHeight: -1}
+	var pre *lpb.InternalBlock
+	if start > 0 {
+		// 区间第一个区块也要和前序区块链接
+		block, err := c.Ledger.QueryBlockByHeight(start - 1)
+		if err != nil {
+			return result.fail(start-1, "query block failed.err:%v", err), nil
+		}
+		pre = block
+	}
+
+	for height := start; height <= end; height++ {
+		block, err := c.Ledger.QueryBlockByHeight(height)
+		if err != nil {
+			return result.fail(height, "query block failed.err:%v", err), nil
+		}
+		if reason := checkLink(meta, pre, block, height); reason != "" {
+			return result.fail(height, "%s", reason), nil
+		}
+		if reason := checkBlock(block); reason != "" {
+			return result.fail(height, "%s", reason), nil
+		}
+		pre = block
+		result.Checked++
+	}
+	return result, nil
+}
+
+// 校验区块在主干上的位置和前序区块链接，返回异常原因
+func checkLink(meta *lpb.LedgerMeta, pre, block *lpb.InternalBlock, height int64) string {
+	if block.GetHeight() != height {
+		return fmt.Sprintf("block height mismatch.expect:%d,got:%d", height, block.GetHeight())
+	}
+	if !block.GetInTrunk() {
+		return fmt.Sprintf("block not in trunk.blockid:%x", block.GetBlockid())
+	}
+	if height == 0 && !bytes.Equal(block.GetBlockid(), meta.GetRootBlockid()) {
+		return fmt.Sprintf("root block mismatch.expect:%x,got:%x", meta.GetRootBlockid(), block.GetBlockid())
+	}
+	if height == meta.GetTrunkHeight() && !bytes.Equal(block.GetBlockid(), meta.GetTipBlockid()) {
+		return fmt.Sprintf("tip block mismatch.expect:%x,got:%x", meta.GetTipBlockid(), block.GetBlockid())
+	}
+	if pre == nil {
+		return ""
+	}
+	if !bytes.Equal(block.GetPreHash(), pre.GetBlockid()) {
+		return fmt.Sprintf("pre hash mismatch.expect:%x,got:%x", pre.GetBlockid(), block.GetPreHash())
+	}
+	if !bytes.Equal(pre.GetNextHash(), block.GetBlockid()) {
+		return fmt.Sprintf("next hash of pre block mismatch.expect:%x,got:%x", block.GetBlockid(), pre.GetNextHash())
+	}
+	return ""
+}
+
+// 重新计算区块id、交易id和默克尔根，返回异常原因
+func checkBlock(block *lpb.InternalBlock) string {
+	blockid, err := ledger.MakeBlockID(block)
+	if err != nil {
+		return fmt.Sprintf("make block id failed.err:%v", err)
+	}
+	if !bytes.Equal(blockid, block.GetBlockid()) {
+		return fmt.Sprintf("block id mismatch.expect:%x,got:%x", blockid, block.GetBlockid())
+	}
+	if int(block.GetTxCount()) != len(block.GetTransactions()) {
+		return fmt.Sprintf("tx count mismatch.expect:%d,got:%d", block.GetTxCount(), len(block.GetTransactions()))
+	}
+	for i, tx := range block.GetTransactions() {
+		// 与adapter的MakeTxId使用相同的摘要算法
+		txid, err := txhash.MakeTransactionID(tx)
+		if err != nil {
+			return fmt.Sprintf("make tx id failed.txid:%x,err:%v", tx.GetTxid(), err)
+		}
+		if !bytes.Equal(txid, tx.GetTxid()) {
+			return fmt.Sprintf("tx id mismatch.expect:%x,got:%x", txid, tx.GetTxid())
+		}
+		if !bytes.Equal(txid, block.GetMerkleTree()[i]) {
+			return fmt.Sprintf("tx not match merkle leaf.index:%d,txid:%x", i, txid)
+		}
+	}
+	if err := ledger.VerifyMerkle(block); err != nil {
+		return err.Error()
+	}
+	return ""
+}
+
+// 回放主干区块和未确认交易的输出，与utxo表逐条比对
+func (c *Chain) verifyUtxo(table map[string]*utxo.UtxoItem, badHeight int64) (*UtxoResult, error) {
+	result := &UtxoResult{Height: -1, Total: len(table)}
+	tip, err := c.Ledger.QueryBlockHeader(c.State.GetLatestBlockid())
+	if err != nil || !tip.GetInTrunk() {
+		// 切换分支过程中节点退出，启动时状态机会walk到主干，不算数据异常
+		result.Reason = fmt.Sprintf("state tip block not in trunk, skipped.blockid:%x",
+			c.State.GetLatestBlockid())
+		return result, nil
+	}
+	result.Height = tip.GetHeight()
+	if badHeight >= 0 && badHeight <= tip.GetHeight() {
+		result.Reason = fmt.Sprintf("block at height %d corrupted, skipped", badHeight)
+		return result, nil
+	}
+
+	replayed := make(map[string]*utxo.UtxoItem)
+	for height := int64(0); height <= tip.GetHeight(); height++ {
+		block, err := c.Ledger.QueryBlockByHeight(height)
+		if err != nil {
+			return nil, fmt.Errorf("query block failed.height:%d,err:%v", height, err)
+		}
+		for _, tx := range block.GetTransactions() {
+			if err := replayTx(replayed, tx, block.GetProposer()); err != nil {
+				result.Mismatch++
+				result.Reason = fmt.Sprintf("replay block failed.height:%d,err:%v", height, err)
+				return result, nil
+			}
+		}
+	}
+	// 未确认交易已经按依赖关系排序，小费在打包后才支付给矿工
+	txs, err := c.State.GetUnconfirmedTx(true)
+	if err != nil {
+		return nil, fmt.Errorf("query unconfirmed tx failed.err:%v", err)
+	}
+	for _, tx := range txs {
+		if err := replayTx(replayed, tx, nil); err != nil {
+			result.Mismatch++
+			result.Reason = fmt.Sprintf("replay unconfirmed tx failed.err:%v", err)
+			return result, nil
+		}
+	}
+
+	keys := make([]string, 0, len(table)+len(replayed))
+	for key := range table {
+		keys = append(keys, key)
+	}
+	for key := range replayed {
+		if _, ok := table[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		reason := compareUtxo(key, replayed[key], table[key])
+		if reason == "" {
+			continue
+		}
+		if result.Mismatch == 0 {
+			result.Reason = reason
+		}
+		result.Mismatch++
+	}
+	return result, nil
+}
+
+// 与状态机执行交易时的utxo变更保持一致，proposer为空时不支付小费
+func replayTx(replayed map[string]*utxo.UtxoItem, tx *lpb.Transaction, proposer []byte) error {
+	for _, txInput := range tx.GetTxInputs() {
+		key := utxo.GenUtxoKey(txInput.GetFromAddr(), txInput.GetRefTxid(), txInput.GetRefOffset())
+		if _, ok := replayed[key]; !ok {
+			return fmt.Errorf("spend unknown utxo.txid:%x,utxo:%s", tx.GetTxid(), key)
+		}
+		delete(replayed, key)
+	}
+	for offset, txOutput := range tx.GetTxOutputs() {
+		amount := new(big.Int).SetBytes(txOutput.GetAmount())
+		addr := txOutput.GetToAddr()
+		if bytes.Equal(addr, []byte(state.FeePlaceholder)) {
+			if proposer == nil {
+				continue
+			}
+			addr = proposer
+			replayed[utxo.GenUtxoKey(addr, tx.GetTxid(), int32(offset))] = &utxo.UtxoItem{Amount: amount}
+			continue
+		}
+		if amount.Sign() == 0 {
+			continue
+		}
+		replayed[utxo.GenUtxoKey(addr, tx.GetTxid(), int32(offset))] = &utxo.UtxoItem{
+			Amount:       amount,
+			FrozenHeight: txOutput.GetFrozenHeight(),
+		}
+	}
+	return nil
+}
+
+func compareUtxo(key string, expect, got *utxo.UtxoItem) string {
+	switch {
+	case got == nil:
+		return fmt.Sprintf("utxo missing in table.utxo:%s", key)
+	case expect == nil:
+		return fmt.Sprintf("utxo not produced by any tx.utxo:%s", key)
+	case expect.Amount.Cmp(got.Amount) != 0:
+		return fmt.Sprintf("utxo amount mismatch.utxo:%s,expect:%s,got:%s", key, expect.Amount, got.Amount)
+	case expect.FrozenHeight != got.FrozenHeight:
+		return fmt.Sprintf("utxo frozen height mismatch.utxo:%s,expect:%d,got:%d",
+			key, expect.FrozenHeight, got.FrozenHeight)
+	}
+	return ""
+}
+
+// 直接打开状态机数据库，节点运行时数据库被占用会打开失败
+func openStateDB(envConf *xconfig.EnvConf, bcName string) (kvdb.Database, error) {
+	if !utils.FileIsExist(ChainPath(envConf, bcName)) {
+		return nil, fmt.Errorf("chain not exist.bcName:%s", bcName)
+	}
+	lctx, err := ledger.NewLedgerCtx(envConf, bcName)
+	if err != nil {
+		return nil, err
+	}
+	db, err := kvdb.CreateKVInstance(&kvdb.KVParameter{
+		DBPath:                filepath.Join(ChainPath(envConf, bcName), def.StateStrgDirName),
+		KVEngineType:          lctx.LedgerCfg.KVEngineType,
+		StorageType:           lctx.LedgerCfg.StorageType,
+		MemCacheSize:          ledger.MemCacheSize,
+		FileHandlersCacheSize: ledger.FileHandlersCacheSize,
+		OtherPaths:            lctx.LedgerCfg.OtherPaths,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("open state db failed, make sure node is stopped.bcName:%s,err:%v", bcName, err)
+	}
+	return db, nil
+}
+
+// 读取utxo表，key与utxo.GenUtxoKey格式相同
+func loadUtxoTable(envConf *xconfig.EnvConf, bcName string) (map[string]*utxo.UtxoItem, error) {
+	db, err := openStateDB(envConf, bcName)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	table := make(map[string]*utxo.UtxoItem)
+	it := db.NewIteratorWithPrefix([]byte(lpb.UTXOTablePrefix))
+	defer it.Release()
+	for it.Next() {
+		key := string(it.Key()[len(lpb.UTXOTablePrefix):])
+		item := new(utxo.UtxoItem)
+		if err := item.Loads(it.Value()); err != nil {
+			return nil, fmt.Errorf("load utxo item failed.utxo:%s,err:%v", key, err)
+		}
+		table[key] = item
+	}
+	if it.Error() != nil {
+		return nil, it.Error()
+	}
+	return table, nil
+}
@@ -0,0 +1,83 @@
+package chaindata
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/utils"
+	lpb "github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
+
+	"github.com/xuperchain/xuperos/common/scaffold"
+)
+
+func TestVerifyChain(t *testing.T) {
+	root, err := ioutil.TempDir("", "chaindata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	envConf := newTestNode(t, root)
+	if err := utils.CreateLedger("xuper", scaffold.GenesisPath(root, "xuper"), envConf); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := VerifyChain(envConf, "xuper", 0, 1, false); err == nil {
+		t.Fatal("verify height higher than trunk should fail")
+	}
+	result, err := VerifyChain(envConf, "xuper", 0, -1, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result.IsGood() || result.Checked != 1 || result.Utxo == nil || result.Utxo.Total == 0 {
+		t.Fatalf("verify result error.result:%+v,utxo:%+v", result, result.Utxo)
+	}
+
+	// 写入一条不是交易产生的utxo
+	db, err := openStateDB(envConf, "xuper")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Put([]byte(lpb.UTXOTablePrefix+"alice_01_0"), []byte(`{"Amount":1}`)); err != nil {
+		t.Fatal(err)
+	}
+	db.Close()
+	result, err = VerifyChain(envConf, "xuper", 0, -1, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.IsGood() || result.BadHeight != -1 || result.Utxo.Mismatch != 1 {
+		t.Fatalf("utxo mismatch not found.result:%+v,utxo:%+v", result, result.Utxo)
+	}
+
+	// 篡改创世块交易
+	chain, err := OpenChain(envConf, "xuper")
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, err := chain.Ledger.QueryBlockByHeight(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx := block.GetTransactions()[0]
+	tx.Nonce = "tampered"
+	buf, err := proto.Marshal(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := append([]byte(lpb.ConfirmedTablePrefix), tx.GetTxid()...)
+	if err := chain.Ledger.GetBaseDB().Put(key, buf); err != nil {
+		t.Fatal(err)
+	}
+	chain.Close()
+
+	result, err = VerifyChain(envConf, "xuper", 0, -1, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.IsGood() || result.BadHeight != 0 || result.Utxo.Mismatch != 0 {
+		t.Fatalf("tampered tx not found.result:%+v,utxo:%+v", result, result.Utxo)
+	}
+}
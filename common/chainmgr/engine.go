@@ -0,0 +1,237 @@
+package chainmgr
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/xuperchain/xupercore/kernel/engines"
+	"github.com/xuperchain/xupercore/kernel/engines/xuperos"
+	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+	xnet "github.com/xuperchain/xupercore/kernel/engines/xuperos/net"
+	"github.com/xuperchain/xupercore/kernel/network/p2p"
+	"github.com/xuperchain/xupercore/lib/logs"
+	"github.com/xuperchain/xupercore/protos"
+
+	"github.com/xuperchain/xuperos/common/def"
+	"github.com/xuperchain/xuperos/common/loglevel"
+)
+
+var (
+	// ErrChainInUse 卸载链时等待使用结束超时，存储在使用结束后关闭
+	ErrChainInUse = &ecom.Error{Status: ecom.ErrStatusInternalErr, Code: 50207, Msg: "chain still in use"}
+)
+
+// Engine 在内核引擎之上支持运行时加载和卸载链
+// 内核引擎只在启动时加载root链，且不对外提供修改链实例的接口，运行时加载的链由Engine管理，
+// Get和GetChains同时返回两部分的链，服务层通过Engine访问链
+type Engine struct {
+	ecom.Engine
+	log  logs.Logger
+	lock sync.RWMutex
+	// 运行时加载的链
+	chains   map[string]*loadedChain
+	exitOnce sync.Once
+}
+
+// 运行时加载的链实例，按使用计数，卸载时等待使用结束再关闭存储
+type loadedChain struct {
+	chain ecom.Chain
+	users sync.WaitGroup
+	// 按链名订阅的p2p消息
+	subs     []p2p.Subscriber
+	msgChan  chan *protos.XuperMessage
+	exitChan chan struct{}
+}
+
+func NewEngine(engine engines.BCEngine) (*Engine, error) {
+	xosEngine, err := xuperos.EngineConvert(engine)
+	if err != nil {
+		return nil, fmt.Errorf("not xuperos engine")
+	}
+
+	log, _ := loglevel.NewLogger("", def.SubModName)
+	obj := &Engine{
+		Engine: xosEngine,
+		log:    log,
+		chains: make(map[string]*loadedChain),
+	}
+	return obj, nil
+}
+
+// AcquireChain 从服务层持有的引擎获取链，不是Engine时（如直接使用内核引擎）不计数
+func AcquireChain(engine ecom.Engine, name string) (ecom.Chain, func(), error) {
+	if mg, ok := engine.(*Engine); ok {
+		return mg.AcquireChain(name)
+	}
+
+	chain, err := engine.Get(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	return chain, func() {}, nil
+}
+
+// Get 获取链实例，优先查找内核引擎加载的链
+func (t *Engine) Get(name string) (ecom.Chain, error) {
+	if chain, err := t.Engine.Get(name); err == nil {
+		return chain, nil
+	}
+
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	if c, ok := t.chains[name]; ok {
+		return c.chain, nil
+	}
+	return nil, ecom.ErrChainNotExist
+}
+
+// GetChains 返回内核引擎加载的链和运行时加载的链
+func (t *Engine) GetChains() []string {
+	chains := t.Engine.GetChains()
+
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	for name := range t.chains {
+		chains = append(chains, name)
+	}
+	return chains
+}
+
+// AcquireChain 获取链并记录使用，使用结束后必须调用release
+// 卸载链时先移除，之后无法再获取，等待已获取的使用全部结束后才关闭存储
+// 内核引擎加载的链不能卸载，不需要计数
+func (t *Engine) AcquireChain(name string) (ecom.Chain, func(), error) {
+	if chain, err := t.Engine.Get(name); err == nil {
+		return chain, func() {}, nil
+	}
+
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	c, ok := t.chains[name]
+	if !ok {
+		return nil, nil, ecom.ErrChainNotExist
+	}
+	c.users.Add(1)
+
+	var once sync.Once
+	release := func() {
+		once.Do(c.users.Done)
+	}
+	return c.chain, release, nil
+}
+
+// LoadChain 运行时加载数据目录下已创建的链并启动
+func (t *Engine) LoadChain(name string) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if _, err := t.Engine.Get(name); err == nil {
+		return ecom.ErrChainExist
+	}
+	if _, ok := t.chains[name]; ok {
+		return ecom.ErrChainExist
+	}
+	envCfg := t.Context().EnvCfg
+	chainDir := filepath.Join(envCfg.GenDataAbsPath(envCfg.ChainDir), name)
+	if fInfo, err := os.Stat(chainDir); err != nil || !fInfo.IsDir() {
+		return ecom.ErrChainNotExist
+	}
+
+	chain, err := xuperos.LoadChain(t.Context(), name)
+	if err != nil {
+		t.log.Error("load chain failed", "chain", name, "err", err)
+		return ecom.ErrLoadChainFailed.More("%v", err)
+	}
+	c := &loadedChain{
+		chain:    chain,
+		msgChan:  make(chan *protos.XuperMessage, xnet.DefMsgChanBufSize),
+		exitChan: make(chan struct{}),
+	}
+	if err := t.subscribe(name, c); err != nil {
+		t.log.Error("subscribe chain message failed", "chain", name, "err", err)
+		closeChain(chain)
+		return ecom.ErrLoadChainFailed.More("%v", err)
+	}
+	t.chains[name] = c
+
+	go t.procMsgLoop(c)
+	go chain.Start()
+
+	t.log.Info("load chain succ", "chain", name)
+	return nil
+}
+
+// UnloadChain 运行时卸载链，只能卸载运行时加载的链
+// 链移除并停止后，等待已获取的使用结束再关闭账本和状态机存储
+// 超时返回ErrChainInUse，存储在使用结束后异步关闭
+func (t *Engine) UnloadChain(name string, timeout time.Duration) error {
+	if _, err := t.Engine.Get(name); err == nil {
+		return ecom.ErrForbidden.More("chain loaded at startup can not be unloaded")
+	}
+
+	t.lock.Lock()
+	c, ok := t.chains[name]
+	if !ok {
+		t.lock.Unlock()
+		return ecom.ErrChainNotExist
+	}
+	delete(t.chains, name)
+	t.lock.Unlock()
+
+	t.stopChain(c)
+
+	drained := make(chan struct{})
+	go func() {
+		c.users.Wait()
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+		closeChain(c.chain)
+		t.log.Info("unload chain succ", "chain", name)
+		return nil
+	case <-time.After(timeout):
+		t.log.Warn("wait chain users timeout, close chain later", "chain", name)
+		go func() {
+			<-drained
+			closeChain(c.chain)
+			t.log.Info("unload chain succ", "chain", name)
+		}()
+		return ErrChainInUse
+	}
+}
+
+// Exit 停止运行时加载的链后退出内核引擎，需要幂等
+func (t *Engine) Exit() {
+	t.exitOnce.Do(func() {
+		t.lock.Lock()
+		chains := t.chains
+		t.chains = make(map[string]*loadedChain)
+		t.lock.Unlock()
+
+		for name, c := range chains {
+			t.stopChain(c)
+			t.log.Trace("chain " + name + " stopped")
+		}
+		t.Engine.Exit()
+	})
+}
+
+// 取消消息订阅并停止矿工
+func (t *Engine) stopChain(c *loadedChain) {
+	t.unsubscribe(c)
+	close(c.exitChan)
+	c.chain.Stop()
+}
+
+func closeChain(chain ecom.Chain) {
+	chain.Context().State.Close()
+	chain.Context().Ledger.Close()
+}
@@ -0,0 +1,361 @@
+package chainmgr
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	lpb "github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
+	xctx "github.com/xuperchain/xupercore/kernel/common/xcontext"
+	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+	xnet "github.com/xuperchain/xupercore/kernel/engines/xuperos/net"
+	"github.com/xuperchain/xupercore/kernel/engines/xuperos/reader"
+	"github.com/xuperchain/xupercore/kernel/engines/xuperos/xpb"
+	"github.com/xuperchain/xupercore/kernel/network/p2p"
+	"github.com/xuperchain/xupercore/lib/logs"
+	"github.com/xuperchain/xupercore/lib/timer"
+	"github.com/xuperchain/xupercore/protos"
+)
+
+// 内核引擎的网络事件处理只查找内核加载的链，运行时加载的链按链名单独订阅p2p消息
+// 处理逻辑与内核引擎一致，处理期间记录链的使用
+
+// 走异步处理的网络消息列表
+var asyncMsgTypes = []protos.XuperMessage_MessageType{
+	protos.XuperMessage_POSTTX,
+	protos.XuperMessage_SENDBLOCK,
+	protos.XuperMessage_BATCHPOSTTX,
+	protos.XuperMessage_NEW_BLOCKID,
+}
+
+func (t *Engine) subscribe(name string, c *loadedChain) error {
+	// 走同步处理的网络消息句柄
+	syncMsgHandle := map[protos.XuperMessage_MessageType]p2p.HandleFunc{
+		protos.XuperMessage_GET_BLOCK:                t.handleGetBlock,
+		protos.XuperMessage_GET_BLOCKCHAINSTATUS:     t.handleGetChainStatus,
+		protos.XuperMessage_CONFIRM_BLOCKCHAINSTATUS: t.handleConfirmChainStatus,
+	}
+
+	net := t.Context().Net
+	subs := make([]p2p.Subscriber, 0, len(asyncMsgTypes)+len(syncMsgHandle))
+	for _, msgType := range asyncMsgTypes {
+		subs = append(subs, p2p.NewSubscriber(net.Context(), msgType, c.msgChan, p2p.WithFilterBCName(name)))
+	}
+	for msgType, handle := range syncMsgHandle {
+		subs = append(subs, p2p.NewSubscriber(net.Context(), msgType, handle, p2p.WithFilterBCName(name)))
+	}
+
+	for _, sub := range subs {
+		if err := net.Register(sub); err != nil {
+			t.unsubscribe(c)
+			return fmt.Errorf("register subscriber failed.type:%v,err:%v", sub.GetMessageType(), err)
+		}
+		c.subs = append(c.subs, sub)
+	}
+	return nil
+}
+
+func (t *Engine) unsubscribe(c *loadedChain) {
+	net := t.Context().Net
+	for _, sub := range c.subs {
+		if err := net.UnRegister(sub); err != nil {
+			t.log.Warn("unregister subscriber failed", "type", sub.GetMessageType(), "err", err)
+		}
+	}
+	c.subs = nil
+}
+
+// 阻塞等待chan中消息，直到链停止
+func (t *Engine) procMsgLoop(c *loadedChain) {
+	for {
+		select {
+		case request := <-c.msgChan:
+			go t.procAsyncMsg(request)
+		case <-c.exitChan:
+			return
+		}
+	}
+}
+
+func (t *Engine) procAsyncMsg(request *protos.XuperMessage) {
+	log, _ := logs.NewLogger(request.GetHeader().GetLogid(), ecom.BCEngineName)
+	ctx := &xctx.BaseCtx{
+		XLog:  log,
+		Timer: timer.NewXTimer(),
+	}
+
+	chain, release, err := t.AcquireChain(request.GetHeader().GetBcname())
+	if err != nil {
+		ctx.GetLog().Warn("chain not exist", "error", err, "bcName", request.GetHeader().GetBcname())
+		return
+	}
+	defer release()
+
+	switch request.GetHeader().GetType() {
+	case protos.XuperMessage_POSTTX:
+		t.handlePostTx(ctx, chain, request)
+	case protos.XuperMessage_BATCHPOSTTX:
+		t.handleBatchPostTx(ctx, chain, request)
+	case protos.XuperMessage_SENDBLOCK:
+		t.handleSendBlock(ctx, chain, request)
+	case protos.XuperMessage_NEW_BLOCKID:
+		t.handleNewBlockID(ctx, chain, request)
+	default:
+		ctx.GetLog().Warn("received unregister request", "type", request.GetHeader().GetType())
+	}
+}
+
+func (t *Engine) handlePostTx(ctx xctx.XContext, chain ecom.Chain, request *protos.XuperMessage) {
+	var tx lpb.Transaction
+	if err := p2p.Unmarshal(request, &tx); err != nil {
+		ctx.GetLog().Warn("handlePostTx Unmarshal request error", "error", err)
+		return
+	}
+
+	if err := postTx(ctx, chain, &tx); err == nil {
+		go t.Context().Net.SendMessage(ctx, request)
+	}
+}
+
+func (t *Engine) handleBatchPostTx(ctx xctx.XContext, chain ecom.Chain, request *protos.XuperMessage) {
+	var input xpb.Transactions
+	if err := p2p.Unmarshal(request, &input); err != nil {
+		ctx.GetLog().Warn("handleBatchPostTx Unmarshal request error", "error", err)
+		return
+	}
+
+	broadcastTx := make([]*lpb.Transaction, 0, len(input.Txs))
+	for _, tx := range input.Txs {
+		if err := postTx(ctx, chain, tx); err != nil {
+			ctx.GetLog().Warn("post tx error", "bcName", request.GetHeader().GetBcname(), "error", err)
+			return
+		}
+		broadcastTx = append(broadcastTx, tx)
+	}
+
+	input.Txs = broadcastTx
+	msg := p2p.NewMessage(protos.XuperMessage_BATCHPOSTTX, &input,
+		p2p.WithBCName(request.GetHeader().GetBcname()))
+	go t.Context().Net.SendMessage(ctx, msg)
+}
+
+func (t *Engine) handleSendBlock(ctx xctx.XContext, chain ecom.Chain, request *protos.XuperMessage) {
+	var block lpb.InternalBlock
+	if err := p2p.Unmarshal(request, &block); err != nil {
+		ctx.GetLog().Warn("handleSendBlock Unmarshal request error", "error", err)
+		return
+	}
+
+	if err := sendBlock(ctx, chain, &block); err != nil {
+		return
+	}
+
+	net := t.Context().Net
+	if t.Context().EngCfg.BlockBroadcastMode == ecom.FullBroadCastMode {
+		go net.SendMessage(ctx, request)
+	} else {
+		blockID := &lpb.InternalBlock{
+			Blockid: block.Blockid,
+		}
+		msg := p2p.NewMessage(protos.XuperMessage_NEW_BLOCKID, blockID,
+			p2p.WithBCName(request.GetHeader().GetBcname()))
+		go net.SendMessage(ctx, msg)
+	}
+}
+
+func (t *Engine) handleNewBlockID(ctx xctx.XContext, chain ecom.Chain, request *protos.XuperMessage) {
+	block, err := t.getBlock(ctx, request)
+	if err != nil {
+		ctx.GetLog().Warn("GetBlock error", "error", err)
+		return
+	}
+
+	if err := sendBlock(ctx, chain, block); err != nil {
+		return
+	}
+
+	go t.Context().Net.SendMessage(ctx, request)
+}
+
+// 从广播新区块id的节点获取区块
+func (t *Engine) getBlock(ctx xctx.XContext, request *protos.XuperMessage) (*lpb.InternalBlock, error) {
+	var block lpb.InternalBlock
+	if err := p2p.Unmarshal(request, &block); err != nil {
+		ctx.GetLog().Warn("handleNewBlockID Unmarshal request error", "error", err)
+		return nil, ecom.ErrParameter
+	}
+
+	msgOpts := []p2p.MessageOption{
+		p2p.WithBCName(request.GetHeader().GetBcname()),
+		p2p.WithLogId(request.GetHeader().GetLogid()),
+	}
+	msg := p2p.NewMessage(protos.XuperMessage_GET_BLOCK, &block, msgOpts...)
+	responses, err := t.Context().Net.SendMessageWithResponse(ctx, msg,
+		p2p.WithPeerIDs([]string{request.GetHeader().GetFrom()}))
+	if err != nil {
+		return nil, ecom.ErrSendMessageFailed
+	}
+
+	for _, response := range responses {
+		if response.GetHeader().GetErrorType() != protos.XuperMessage_SUCCESS {
+			ctx.GetLog().Warn("GetBlock response error", "errorType", response.GetHeader().GetErrorType(),
+				"from", response.GetHeader().GetFrom())
+			continue
+		}
+
+		var block lpb.InternalBlock
+		if err := p2p.Unmarshal(response, &block); err != nil {
+			ctx.GetLog().Warn("GetBlock unmarshal error", "error", err, "from", response.GetHeader().GetFrom())
+			continue
+		}
+		return &block, nil
+	}
+
+	return nil, ecom.ErrNetworkNoResponse
+}
+
+func (t *Engine) handleGetBlock(ctx xctx.XContext, request *protos.XuperMessage) (*protos.XuperMessage, error) {
+	var input xpb.BlockID
+	var output *xpb.BlockInfo
+
+	bcName := request.GetHeader().GetBcname()
+	response := func(err error) (*protos.XuperMessage, error) {
+		return newResponse(request, output, err)
+	}
+
+	if err := p2p.Unmarshal(request, &input); err != nil {
+		ctx.GetLog().Error("unmarshal error", "bcName", bcName, "error", err)
+		return response(ecom.ErrParameter)
+	}
+
+	chain, release, err := t.AcquireChain(bcName)
+	if err != nil {
+		ctx.GetLog().Warn("chain not exist", "error", err, "bcName", bcName)
+		return response(ecom.ErrChainNotExist)
+	}
+	defer release()
+
+	output, err = reader.NewLedgerReader(chain.Context(), ctx).QueryBlock(input.Blockid, input.NeedContent)
+	if err != nil {
+		ctx.GetLog().Error("ledger reader query block error", "error", err)
+		return response(err)
+	}
+
+	ctx.GetLog().SetInfoField("height", output.Block.Height)
+	ctx.GetLog().SetInfoField("status", output.Status)
+	return response(nil)
+}
+
+func (t *Engine) handleGetChainStatus(ctx xctx.XContext, request *protos.XuperMessage) (*protos.XuperMessage, error) {
+	var output *xpb.ChainStatus
+
+	bcName := request.GetHeader().GetBcname()
+	response := func(err error) (*protos.XuperMessage, error) {
+		return newResponse(request, output, err)
+	}
+
+	chain, release, err := t.AcquireChain(bcName)
+	if err != nil {
+		ctx.GetLog().Warn("chain not exist", "error", err, "bcName", bcName)
+		return response(ecom.ErrChainNotExist)
+	}
+	defer release()
+
+	output, err = reader.NewChainReader(chain.Context(), ctx).GetChainStatus()
+	if err != nil {
+		ctx.GetLog().Error("handleGetChainStatus error", "error", err)
+		return response(err)
+	}
+
+	return response(nil)
+}
+
+func (t *Engine) handleConfirmChainStatus(ctx xctx.XContext, request *protos.XuperMessage) (*protos.XuperMessage, error) {
+	var input lpb.InternalBlock
+	var output *xpb.TipStatus
+
+	bcName := request.GetHeader().GetBcname()
+	response := func(err error) (*protos.XuperMessage, error) {
+		return newResponse(request, output, err)
+	}
+
+	if err := p2p.Unmarshal(request, &input); err != nil {
+		ctx.GetLog().Error("unmarshal error", "bcName", bcName, "error", err)
+		return response(ecom.ErrParameter)
+	}
+
+	chain, release, err := t.AcquireChain(bcName)
+	if err != nil {
+		ctx.GetLog().Warn("chain not exist", "error", err, "bcName", bcName)
+		return response(ecom.ErrChainNotExist)
+	}
+	defer release()
+
+	chainStatus, err := reader.NewChainReader(chain.Context(), ctx).GetChainStatus()
+	if err != nil {
+		ctx.GetLog().Error("handleConfirmChainStatus error", "bcName", bcName, "error", err)
+		return response(err)
+	}
+
+	output = &xpb.TipStatus{
+		IsTrunkTip: bytes.Equal(input.Blockid, chainStatus.LedgerMeta.TipBlockid),
+	}
+	return response(nil)
+}
+
+// 同步消息的响应，err不为nil时订阅方不回复
+func newResponse(request *protos.XuperMessage, output proto.Message, err error) (*protos.XuperMessage, error) {
+	opts := []p2p.MessageOption{
+		p2p.WithBCName(request.GetHeader().GetBcname()),
+		p2p.WithErrorType(xnet.ErrorType(err)),
+		p2p.WithLogId(request.GetHeader().GetLogid()),
+	}
+	resp := p2p.NewMessage(p2p.GetRespMessageType(request.GetHeader().GetType()), output, opts...)
+	return resp, err
+}
+
+func postTx(ctx xctx.XContext, chain ecom.Chain, tx *lpb.Transaction) error {
+	if err := validatePostTx(tx); err != nil {
+		ctx.GetLog().Trace("PostTx validate param errror", "error", err)
+		return ecom.CastError(err)
+	}
+
+	if len(tx.TxInputs) == 0 && !chain.Context().Ledger.GetNoFee() {
+		ctx.GetLog().Warn("TxInputs can not be null while need utxo")
+		return ecom.ErrTxNotEnough
+	}
+
+	return chain.SubmitTx(ctx, tx)
+}
+
+func sendBlock(ctx xctx.XContext, chain ecom.Chain, block *lpb.InternalBlock) error {
+	if block == nil || len(block.Blockid) == 0 {
+		ctx.GetLog().Trace("SendBlock validate param error")
+		return ecom.ErrParameter
+	}
+
+	if err := chain.ProcBlock(ctx, block); err != nil {
+		ctx.GetLog().Warn("process block error", "error", err)
+		return err
+	}
+
+	ledgerMeta := chain.Context().Ledger.GetMeta()
+	ctx.GetLog().Info("SendBlock", "height", ledgerMeta.TrunkHeight)
+	return nil
+}
+
+// 与内核一致，先对交易做一次序列化，避免pb和json对空byte数组处理不同导致txid校验失败
+func validatePostTx(tx *lpb.Transaction) error {
+	if tx == nil || len(tx.Txid) == 0 {
+		return fmt.Errorf("validation error: tx can't be null")
+	}
+
+	buf, err := proto.Marshal(tx)
+	if err != nil {
+		return fmt.Errorf("validation error: tx info is invaild")
+	}
+	if err := proto.Unmarshal(buf, tx); err != nil {
+		return fmt.Errorf("validation error: tx info is invaild")
+	}
+	return nil
+}
@@ -2,29 +2,99 @@ package config
 
 import (
 	"fmt"
+	"net"
+	"strconv"
+	"time"
 
 	"github.com/xuperchain/xupercore/lib/utils"
 
 	"github.com/spf13/viper"
 )
 
+// 服务监听配置
+type ListenConf struct {
+	// 监听地址，为空监听所有地址，支持ipv6地址
+	Address string `yaml:"address,omitempty"`
+	Port    int    `yaml:"port,omitempty"`
+	Tls     bool   `yaml:"tls,omitempty"`
+	// 允许访问的方法，grpc服务为完整方法名，gateway为url路径，eth服务为json-rpc方法名，为空不限制
+	AllowMethods []string `yaml:"allowMethods,omitempty"`
+}
+
+// 监听地址，ipv6地址会加上方括号
+func (t *ListenConf) Addr() string {
+	return net.JoinHostPort(t.Address, strconv.Itoa(t.Port))
+}
+
 type ServConf struct {
-	// rpc server listen port
-	RpcPort            int    `yaml:"rpcPort,omitempty"`
-	AdapterRpcPort     int    `yaml:"adapterRpcPort,omitempty"`
-	AdapterGWPort      int    `yaml:"adapterGWPort,omitempty"`
-	MetricPort         int    `yaml:"metricPort,omitempty"`
-	EnableMetric       bool   `yaml:"enableMetric,omitempty"`
-	EnableTls          bool   `yaml:"enableTls,omitempty"`
-	EnableAdapter      bool   `yaml:"enableAdapter,omitempty"`
-	EnableEndorser     bool   `yaml:"enableEndorser,omitempty"`
-	AdapterAllowCROS   bool   `yaml:"adapterAllowCROS,omitempty"`
-	MaxMsgSize         int    `yaml:"maxMsgSize,omitempty"`
-	ReadBufSize        int    `yaml:"readBufSize,omitempty"`
-	WriteBufSize       int    `yaml:"writeBufSize,omitempty"`
-	InitWindowSize     int32  `yaml:"initWindowSize,omitempty"`
-	InitConnWindowSize int32  `yaml:"initConnWindowSize,omitempty"`
-	TlsServerName      string `yaml:"tlsServerName,omitempty"`
+	// rpc server listeners
+	RpcListeners        []ListenConf `yaml:"rpcListeners,omitempty"`
+	AdapterRpcListeners []ListenConf `yaml:"adapterRpcListeners,omitempty"`
+	AdapterGWListeners  []ListenConf `yaml:"adapterGWListeners,omitempty"`
+	MetricPort          int          `yaml:"metricPort,omitempty"`
+	EnableMetric        bool         `yaml:"enableMetric,omitempty"`
+	EnableAdapter       bool         `yaml:"enableAdapter,omitempty"`
+	EnableEndorser      bool         `yaml:"enableEndorser,omitempty"`
+	AdapterAllowCROS    bool         `yaml:"adapterAllowCROS,omitempty"`
+	MaxMsgSize          int          `yaml:"maxMsgSize,omitempty"`
+	ReadBufSize         int          `yaml:"readBufSize,omitempty"`
+	WriteBufSize        int          `yaml:"writeBufSize,omitempty"`
+	InitWindowSize      int32        `yaml:"initWindowSize,omitempty"`
+	InitConnWindowSize  int32        `yaml:"initConnWindowSize,omitempty"`
+	TlsServerName       string       `yaml:"tlsServerName,omitempty"`
+	// ethereum json-rpc facade, query and pre-exec only, ethereum signed transactions are not supported
+	EnableEthRpc bool         `yaml:"enableEthRpc,omitempty"`
+	EthListeners []ListenConf `yaml:"ethListeners,omitempty"`
+	EthChainId   int64        `yaml:"ethChainId,omitempty"`
+	EthBcName    string       `yaml:"ethBcName,omitempty"`
+	// graphql query endpoint on adapter gateway
+	EnableGraphQL        bool `yaml:"enableGraphQL,omitempty"`
+	GraphQLMaxDepth      int  `yaml:"graphQLMaxDepth,omitempty"`
+	GraphQLMaxComplexity int  `yaml:"graphQLMaxComplexity,omitempty"`
+	// unix domain socket，相对路径基于节点根目录，为空不监听
+	RpcUnixSocket         string   `yaml:"rpcUnixSocket,omitempty"`
+	AdapterRpcUnixSocket  string   `yaml:"adapterRpcUnixSocket,omitempty"`
+	EthUnixSocket         string   `yaml:"ethUnixSocket,omitempty"`
+	UnixSocketPerm        string   `yaml:"unixSocketPerm,omitempty"`
+	UnixSocketOnlyMethods []string `yaml:"unixSocketOnlyMethods,omitempty"`
+	// 服务层二级索引，存储目录相对于数据目录
+	EnableAddrIndex  bool   `yaml:"enableAddrIndex,omitempty"`
+	EnableEventIndex bool   `yaml:"enableEventIndex,omitempty"`
+	EnableTokenIndex bool   `yaml:"enableTokenIndex,omitempty"`
+	IndexDir         string `yaml:"indexDir,omitempty"`
+	// 区块和交易查询缓存，条目数为0不开启，距离主干顶端不超过安全深度的数据不缓存
+	QueryCacheSize      int           `yaml:"queryCacheSize,omitempty"`
+	QueryCacheTTL       time.Duration `yaml:"queryCacheTTL,omitempty"`
+	QueryCacheSafeDepth int64         `yaml:"queryCacheSafeDepth,omitempty"`
+	// 管理服务，只允许监听本机回环地址或unix socket，都为空不开启
+	AdminListenAddr string `yaml:"adminListenAddr,omitempty"`
+	AdminUnixSocket string `yaml:"adminUnixSocket,omitempty"`
+	// 模块日志级别，key为日志s_mod字段，只对xuperos创建的日志实例生效，只能比日志配置的级别更简略
+	LogLevels map[string]string `yaml:"logLevels,omitempty"`
+	// 自定义服务组件，按名称开启，组件需要通过service.Register注册
+	Services []ServiceConf `yaml:"services,omitempty"`
+}
+
+// 自定义服务组件配置
+type ServiceConf struct {
+	Name string `yaml:"name,omitempty"`
+	// 重启方式never|always|backoff，为空使用backoff
+	Restart  string `yaml:"restart,omitempty"`
+	MaxRetry int    `yaml:"maxRetry,omitempty"`
+	// 组件无法恢复时退出进程
+	Critical bool `yaml:"critical,omitempty"`
+	// 组件自定义参数，key不区分大小写
+	Params map[string]string `yaml:"params,omitempty"`
+}
+
+// GetService 获取自定义服务组件配置，未配置返回nil
+func (t *ServConf) GetService(name string) *ServiceConf {
+	for i := range t.Services {
+		if t.Services[i].Name == name {
+			return &t.Services[i]
+		}
+	}
+	return nil
 }
 
 func LoadServConf(cfgFile string) (*ServConf, error) {
@@ -39,21 +109,35 @@ func LoadServConf(cfgFile string) (*ServConf, error) {
 
 func GetDefServConf() *ServConf {
 	return &ServConf{
-		RpcPort:            38101,
-		AdapterRpcPort:     37101,
-		AdapterGWPort:      37102,
-		MetricPort:         38100,
-		EnableMetric:       true,
-		EnableTls:          false,
-		EnableAdapter:      false,
-		EnableEndorser:     false,
-		AdapterAllowCROS:   false,
-		MaxMsgSize:         128 << 20,
-		ReadBufSize:        32 << 10,
-		WriteBufSize:       32 << 10,
-		InitWindowSize:     128 << 10,
-		InitConnWindowSize: 64 << 10,
-		TlsServerName:      "localhost",
+		RpcListeners:         []ListenConf{{Port: 38101}},
+		AdapterRpcListeners:  []ListenConf{{Port: 37101}},
+		AdapterGWListeners:   []ListenConf{{Port: 37102}},
+		MetricPort:           38100,
+		EnableMetric:         true,
+		EnableAdapter:        false,
+		EnableEndorser:       false,
+		AdapterAllowCROS:     false,
+		MaxMsgSize:           128 << 20,
+		ReadBufSize:          32 << 10,
+		WriteBufSize:         32 << 10,
+		InitWindowSize:       128 << 10,
+		InitConnWindowSize:   64 << 10,
+		TlsServerName:        "localhost",
+		EnableEthRpc:         false,
+		EthListeners:         []ListenConf{{Port: 37103}},
+		EthChainId:           3777,
+		EthBcName:            "xuper",
+		EnableGraphQL:        false,
+		GraphQLMaxDepth:      10,
+		GraphQLMaxComplexity: 5000,
+		UnixSocketPerm:       "0660",
+		EnableAddrIndex:      false,
+		EnableEventIndex:     false,
+		EnableTokenIndex:     false,
+		IndexDir:             "index",
+		QueryCacheSize:       1000,
+		QueryCacheTTL:        10 * time.Minute,
+		QueryCacheSafeDepth:  20,
 	}
 }
 
@@ -69,6 +153,19 @@ func (t *ServConf) loadConf(cfgFile string) error {
 		return fmt.Errorf("read config failed.path:%s,err:%v", cfgFile, err)
 	}
 
+	// 监听列表以配置文件为准，不与默认值合并
+	listeners := map[string]*[]ListenConf{
+		"rpcListeners":        &t.RpcListeners,
+		"adapterRpcListeners": &t.AdapterRpcListeners,
+		"adapterGWListeners":  &t.AdapterGWListeners,
+		"ethListeners":        &t.EthListeners,
+	}
+	for key, lcs := range listeners {
+		if viperObj.IsSet(key) {
+			*lcs = nil
+		}
+	}
+
 	if err = viperObj.Unmarshal(t); err != nil {
 		return fmt.Errorf("unmatshal config failed.path:%s,err:%v", cfgFile, err)
 	}
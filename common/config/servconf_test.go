@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/xuperchain/xupercore/lib/utils"
 )
@@ -21,3 +22,52 @@ func getConfFile() string {
 	dir := utils.GetCurFileDir()
 	return filepath.Join(dir, "mock/server.yaml")
 }
+
+func TestLoadListeners(t *testing.T) {
+	cfg, err := LoadServConf(getConfFile())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 配置文件中的监听列表覆盖默认值
+	if len(cfg.RpcListeners) != 2 {
+		t.Fatalf("unexpected rpc listeners %+v", cfg.RpcListeners)
+	}
+	if addr := cfg.RpcListeners[0].Addr(); addr != ":37101" {
+		t.Errorf("unexpected addr %s", addr)
+	}
+	lc := cfg.RpcListeners[1]
+	if lc.Addr() != "[::1]:37104" || !lc.Tls || len(lc.AllowMethods) != 1 {
+		t.Errorf("unexpected listener %+v", lc)
+	}
+	if len(cfg.AdapterRpcListeners) != 1 || cfg.AdapterRpcListeners[0].Port != 37101 {
+		t.Errorf("unexpected adapter rpc listeners %+v", cfg.AdapterRpcListeners)
+	}
+	if len(cfg.EthListeners) != 1 || cfg.EthListeners[0].Addr() != "127.0.0.1:37103" ||
+		len(cfg.EthListeners[0].AllowMethods) != 1 {
+		t.Errorf("unexpected eth listeners %+v", cfg.EthListeners)
+	}
+
+	// 时长配置支持字符串格式
+	if cfg.QueryCacheTTL != 5*time.Minute {
+		t.Errorf("unexpected query cache ttl %v", cfg.QueryCacheTTL)
+	}
+}
+
+func TestLoadServices(t *testing.T) {
+	cfg, err := LoadServConf(getConfFile())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(cfg.Services) != 2 {
+		t.Fatalf("unexpected services %+v", cfg.Services)
+	}
+	conf := cfg.GetService("compliance")
+	if conf == nil || conf.Restart != "always" || !conf.Critical || conf.Params["endpoint"] != "127.0.0.1:9000" {
+		t.Errorf("unexpected service conf %+v", conf)
+	}
+	if cfg.GetService("indexapi") == nil || cfg.GetService("notexist") != nil {
+		t.Errorf("get service error")
+	}
+}
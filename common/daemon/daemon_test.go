@@ -0,0 +1,92 @@
+package daemon
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestPidFile(t *testing.T) {
+	root, err := ioutil.TempDir("", "daemon")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	path := filepath.Join(root, "tmp", "xuperos.pid")
+	if pid, err := ReadPidFile(path); err != nil || pid != 0 {
+		t.Fatalf("read not exist pid file error.pid:%d,err:%v", pid, err)
+	}
+
+	// 残留的已退出进程pid可以覆盖
+	cmd := exec.Command("true")
+	if err := cmd.Run(); err != nil {
+		t.Skip("command true not available")
+	}
+	ioutil.WriteFile(filepath.Join(root, "stale.pid"), []byte(strconv.Itoa(cmd.Process.Pid)), 0644)
+	if err := WritePidFile(filepath.Join(root, "stale.pid")); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := WritePidFile(path); err != nil {
+		t.Fatal(err)
+	}
+	if pid, _ := ReadPidFile(path); pid != os.Getpid() {
+		t.Fatalf("pid file content error.pid:%d", pid)
+	}
+
+	// 运行中的其他进程不能覆盖
+	sleep := exec.Command("sleep", "10")
+	if err := sleep.Start(); err != nil {
+		t.Fatal(err)
+	}
+	ioutil.WriteFile(path, []byte(strconv.Itoa(sleep.Process.Pid)), 0644)
+	if err := WritePidFile(path); err == nil {
+		t.Fatal("overwrite running process pid file should fail")
+	}
+	if err := RemovePidFile(path); err != nil || !fileExist(path) {
+		t.Fatal("remove other process pid file should be ignored")
+	}
+
+	go sleep.Wait()
+	if err := Stop(sleep.Process.Pid, syscall.SIGTERM, 5*time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if Alive(sleep.Process.Pid) {
+		t.Fatal("process not stopped")
+	}
+}
+
+func TestLockDir(t *testing.T) {
+	root, err := ioutil.TempDir("", "daemon")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	lock, err := LockDir(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LockDir(root); err == nil {
+		t.Fatal("lock locked dir should fail")
+	}
+	if err := lock.Unlock(); err != nil {
+		t.Fatal(err)
+	}
+	lock, err = LockDir(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lock.Unlock()
+}
+
+func fileExist(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
@@ -0,0 +1,57 @@
+package daemon
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+// LockFileName 数据目录锁文件名
+const LockFileName = "xuperos.lock"
+
+// DirLock 数据目录独占锁，进程退出时系统自动释放
+type DirLock struct {
+	file *os.File
+}
+
+// LockDir 对目录加独占锁并记录持有进程pid，已被其他进程持有时返回错误
+func LockDir(dir string) (*DirLock, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	path := filepath.Join(dir, LockFileName)
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0644)
+	if err != nil {
+		return nil, err
+	}
+	if err := syscall.Flock(int(file.Fd()), syscall.LOCK_EX|syscall.LOCK_NB); err != nil {
+		file.Close()
+		if err == syscall.EWOULDBLOCK {
+			return nil, fmt.Errorf("data dir locked by another process.dir:%s,pid:%s", dir, lockHolder(path))
+		}
+		return nil, fmt.Errorf("lock data dir failed.dir:%s,err:%v", dir, err)
+	}
+
+	if err := file.Truncate(0); err == nil {
+		file.WriteAt([]byte(strconv.Itoa(os.Getpid())), 0)
+	}
+	return &DirLock{file: file}, nil
+}
+
+// Unlock 释放目录锁
+func (l *DirLock) Unlock() error {
+	defer l.file.Close()
+	return syscall.Flock(int(l.file.Fd()), syscall.LOCK_UN)
+}
+
+func lockHolder(path string) string {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "unknown"
+	}
+	return strings.TrimSpace(string(data))
+}
@@ -0,0 +1,83 @@
+// Package daemon 节点进程管理，包括pid文件、数据目录锁和停止进程
+package daemon
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// Alive 进程是否存在
+func Alive(pid int) bool {
+	if pid <= 0 {
+		return false
+	}
+	err := syscall.Kill(pid, 0)
+	return err == nil || err == syscall.EPERM
+}
+
+// ReadPidFile 读取pid文件，文件不存在时返回0
+func ReadPidFile(path string) (int, error) {
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	pid, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	if err != nil {
+		return 0, fmt.Errorf("pid file format error.path:%s,err:%v", path, err)
+	}
+	return pid, nil
+}
+
+// WritePidFile 写入当前进程pid，文件中的进程仍在运行时返回错误，进程已退出的残留文件直接覆盖
+func WritePidFile(path string) error {
+	pid, err := ReadPidFile(path)
+	if err != nil {
+		return err
+	}
+	// 由父进程提前写入当前进程pid的情况不算冲突
+	if pid != os.Getpid() && Alive(pid) {
+		return fmt.Errorf("process already running.pid:%d,pidfile:%s", pid, path)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, []byte(strconv.Itoa(os.Getpid())), 0644)
+}
+
+// RemovePidFile 删除当前进程写入的pid文件，避免误删新进程的文件
+func RemovePidFile(path string) error {
+	pid, err := ReadPidFile(path)
+	if err != nil || pid != os.Getpid() {
+		return err
+	}
+	return os.Remove(path)
+}
+
+// Stop 给进程发送信号并等待退出，超时返回错误
+func Stop(pid int, sig syscall.Signal, timeout time.Duration) error {
+	if !Alive(pid) {
+		return nil
+	}
+	if err := syscall.Kill(pid, sig); err != nil {
+		return fmt.Errorf("signal process failed.pid:%d,err:%v", pid, err)
+	}
+
+	deadline := time.Now().Add(timeout)
+	for Alive(pid) {
+		if time.Now().After(deadline) {
+			return fmt.Errorf("wait process exit timeout.pid:%d", pid)
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	return nil
+}
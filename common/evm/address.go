@@ -0,0 +1,135 @@
+package evm
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/xuperchain/crypto/gm/base58"
+	"github.com/xuperchain/xupercore/kernel/contract"
+	aclUtils "github.com/xuperchain/xupercore/kernel/permission/acl/utils"
+	cryptoHash "github.com/xuperchain/xupercore/lib/crypto/hash"
+)
+
+// xchain地址、合约名、合约账户与EVM地址(20字节)的互相转换
+// 合约名和合约账户通过固定前缀区分，普通地址取base58解码后的ripemd160部分
+const (
+	XchainAddrType      = "xchain"
+	ContractNameType    = "contract-name"
+	ContractAccountType = "contract-account"
+)
+
+const (
+	// xchain地址版本号
+	xchainAddrVersion = 1
+	// 地址校验码长度
+	checkCodeLen = 4
+
+	contractNamePrefix    = "1111"
+	contractAccountPrefix = "1112"
+	evmAddressFiller      = "-"
+)
+
+// XchainToEVMAddress 将xchain地址转换为EVM地址
+func XchainToEVMAddress(addr string) (crypto.Address, error) {
+	rawAddr := base58.Decode(addr)
+	if len(rawAddr) < crypto.AddressLength+1 {
+		return crypto.ZeroAddress, fmt.Errorf("%s is not a valid xchain address", addr)
+	}
+
+	return crypto.AddressFromBytes(rawAddr[1 : crypto.AddressLength+1])
+}
+
+// EVMAddressToXchain 将EVM地址转换为xchain地址
+func EVMAddressToXchain(evmAddr crypto.Address) (string, error) {
+	buf := make([]byte, 0, 1+crypto.AddressLength+checkCodeLen)
+	buf = append(buf, xchainAddrVersion)
+	buf = append(buf, evmAddr.Bytes()...)
+	checkCode := cryptoHash.DoubleSha256(buf)
+	buf = append(buf, checkCode[:checkCodeLen]...)
+
+	return base58.Encode(buf), nil
+}
+
+// ContractNameToEVMAddress 将合约名转换为EVM地址
+func ContractNameToEVMAddress(contractName string) (crypto.Address, error) {
+	if err := contract.ValidContractName(contractName); err != nil {
+		return crypto.ZeroAddress, err
+	}
+
+	fillerLen := crypto.AddressLength - len(contractNamePrefix) - len(contractName)
+	str := contractNamePrefix + strings.Repeat(evmAddressFiller, fillerLen) + contractName
+	return crypto.AddressFromBytes([]byte(str))
+}
+
+// EVMAddressToContractName 将EVM地址转换为合约名
+func EVMAddressToContractName(evmAddr crypto.Address) (string, error) {
+	raw := evmAddr.Bytes()
+	if !bytes.HasPrefix(raw, []byte(contractNamePrefix)) {
+		return "", fmt.Errorf("%s is not a contract name address", evmAddr)
+	}
+
+	contractName := strings.TrimLeft(string(raw[len(contractNamePrefix):]), evmAddressFiller)
+	if err := contract.ValidContractName(contractName); err != nil {
+		return "", err
+	}
+	return contractName, nil
+}
+
+// ContractAccountToEVMAddress 将合约账户(XC1111111111111111@xuper)转换为EVM地址
+func ContractAccountToEVMAddress(account string) (crypto.Address, error) {
+	if aclUtils.IsAccount(account) != 1 {
+		return crypto.ZeroAddress, fmt.Errorf("%s is not a valid contract account", account)
+	}
+
+	prefix := strings.Split(account, aclUtils.GetAccountBcnameSep())[0]
+	number := prefix[len(aclUtils.GetAccountPrefix()):]
+	return crypto.AddressFromBytes([]byte(contractAccountPrefix + number))
+}
+
+// EVMAddressToContractAccount 将EVM地址转换为指定链上的合约账户
+func EVMAddressToContractAccount(evmAddr crypto.Address, bcName string) (string, error) {
+	raw := evmAddr.Bytes()
+	if !bytes.HasPrefix(raw, []byte(contractAccountPrefix)) {
+		return "", fmt.Errorf("%s is not a contract account address", evmAddr)
+	}
+
+	number := string(raw[len(contractAccountPrefix):])
+	if err := aclUtils.ValidRawAccount(number); err != nil {
+		return "", err
+	}
+	return aclUtils.MakeAccountKey(bcName, number), nil
+}
+
+// DetermineXchainAddress 根据xchain地址类型转换为EVM地址，返回EVM地址和地址类型
+func DetermineXchainAddress(xchainAddr string) (crypto.Address, string, error) {
+	if aclUtils.IsAccount(xchainAddr) == 1 {
+		addr, err := ContractAccountToEVMAddress(xchainAddr)
+		return addr, ContractAccountType, err
+	}
+	if contract.ValidContractName(xchainAddr) == nil {
+		addr, err := ContractNameToEVMAddress(xchainAddr)
+		return addr, ContractNameType, err
+	}
+
+	addr, err := XchainToEVMAddress(xchainAddr)
+	return addr, XchainAddrType, err
+}
+
+// DetermineEVMAddress 根据EVM地址前缀转换为xchain地址，返回xchain地址和地址类型
+// 合约账户需要指定所在链名
+func DetermineEVMAddress(evmAddr crypto.Address, bcName string) (string, string, error) {
+	raw := evmAddr.Bytes()
+	if bytes.HasPrefix(raw, []byte(contractAccountPrefix)) {
+		addr, err := EVMAddressToContractAccount(evmAddr, bcName)
+		return addr, ContractAccountType, err
+	}
+	if bytes.HasPrefix(raw, []byte(contractNamePrefix)) {
+		addr, err := EVMAddressToContractName(evmAddr)
+		return addr, ContractNameType, err
+	}
+
+	addr, err := EVMAddressToXchain(evmAddr)
+	return addr, XchainAddrType, err
+}
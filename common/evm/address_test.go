@@ -0,0 +1,52 @@
+package evm
+
+import (
+	"testing"
+)
+
+func TestXchainAddress(t *testing.T) {
+	xAddr := "dpzuVdosQrF2kmzumhVeFQZa1aYcdgFpN"
+	evmAddr, addrType, err := DetermineXchainAddress(xAddr)
+	if err != nil || addrType != XchainAddrType {
+		t.Fatal(err, addrType)
+	}
+
+	addr, addrType, err := DetermineEVMAddress(evmAddr, "xuper")
+	if err != nil || addrType != XchainAddrType {
+		t.Fatal(err, addrType)
+	}
+	if addr != xAddr {
+		t.Fatalf("address mismatch, expect %s, actual %s", xAddr, addr)
+	}
+}
+
+func TestContractName(t *testing.T) {
+	evmAddr, addrType, err := DetermineXchainAddress("counter")
+	if err != nil || addrType != ContractNameType {
+		t.Fatal(err, addrType)
+	}
+
+	name, addrType, err := DetermineEVMAddress(evmAddr, "xuper")
+	if err != nil || addrType != ContractNameType {
+		t.Fatal(err, addrType)
+	}
+	if name != "counter" {
+		t.Fatalf("contract name mismatch, actual %s", name)
+	}
+}
+
+func TestContractAccount(t *testing.T) {
+	account := "XC1111111111111111@xuper"
+	evmAddr, addrType, err := DetermineXchainAddress(account)
+	if err != nil || addrType != ContractAccountType {
+		t.Fatal(err, addrType)
+	}
+
+	addr, addrType, err := DetermineEVMAddress(evmAddr, "xuper")
+	if err != nil || addrType != ContractAccountType {
+		t.Fatal(err, addrType)
+	}
+	if addr != account {
+		t.Fatalf("account mismatch, expect %s, actual %s", account, addr)
+	}
+}
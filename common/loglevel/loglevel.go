@@ -0,0 +1,225 @@
+package loglevel
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	log "github.com/xuperchain/log15"
+	"github.com/xuperchain/xupercore/lib/logs"
+	lconf "github.com/xuperchain/xupercore/lib/logs/config"
+)
+
+// 内核日志库没有提供运行时调整级别的接口，xuperos的日志实例通过NewLogger创建，
+// 创建时按模块级别屏蔽更详细级别的输出，内核模块的日志仍按日志配置的级别输出
+var (
+	// 安装后的*Levels，创建日志实例时读取
+	current     atomic.Value
+	installErr  error
+	installOnce sync.Once
+)
+
+// Levels 按模块（日志s_mod字段）设置的日志级别
+// 日志实例的最低级别在创建时按日志配置确定，所以模块级别只能比配置级别更简略
+type Levels struct {
+	defLevel log.Lvl
+	// module => log.Lvl，写时复制，创建日志实例时不加锁
+	levels atomic.Value
+	lock   sync.Mutex
+	// 创建过日志实例的模块
+	modules sync.Map
+}
+
+func newLevels(defLevel log.Lvl) *Levels {
+	obj := &Levels{defLevel: defLevel}
+	obj.levels.Store(map[string]log.Lvl{})
+	return obj
+}
+
+// Install 按日志配置的级别开启模块级别过滤，只安装一次
+func Install(logConfFile string) (*Levels, error) {
+	installOnce.Do(func() {
+		logConf, err := lconf.LoadLogConf(logConfFile)
+		if err != nil {
+			installErr = err
+			return
+		}
+		defLevel, err := log.LvlFromString(logConf.Level)
+		if err != nil {
+			installErr = fmt.Errorf("log level error.err:%v", err)
+			return
+		}
+		current.Store(newLevels(defLevel))
+	})
+
+	levels, _ := current.Load().(*Levels)
+	return levels, installErr
+}
+
+// NewLogger 创建日志实例，开启过滤时按模块当前级别屏蔽更详细的输出
+// 已创建的实例不感知之后的级别调整，请求级别的日志实例在下一个请求生效
+func NewLogger(logId, subMod string) (logs.Logger, error) {
+	logger, err := logs.NewLogger(logId, subMod)
+	if err != nil {
+		return nil, err
+	}
+	levels, _ := current.Load().(*Levels)
+	if levels == nil || subMod == "" {
+		return logger, nil
+	}
+	return levels.wrap(logger, subMod), nil
+}
+
+// DefaultLevel 日志配置的级别
+func (t *Levels) DefaultLevel() string {
+	return levelName(t.defLevel)
+}
+
+// List 返回创建过日志实例和设置过级别的模块级别
+func (t *Levels) List() map[string]string {
+	res := make(map[string]string)
+	t.modules.Range(func(key, value interface{}) bool {
+		res[key.(string)] = levelName(t.defLevel)
+		return true
+	})
+	for mod, lvl := range t.levels.Load().(map[string]log.Lvl) {
+		res[mod] = levelName(lvl)
+	}
+
+	return res
+}
+
+// Set 设置模块级别，level为空恢复为默认级别
+func (t *Levels) Set(module, level string) error {
+	module = strings.ToLower(module)
+	if module == "" {
+		return fmt.Errorf("module is empty")
+	}
+
+	var lvl log.Lvl
+	if level != "" {
+		var err error
+		if lvl, err = t.parseLevel(level); err != nil {
+			return err
+		}
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	levels := t.copyLevels()
+	if level == "" || lvl == t.defLevel {
+		delete(levels, module)
+	} else {
+		levels[module] = lvl
+	}
+	t.levels.Store(levels)
+	return nil
+}
+
+// Reset 用配置替换全部模块级别，校验失败时不做修改
+func (t *Levels) Reset(conf map[string]string) error {
+	levels := make(map[string]log.Lvl, len(conf))
+	for module, level := range conf {
+		lvl, err := t.parseLevel(level)
+		if err != nil {
+			return fmt.Errorf("module %s %v", module, err)
+		}
+		if lvl != t.defLevel {
+			levels[strings.ToLower(module)] = lvl
+		}
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.levels.Store(levels)
+	return nil
+}
+
+func (t *Levels) parseLevel(level string) (log.Lvl, error) {
+	lvl, err := log.LvlFromString(level)
+	if err != nil {
+		return 0, fmt.Errorf("unknown log level %s", level)
+	}
+	if lvl > t.defLevel {
+		return 0, fmt.Errorf("log level %s is more verbose than configured level %s",
+			level, levelName(t.defLevel))
+	}
+	return lvl, nil
+}
+
+func (t *Levels) copyLevels() map[string]log.Lvl {
+	old := t.levels.Load().(map[string]log.Lvl)
+	levels := make(map[string]log.Lvl, len(old)+1)
+	for mod, lvl := range old {
+		levels[mod] = lvl
+	}
+	return levels
+}
+
+func (t *Levels) wrap(logger logs.Logger, subMod string) logs.Logger {
+	module := strings.ToLower(subMod)
+	if _, ok := t.modules.Load(module); !ok {
+		t.modules.Store(module, true)
+	}
+
+	lvl, ok := t.levels.Load().(map[string]log.Lvl)[module]
+	if !ok {
+		return logger
+	}
+	switch lvl {
+	case log.LvlCrit:
+		return critLogger{errorLogger{warnLogger{infoLogger{traceLogger{logger}}}}}
+	case log.LvlError:
+		return errorLogger{warnLogger{infoLogger{traceLogger{logger}}}}
+	case log.LvlWarn:
+		return warnLogger{infoLogger{traceLogger{logger}}}
+	case log.LvlInfo:
+		return infoLogger{traceLogger{logger}}
+	case log.LvlTrace:
+		return traceLogger{logger}
+	}
+	return logger
+}
+
+// 逐级屏蔽更详细级别的日志实例，允许输出的级别通过嵌入直接调用原实例，保持日志的call字段
+type traceLogger struct{ logs.Logger }
+
+func (traceLogger) Debug(msg string, ctx ...interface{}) {}
+
+type infoLogger struct{ traceLogger }
+
+func (infoLogger) Trace(msg string, ctx ...interface{}) {}
+
+type warnLogger struct{ infoLogger }
+
+func (warnLogger) Info(msg string, ctx ...interface{}) {}
+
+type errorLogger struct{ warnLogger }
+
+func (errorLogger) Warn(msg string, ctx ...interface{}) {}
+
+type critLogger struct{ errorLogger }
+
+func (critLogger) Error(msg string, ctx ...interface{}) {}
+
+// 与日志配置使用相同的级别名称
+func levelName(lvl log.Lvl) string {
+	switch lvl {
+	case log.LvlDebug:
+		return "debug"
+	case log.LvlTrace:
+		return "trace"
+	case log.LvlInfo:
+		return "info"
+	case log.LvlWarn:
+		return "warn"
+	case log.LvlError:
+		return "error"
+	case log.LvlCrit:
+		return "crit"
+	}
+	return "unknown"
+}
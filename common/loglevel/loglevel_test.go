@@ -0,0 +1,73 @@
+package loglevel
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/xuperchain/xupercore/lib/logs"
+)
+
+func TestLevels(t *testing.T) {
+	dir, err := ioutil.TempDir("", "loglevel")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	logConfFile := filepath.Join(dir, "log.yaml")
+	err = ioutil.WriteFile(logConfFile, []byte("level: trace\nconsole: false\nfilename: test\n"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	logs.InitLog(logConfFile, dir)
+
+	levels, err := Install(logConfFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if levels.DefaultLevel() != "trace" {
+		t.Errorf("unexpected default level %s", levels.DefaultLevel())
+	}
+	// 不能比配置级别更详细
+	if err := levels.Set("ledger", "debug"); err == nil {
+		t.Error("expect set debug level failed")
+	}
+	if err := levels.Reset(map[string]string{"ledger": "warn"}); err != nil {
+		t.Fatal(err)
+	}
+
+	ledgerLog, _ := NewLogger("", "Ledger")
+	netLog, _ := NewLogger("", "network")
+	ledgerLog.Trace("ledger trace")
+	ledgerLog.Warn("ledger warn")
+	netLog.Trace("network trace")
+	// 恢复默认级别，之后创建的实例生效
+	levels.Set("LEDGER", "")
+	ledgerLog.Trace("ledger trace before recreate")
+	ledgerLog, _ = NewLogger("", "Ledger")
+	ledgerLog.Trace("ledger trace again")
+
+	data, err := ioutil.ReadFile(filepath.Join(dir, "test.log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	wfData, _ := ioutil.ReadFile(filepath.Join(dir, "test.log.wf"))
+	output := string(data) + string(wfData)
+	for msg, expect := range map[string]bool{
+		"\"ledger trace\"": false, "ledger warn": true, "network trace": true,
+		"ledger trace before recreate": false, "ledger trace again": true,
+		// 屏蔽级别后call字段仍指向调用方
+		"loglevel.go": false, "loglevel_test.go": true,
+	} {
+		if strings.Contains(output, msg) != expect {
+			t.Errorf("message %s expect output %v", msg, expect)
+		}
+	}
+
+	list := levels.List()
+	if list["ledger"] != "trace" || list["network"] != "trace" {
+		t.Errorf("unexpected levels %v", list)
+	}
+}
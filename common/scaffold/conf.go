@@ -0,0 +1,116 @@
+package scaffold
+
+// 节点配置文件模板，与conf目录下的默认配置保持一致
+
+const envConfTpl = `# Configuration of kernel runtime environment
+
+# Program running root directory
+rootPath: {{.RootPath}}
+# Config file directory
+confDir: conf
+# Data file directory
+dataDir: data
+# Log file directory
+logDir: logs
+# Tls file directory
+tlsDir: tls
+# Node key directory
+keyDir: keys
+# Blockchain data directory
+chainDir: blockchain
+# Engine config file name
+engineConf: engine.yaml
+# Log config file name
+logConf: log.yaml
+# Server config file name
+servConf: server.yaml
+# Network config file name
+netConf: network.yaml
+# Ledger config file name
+ledgerConf: ledger.yaml
+# Metric switch
+metricSwitch: false
+`
+
+const engineConfTpl = `# root chain name
+rootChain: {{.BcName}}
+# blockBroadcaseMode is the mode for broadcast new block
+blockBroadcastMode: 0
+# txCacheExpiredTime set expired time for tx cache
+txidCacheExpiredTime: 3m
+# txIdCacheGCInterval set clean up interval for tx cache
+txIdCacheGCInterval: 10m
+`
+
+const networkConfTpl = `# p2p network config
+
+# Module is the name of p2p module plugin.(p2pv1 | p2pv2)
+module: {{.P2PModule}}
+# Port the p2p network listened
+port: {{.P2PPort}}
+# Address multiaddr string
+address: /ip4/{{.Host}}/tcp/{{.P2PPort}}
+# KeyPath is the netdisk private key path
+keyPath: netkeys
+# BootNodes config the bootNodes the node to connect
+bootNodes:{{if not .BootNodes}} []{{end}}
+{{- range .BootNodes}}
+    - "{{.}}"
+{{- end}}
+# service name
+serviceName: localhost
+`
+
+const serverConfTpl = `# Rpc service listeners, one listener per entry
+rpcListeners:
+  - port: {{.RpcPort}}
+# AdapterRpcListeners
+adapterRpcListeners:
+  - port: {{.AdapterRpcPort}}
+# AdapterGWListeners
+adapterGWListeners:
+  - port: {{.AdapterGWPort}}
+# MetricPort http port serving /metrics and service health /health, enabled when enableMetric and metricSwitch in env.yaml are both true
+metricPort: {{.MetricPort}}
+# EnableAdapter
+enableAdapter: true
+# AdminListenAddr admin service listen address, only loopback address allowed, empty means disabled
+adminListenAddr: "{{if .AdminPort}}127.0.0.1:{{.AdminPort}}{{end}}"
+`
+
+const ledgerConfTpl = `# 账本配置
+
+# 存储引擎配置，不支持中途更换
+kvEngineType: leveldb
+# 数据存储方式
+storageType: single
+`
+
+const logConfTpl = `# 日志配置文件
+
+# 模块名
+module: xchain
+# 日志文件名
+filename: xchain
+# 日志输出格式（logfmt | json）
+fmt: logfmt
+# 日志输出级别：debug、trace、info、warn、error
+level: debug
+# 日志分割周期（单位：分钟）
+rotateInterval: 60
+# 日志保留天数（单位：小时）
+rotateBackups: 168
+# 是否输出到标准输出
+console: false
+# 设置日志模式是否是异步
+async: false
+# 设置异步模式下缓冲区大小
+bufSize: 102400
+`
+
+const clientConfTpl = `# xchain-cli config of this node
+host: "127.0.0.1:{{.AdapterRpcPort}}"
+name: {{.BcName}}
+keys: ./data/keys
+adminHost: "{{if .AdminPort}}127.0.0.1:{{.AdminPort}}{{end}}"
+`
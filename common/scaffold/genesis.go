@@ -0,0 +1,157 @@
+package scaffold
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// 创世块模板，与data/genesis下对应共识的配置保持一致
+var genesisTpls = map[string]string{
+	"single": `{
+    "version": "1",
+    "predistribution": [],
+    "maxblocksize": "128",
+    "award": "1000000",
+    "decimals": "8",
+    "award_decay": {"height_gap": 31536000, "ratio": 1},
+    "gas_price": {"cpu_rate": 1000, "mem_rate": 1000000, "disk_rate": 1, "xfee_rate": 1},
+    "new_account_resource_amount": 1000,
+    "genesis_consensus": {
+        "name": "single",
+        "config": {"miner": "", "period": 3000}
+    }
+}`,
+	"tdpos": `{
+    "version": "1",
+    "predistribution": [],
+    "maxblocksize": "128",
+    "award": "1000000",
+    "decimals": "8",
+    "award_decay": {"height_gap": 31536000, "ratio": 1},
+    "nofee": false,
+    "gas_price": {"cpu_rate": 1000, "mem_rate": 1000000, "disk_rate": 1, "xfee_rate": 1},
+    "new_account_resource_amount": 1000,
+    "genesis_consensus": {
+        "name": "tdpos",
+        "config": {
+            "timestamp": "1559021720000000000",
+            "proposer_num": "1",
+            "period": "3000",
+            "alternate_interval": "3000",
+            "term_interval": "6000",
+            "block_num": "20",
+            "vote_unit_price": "1",
+            "init_proposer": {"1": []},
+            "init_proposer_neturl": {"1": []}
+        }
+    }
+}`,
+	"xpoa": `{
+    "version": "1",
+    "predistribution": [],
+    "maxblocksize": "128",
+    "award": "1000000",
+    "decimals": "8",
+    "award_decay": {"height_gap": 31536000, "ratio": 1},
+    "gas_price": {"cpu_rate": 1000, "mem_rate": 1000000, "disk_rate": 1, "xfee_rate": 1},
+    "new_account_resource_amount": 1000,
+    "genesis_consensus": {
+        "name": "xpoa",
+        "config": {
+            "period": 3000,
+            "block_num": 10,
+            "contract_name": "xpoa_validates",
+            "method_name": "get_validates",
+            "init_proposer": [],
+            "bft_config": {}
+        }
+    }
+}`,
+	"pow": `{
+    "version": "1",
+    "predistribution": [],
+    "maxblocksize": "128",
+    "award": "1000000",
+    "decimals": "8",
+    "award_decay": {"height_gap": 31536000, "ratio": 0.5},
+    "new_account_resource_amount": 1000,
+    "genesis_consensus": {
+        "name": "pow",
+        "config": {
+            "defaultTarget": "545259519",
+            "adjustHeightGap": "5",
+            "expectedPeriod": "15",
+            "maxTarget": "486604799"
+        }
+    }
+}`,
+}
+
+// 每个验证节点的预分配额度
+const defQuota = "100000000000000000000"
+
+// Validator 创世块中的验证节点
+type Validator struct {
+	Address string
+	NetURL  string
+}
+
+// Consensuses 支持的共识模板
+func Consensuses() []string {
+	names := make([]string, 0, len(genesisTpls))
+	for name := range genesisTpls {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// GenGenesis 按共识模板生成创世块配置，所有验证节点都有预分配额度
+// single共识只有第一个节点出块，pow共识不需要设置验证节点
+func GenGenesis(consensus string, validators []*Validator) ([]byte, error) {
+	tpl, ok := genesisTpls[consensus]
+	if !ok {
+		return nil, fmt.Errorf("consensus template not exist.consensus:%s", consensus)
+	}
+	if len(validators) == 0 {
+		return nil, fmt.Errorf("validators is empty")
+	}
+
+	genesis := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(tpl), &genesis); err != nil {
+		return nil, err
+	}
+
+	addrs := make([]string, 0, len(validators))
+	netURLs := make([]string, 0, len(validators))
+	predistribution := make([]interface{}, 0, len(validators))
+	proposers := make([]interface{}, 0, len(validators))
+	for _, v := range validators {
+		addrs = append(addrs, v.Address)
+		netURLs = append(netURLs, v.NetURL)
+		predistribution = append(predistribution, map[string]string{
+			"address": v.Address,
+			"quota":   defQuota,
+		})
+		proposers = append(proposers, map[string]string{
+			"address": v.Address,
+			"neturl":  v.NetURL,
+		})
+	}
+	genesis["predistribution"] = predistribution
+
+	config := genesis["genesis_consensus"].(map[string]interface{})["config"].(map[string]interface{})
+	switch consensus {
+	case "single":
+		config["miner"] = addrs[0]
+	case "tdpos":
+		config["proposer_num"] = fmt.Sprintf("%d", len(validators))
+		config["init_proposer"] = map[string]interface{}{"1": addrs}
+		config["init_proposer_neturl"] = map[string]interface{}{"1": netURLs}
+	case "xpoa":
+		config["init_proposer"] = proposers
+	}
+
+	return json.MarshalIndent(genesis, "", "    ")
+}
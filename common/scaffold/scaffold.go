@@ -0,0 +1,218 @@
+package scaffold
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/xuperchain/xupercore/kernel/network/p2p"
+	cryptoClient "github.com/xuperchain/xupercore/lib/crypto/client"
+	"github.com/xuperchain/xupercore/lib/utils"
+)
+
+// NodeConf 节点目录生成参数
+type NodeConf struct {
+	// 节点根目录
+	RootPath string
+	// root链名
+	BcName string
+	// 创世块共识模板，single|tdpos|xpoa|pow
+	Consensus string
+	// 账户密钥加密类型
+	CryptoType string
+	// p2p监听地址，同时用于生成节点neturl
+	Host           string
+	P2PPort        int
+	RpcPort        int
+	AdapterRpcPort int
+	AdapterGWPort  int
+	MetricPort     int
+	// 为0不开启管理服务
+	AdminPort int
+	// p2p模块，p2pv1|p2pv2，为空时有种子节点用p2pv2，否则用p2pv1
+	P2PModule string
+	BootNodes []string
+	// 节点目录已经初始化过时重新生成配置和创世块，保留已有密钥
+	Force bool
+}
+
+// NodeInfo 节点身份信息
+type NodeInfo struct {
+	Address string
+	PeerId  string
+	NetURL  string
+}
+
+// DefAdminPort 开启管理服务时的默认端口，节点默认不开启
+const DefAdminPort = 36901
+
+func GetDefNodeConf() *NodeConf {
+	return &NodeConf{
+		RootPath:       ".",
+		BcName:         "xuper",
+		Consensus:      "single",
+		CryptoType:     "default",
+		Host:           "127.0.0.1",
+		P2PPort:        47101,
+		RpcPort:        36201,
+		AdapterRpcPort: 36301,
+		AdapterGWPort:  36601,
+		MetricPort:     36801,
+	}
+}
+
+// InitNode 生成节点配置、密钥和创世块，节点作为唯一验证节点
+func InitNode(conf *NodeConf) (*NodeInfo, error) {
+	info, err := InitNodeDir(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	genesis, err := GenGenesis(conf.Consensus, []*Validator{{Address: info.Address, NetURL: info.NetURL}})
+	if err != nil {
+		return nil, err
+	}
+	if err := WriteGenesis(conf.RootPath, conf.BcName, genesis); err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
+// InitNodeDir 生成节点配置和密钥，不生成创世块，供多节点网络统一生成创世块
+func InitNodeDir(conf *NodeConf) (*NodeInfo, error) {
+	if conf == nil || conf.RootPath == "" || conf.BcName == "" {
+		return nil, fmt.Errorf("param error")
+	}
+	if _, ok := genesisTpls[conf.Consensus]; !ok {
+		return nil, fmt.Errorf("consensus template not exist.consensus:%s", conf.Consensus)
+	}
+	if conf.P2PModule != "" && conf.P2PModule != "p2pv1" && conf.P2PModule != "p2pv2" {
+		return nil, fmt.Errorf("p2p module not support.module:%s", conf.P2PModule)
+	}
+
+	rootPath, err := filepath.Abs(conf.RootPath)
+	if err != nil {
+		return nil, err
+	}
+	confDir := filepath.Join(rootPath, "conf")
+	if utils.FileIsExist(filepath.Join(confDir, "env.yaml")) && !conf.Force {
+		return nil, fmt.Errorf("node dir already initialized, use force to overwrite.path:%s", rootPath)
+	}
+
+	info, err := InitNodeKeys(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	// 生成配置文件
+	tplConf := *conf
+	tplConf.RootPath = rootPath
+	// p2pv2启动时需要向dht写入节点地址，没有其他节点时无法启动，单节点默认使用p2pv1
+	if tplConf.P2PModule == "" {
+		tplConf.P2PModule = "p2pv1"
+		if len(tplConf.BootNodes) > 0 {
+			tplConf.P2PModule = "p2pv2"
+		}
+	}
+	files := map[string]string{
+		"env.yaml":     envConfTpl,
+		"engine.yaml":  engineConfTpl,
+		"network.yaml": networkConfTpl,
+		"server.yaml":  serverConfTpl,
+		"ledger.yaml":  ledgerConfTpl,
+		"log.yaml":     logConfTpl,
+		"client.yaml":  clientConfTpl,
+	}
+	if err := os.MkdirAll(confDir, 0755); err != nil {
+		return nil, err
+	}
+	for name, tpl := range files {
+		if err := writeTpl(filepath.Join(confDir, name), tpl, &tplConf); err != nil {
+			return nil, err
+		}
+	}
+
+	return info, nil
+}
+
+// InitNodeKeys 生成节点账户密钥和p2p网络密钥，已存在时保留，重复初始化节点身份不变
+func InitNodeKeys(conf *NodeConf) (*NodeInfo, error) {
+	if conf == nil || conf.RootPath == "" {
+		return nil, fmt.Errorf("param error")
+	}
+	rootPath, err := filepath.Abs(conf.RootPath)
+	if err != nil {
+		return nil, err
+	}
+
+	keyDir := filepath.Join(rootPath, "data", "keys")
+	if !utils.FileIsExist(filepath.Join(keyDir, "address")) {
+		cli, err := cryptoClient.CreateCryptoClient(conf.CryptoType)
+		if err != nil {
+			return nil, fmt.Errorf("create crypto client failed.err:%v", err)
+		}
+		if err := os.MkdirAll(keyDir, 0755); err != nil {
+			return nil, err
+		}
+		if err := cli.ExportNewAccount(keyDir); err != nil {
+			return nil, fmt.Errorf("create account failed.err:%v", err)
+		}
+	}
+	netKeyDir := filepath.Join(rootPath, "data", "netkeys")
+	if !utils.FileIsExist(filepath.Join(netKeyDir, "net_private.key")) {
+		if err := p2p.GenerateKeyPairWithPath(netKeyDir); err != nil {
+			return nil, fmt.Errorf("create net key failed.err:%v", err)
+		}
+	}
+
+	return LoadNodeInfo(rootPath, conf.Host, conf.P2PPort)
+}
+
+// LoadNodeInfo 从节点目录读取账户地址和p2p节点id
+func LoadNodeInfo(rootPath, host string, p2pPort int) (*NodeInfo, error) {
+	addr, err := ioutil.ReadFile(filepath.Join(rootPath, "data", "keys", "address"))
+	if err != nil {
+		return nil, fmt.Errorf("read node address failed.err:%v", err)
+	}
+	peerId, err := p2p.GetPeerIDFromPath(filepath.Join(rootPath, "data", "netkeys"))
+	if err != nil {
+		return nil, fmt.Errorf("read net key failed.err:%v", err)
+	}
+
+	return &NodeInfo{
+		Address: strings.TrimSpace(string(addr)),
+		PeerId:  peerId,
+		NetURL:  fmt.Sprintf("/ip4/%s/tcp/%d/p2p/%s", host, p2pPort, peerId),
+	}, nil
+}
+
+// WriteGenesis 写入data/genesis/<bcName>.json
+func WriteGenesis(rootPath, bcName string, genesis []byte) error {
+	path := GenesisPath(rootPath, bcName)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, genesis, 0644)
+}
+
+// GenesisPath 节点目录下的创世块配置路径
+func GenesisPath(rootPath, bcName string) string {
+	return filepath.Join(rootPath, "data", "genesis", bcName+".json")
+}
+
+func writeTpl(path, tpl string, conf *NodeConf) error {
+	t, err := template.New(filepath.Base(path)).Parse(tpl)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, conf); err != nil {
+		return fmt.Errorf("generate config failed.path:%s,err:%v", path, err)
+	}
+	return ioutil.WriteFile(path, buf.Bytes(), 0644)
+}
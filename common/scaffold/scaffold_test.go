@@ -0,0 +1,100 @@
+package scaffold
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestInitNode(t *testing.T) {
+	root, err := ioutil.TempDir("", "scaffold")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	conf := GetDefNodeConf()
+	conf.RootPath = root
+	info, err := InitNode(conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"env.yaml", "engine.yaml", "network.yaml", "server.yaml",
+		"ledger.yaml", "log.yaml", "client.yaml"} {
+		if _, err := os.Stat(filepath.Join(root, "conf", name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	netConf, _ := ioutil.ReadFile(filepath.Join(root, "conf", "network.yaml"))
+	if !strings.Contains(string(netConf), "module: p2pv1") {
+		t.Fatal("standalone node should use p2pv1")
+	}
+
+	genesis, err := ioutil.ReadFile(GenesisPath(root, conf.BcName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(genesis), info.Address) {
+		t.Fatal("genesis miner not match node address")
+	}
+
+	// 重复初始化需要force，且节点身份不变
+	if _, err := InitNode(conf); err == nil {
+		t.Fatal("init twice without force should fail")
+	}
+	conf.Force = true
+	conf.BootNodes = []string{info.NetURL}
+	info2, err := InitNode(conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info2.Address != info.Address || info2.PeerId != info.PeerId {
+		t.Fatal("node identity changed after force init")
+	}
+	netConf, _ = ioutil.ReadFile(filepath.Join(root, "conf", "network.yaml"))
+	if !strings.Contains(string(netConf), "module: p2pv2") || !strings.Contains(string(netConf), info.NetURL) {
+		t.Fatal("bootnodes not applied")
+	}
+}
+
+func TestGenGenesis(t *testing.T) {
+	validators := []*Validator{
+		{Address: "addr1", NetURL: "/ip4/127.0.0.1/tcp/47101/p2p/peer1"},
+		{Address: "addr2", NetURL: "/ip4/127.0.0.1/tcp/47102/p2p/peer2"},
+	}
+	data, err := GenGenesis("tdpos", validators)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var genesis struct {
+		Predistribution  []map[string]string `json:"predistribution"`
+		GenesisConsensus struct {
+			Config struct {
+				ProposerNum        string              `json:"proposer_num"`
+				InitProposer       map[string][]string `json:"init_proposer"`
+				InitProposerNetURL map[string][]string `json:"init_proposer_neturl"`
+			} `json:"config"`
+		} `json:"genesis_consensus"`
+	}
+	if err := json.Unmarshal(data, &genesis); err != nil {
+		t.Fatal(err)
+	}
+	config := genesis.GenesisConsensus.Config
+	if len(genesis.Predistribution) != 2 || config.ProposerNum != "2" ||
+		len(config.InitProposer["1"]) != 2 || config.InitProposerNetURL["1"][1] != validators[1].NetURL {
+		t.Fatalf("genesis not match validators.genesis:%s", data)
+	}
+
+	if _, err := GenGenesis("unknown", validators); err == nil {
+		t.Fatal("unknown consensus should fail")
+	}
+	for _, consensus := range Consensuses() {
+		if _, err := GenGenesis(consensus, validators); err != nil {
+			t.Fatal(consensus, err)
+		}
+	}
+}
@@ -0,0 +1,211 @@
+package testnet
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"syscall"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/xuperchain/xupercore/lib/utils"
+	"github.com/xuperchain/xuperos/common/daemon"
+	"github.com/xuperchain/xuperos/common/scaffold"
+	"github.com/xuperchain/xuperos/common/xupospb"
+)
+
+const pidFile = "xuperos.pid"
+
+// Node 测试网络中的一个节点进程
+type Node struct {
+	Name string
+	Conf *scaffold.NodeConf
+	Info *scaffold.NodeInfo
+
+	// 当前进程启动的节点，退出时回收子进程
+	cmd  *exec.Cmd
+	done chan struct{}
+}
+
+// NodeStatus 节点运行状态
+type NodeStatus struct {
+	Name        string `json:"name"`
+	Pid         int    `json:"pid"`
+	Running     bool   `json:"running"`
+	Address     string `json:"address"`
+	NetURL      string `json:"neturl"`
+	RpcAddr     string `json:"rpcAddr"`
+	AdapterAddr string `json:"adapterAddr"`
+	AdminAddr   string `json:"adminAddr"`
+	TrunkHeight int64  `json:"trunkHeight"`
+	TipBlockid  string `json:"tipBlockid"`
+	Error       string `json:"error,omitempty"`
+}
+
+// EnvConfPath 节点环境配置路径
+func (n *Node) EnvConfPath() string {
+	return filepath.Join(n.Conf.RootPath, "conf", "env.yaml")
+}
+
+// RpcAddr xuperos rpc服务地址
+func (n *Node) RpcAddr() string {
+	return fmt.Sprintf("127.0.0.1:%d", n.Conf.RpcPort)
+}
+
+// AdapterAddr xchain-cli使用的适配rpc服务地址
+func (n *Node) AdapterAddr() string {
+	return fmt.Sprintf("127.0.0.1:%d", n.Conf.AdapterRpcPort)
+}
+
+// AdminAddr 节点管理服务地址
+func (n *Node) AdminAddr() string {
+	return fmt.Sprintf("127.0.0.1:%d", n.Conf.AdminPort)
+}
+
+// PidFile 节点pid文件路径
+func (n *Node) PidFile() string {
+	return filepath.Join(n.Conf.RootPath, pidFile)
+}
+
+// Pid 读取pid文件，进程不存在时返回0
+func (n *Node) Pid() int {
+	pid, err := daemon.ReadPidFile(n.PidFile())
+	if err != nil || !n.alive(pid) {
+		return 0
+	}
+	return pid
+}
+
+func (n *Node) alive(pid int) bool {
+	if n.cmd != nil && n.cmd.Process.Pid == pid {
+		select {
+		case <-n.done:
+			return false
+		default:
+			return true
+		}
+	}
+	return daemon.Alive(pid)
+}
+
+// 以子进程方式启动节点，进程组独立，启动命令退出后节点继续运行
+func (n *Node) start(binPath string) error {
+	if pid := n.Pid(); pid != 0 {
+		return nil
+	}
+
+	logDir := filepath.Join(n.Conf.RootPath, "logs")
+	if err := os.MkdirAll(logDir, 0755); err != nil {
+		return err
+	}
+	out, err := os.OpenFile(filepath.Join(logDir, "stdout.log"), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	cmd := exec.Command(binPath, "startup", "--conf", n.EnvConfPath(), "--pidfile", n.PidFile())
+	cmd.Dir = n.Conf.RootPath
+	cmd.Stdout = out
+	cmd.Stderr = out
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("start node failed.node:%s,err:%v", n.Name, err)
+	}
+	n.cmd = cmd
+	n.done = make(chan struct{})
+	go func() {
+		cmd.Wait()
+		close(n.done)
+	}()
+
+	// 节点启动后会重写pid文件，提前写入使启动过程中也能查询到进程
+	pid := strconv.Itoa(cmd.Process.Pid)
+	return ioutil.WriteFile(n.PidFile(), []byte(pid), 0644)
+}
+
+// 发送SIGTERM等待节点退出，超时后强制结束
+func (n *Node) stop(timeout time.Duration) error {
+	pid := n.Pid()
+	if pid == 0 {
+		os.Remove(n.PidFile())
+		return nil
+	}
+
+	syscall.Kill(pid, syscall.SIGTERM)
+	deadline := time.Now().Add(timeout)
+	for n.alive(pid) {
+		if time.Now().After(deadline) {
+			syscall.Kill(pid, syscall.SIGKILL)
+			break
+		}
+		time.Sleep(200 * time.Millisecond)
+	}
+	if n.done != nil {
+		<-n.done
+	}
+
+	// 节点正常退出时已经删除pid文件
+	if err := os.Remove(n.PidFile()); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// 通过节点管理服务查询链状态
+func (n *Node) status(ctx context.Context) *NodeStatus {
+	st := &NodeStatus{
+		Name:        n.Name,
+		Address:     n.Info.Address,
+		NetURL:      n.Info.NetURL,
+		RpcAddr:     n.RpcAddr(),
+		AdapterAddr: n.AdapterAddr(),
+		AdminAddr:   n.AdminAddr(),
+	}
+	st.Pid = n.Pid()
+	st.Running = st.Pid != 0
+	if !st.Running {
+		return st
+	}
+
+	chain, err := n.chainStatus(ctx)
+	if err != nil {
+		st.Error = err.Error()
+		return st
+	}
+	st.TrunkHeight = chain.GetTrunkHeight()
+	st.TipBlockid = fmt.Sprintf("%x", chain.GetTipBlockid())
+	return st
+}
+
+func (n *Node) chainStatus(ctx context.Context) (*xupospb.ChainStatus, error) {
+	ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, n.AdminAddr(), grpc.WithInsecure(), grpc.WithBlock())
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	req := &xupospb.BaseReq{
+		Header: &xupospb.ReqHeader{LogId: utils.GenLogId(), SelfName: "testnet"},
+	}
+	resp, err := xupospb.NewXuperAdminClient(conn).ListChains(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetHeader().GetErrCode() != 0 {
+		return nil, fmt.Errorf("%s", resp.GetHeader().GetErrMsg())
+	}
+	for _, chain := range resp.GetChains() {
+		if chain.GetBcName() == n.Conf.BcName {
+			return chain, nil
+		}
+	}
+	return nil, fmt.Errorf("chain not loaded.bcname:%s", n.Conf.BcName)
+}
@@ -0,0 +1,276 @@
+// Package testnet 在本机生成并运行多节点测试网络，供命令行和集成测试使用
+package testnet
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/utils"
+	"github.com/xuperchain/xupercore/kernel/common/xconfig"
+	"github.com/xuperchain/xupercore/lib/logs"
+	_ "github.com/xuperchain/xupercore/lib/storage/kvdb/leveldb"
+	xutils "github.com/xuperchain/xupercore/lib/utils"
+
+	"github.com/xuperchain/xuperos/common/scaffold"
+)
+
+// 测试网络配置文件，记录生成参数，down和status只需要根目录
+const confFile = "testnet.json"
+
+// Conf 测试网络参数
+type Conf struct {
+	RootPath  string `json:"rootPath"`
+	Nodes     int    `json:"nodes"`
+	Consensus string `json:"consensus"`
+	BcName    string `json:"bcName"`
+	// 节点i(从0开始)的端口为scaffold默认端口+PortOffset+i，同一台机器运行多个测试网络时错开
+	PortOffset int `json:"portOffset"`
+	// xuperos可执行文件，不持久化，为空时使用当前可执行文件
+	BinPath string `json:"-"`
+}
+
+// Testnet 本机多节点测试网络
+type Testnet struct {
+	conf  *Conf
+	nodes []*Node
+}
+
+// New 按参数创建测试网络，需要调用Init生成节点目录
+func New(conf *Conf) (*Testnet, error) {
+	if conf == nil || conf.RootPath == "" || conf.Nodes <= 0 {
+		return nil, fmt.Errorf("param error")
+	}
+	if conf.BcName == "" {
+		conf.BcName = "xuper"
+	}
+	if conf.Consensus == "" {
+		conf.Consensus = "tdpos"
+	}
+	rootPath, err := filepath.Abs(conf.RootPath)
+	if err != nil {
+		return nil, err
+	}
+	conf.RootPath = rootPath
+
+	t := &Testnet{conf: conf}
+	for i := 0; i < conf.Nodes; i++ {
+		nodeConf := scaffold.GetDefNodeConf()
+		nodeConf.RootPath = filepath.Join(rootPath, fmt.Sprintf("node%d", i+1))
+		nodeConf.BcName = conf.BcName
+		nodeConf.Consensus = conf.Consensus
+		offset := conf.PortOffset + i
+		nodeConf.P2PPort += offset
+		nodeConf.RpcPort += offset
+		nodeConf.AdapterRpcPort += offset
+		nodeConf.AdapterGWPort += offset
+		nodeConf.MetricPort += offset
+		// 测试网络通过管理服务查询节点状态
+		nodeConf.AdminPort = scaffold.DefAdminPort + offset
+		t.nodes = append(t.nodes, &Node{Name: fmt.Sprintf("node%d", i+1), Conf: nodeConf})
+	}
+	return t, nil
+}
+
+// Load 加载已经生成的测试网络
+func Load(rootPath string) (*Testnet, error) {
+	data, err := ioutil.ReadFile(filepath.Join(rootPath, confFile))
+	if err != nil {
+		return nil, fmt.Errorf("testnet not exist.root:%s,err:%v", rootPath, err)
+	}
+	conf := new(Conf)
+	if err := json.Unmarshal(data, conf); err != nil {
+		return nil, err
+	}
+	conf.RootPath = rootPath
+
+	t, err := New(conf)
+	if err != nil {
+		return nil, err
+	}
+	if err := t.loadNodeInfo(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (t *Testnet) loadNodeInfo() error {
+	for _, node := range t.nodes {
+		info, err := scaffold.LoadNodeInfo(node.Conf.RootPath, node.Conf.Host, node.Conf.P2PPort)
+		if err != nil {
+			return err
+		}
+		node.Info = info
+	}
+	return nil
+}
+
+// Exist 根目录下是否已经生成测试网络
+func Exist(rootPath string) bool {
+	return xutils.FileIsExist(filepath.Join(rootPath, confFile))
+}
+
+func (t *Testnet) Conf() *Conf {
+	return t.conf
+}
+
+func (t *Testnet) Nodes() []*Node {
+	return t.nodes
+}
+
+// Init 生成节点目录和所有节点作为验证节点的创世块，并创建root链账本
+func (t *Testnet) Init() error {
+	if Exist(t.conf.RootPath) {
+		return fmt.Errorf("testnet already exist.root:%s", t.conf.RootPath)
+	}
+
+	// 先生成所有节点身份，每个节点都以全部节点作为种子节点
+	validators := make([]*scaffold.Validator, 0, len(t.nodes))
+	bootNodes := make([]string, 0, len(t.nodes))
+	for _, node := range t.nodes {
+		info, err := scaffold.InitNodeKeys(node.Conf)
+		if err != nil {
+			return err
+		}
+		node.Info = info
+		validators = append(validators, &scaffold.Validator{Address: info.Address, NetURL: info.NetURL})
+		bootNodes = append(bootNodes, info.NetURL)
+	}
+	genesis, err := scaffold.GenGenesis(t.conf.Consensus, validators)
+	if err != nil {
+		return err
+	}
+
+	for _, node := range t.nodes {
+		node.Conf.BootNodes = bootNodes
+		node.Conf.P2PModule = "p2pv2"
+		if _, err := scaffold.InitNodeDir(node.Conf); err != nil {
+			return err
+		}
+		if err := scaffold.WriteGenesis(node.Conf.RootPath, t.conf.BcName, genesis); err != nil {
+			return err
+		}
+		if err := t.createLedger(node); err != nil {
+			return err
+		}
+	}
+
+	data, err := json.MarshalIndent(t.conf, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(t.conf.RootPath, confFile), data, 0644)
+}
+
+func (t *Testnet) createLedger(node *Node) error {
+	envConf, err := xconfig.LoadEnvConf(node.EnvConfPath())
+	if err != nil {
+		return err
+	}
+	// 创建账本需要初始化日志，日志全局只初始化一次，输出到第一个节点日志目录
+	logs.InitLog(envConf.GenConfFilePath(envConf.LogConf), envConf.GenDirAbsPath(envConf.LogDir))
+
+	genesisPath := scaffold.GenesisPath(node.Conf.RootPath, t.conf.BcName)
+	if err := utils.CreateLedger(t.conf.BcName, genesisPath, envConf); err != nil {
+		return fmt.Errorf("create ledger failed.node:%s,err:%v", node.Name, err)
+	}
+	return nil
+}
+
+// Start 启动未运行的节点
+func (t *Testnet) Start() error {
+	binPath := t.conf.BinPath
+	if binPath == "" {
+		var err error
+		if binPath, err = os.Executable(); err != nil {
+			return err
+		}
+	}
+
+	for _, node := range t.nodes {
+		if err := node.start(binPath); err != nil {
+			t.Stop()
+			return err
+		}
+	}
+	return nil
+}
+
+// Stop 停止所有节点
+func (t *Testnet) Stop() error {
+	var lastErr error
+	for _, node := range t.nodes {
+		if err := node.stop(30 * time.Second); err != nil {
+			lastErr = err
+		}
+	}
+	return lastErr
+}
+
+// Status 查询所有节点状态
+func (t *Testnet) Status(ctx context.Context) []*NodeStatus {
+	status := make([]*NodeStatus, 0, len(t.nodes))
+	for _, node := range t.nodes {
+		status = append(status, node.status(ctx))
+	}
+	return status
+}
+
+// WaitAgreement 等待所有节点主干高度不低于minHeight且最新区块一致，返回一致时的高度
+func (t *Testnet) WaitAgreement(ctx context.Context, minHeight int64) (int64, error) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		status := t.Status(ctx)
+		if height, ok := agreed(status, minHeight); ok {
+			return height, nil
+		}
+		for _, st := range status {
+			if !st.Running {
+				return 0, fmt.Errorf("node exited.node:%s", st.Name)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return 0, fmt.Errorf("wait nodes agreement timeout.err:%v", ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+func agreed(status []*NodeStatus, minHeight int64) (int64, bool) {
+	if len(status) == 0 {
+		return 0, false
+	}
+	first := status[0]
+	for _, st := range status {
+		if !st.Running || st.Error != "" || st.TrunkHeight < minHeight ||
+			st.TrunkHeight != first.TrunkHeight || st.TipBlockid != first.TipBlockid {
+			return 0, false
+		}
+	}
+	return first.TrunkHeight, true
+}
+
+// Up 生成(已生成时跳过)并启动测试网络，等待所有节点出块并达成一致，集成测试使用
+func (t *Testnet) Up(ctx context.Context) (int64, error) {
+	var err error
+	if Exist(t.conf.RootPath) {
+		err = t.loadNodeInfo()
+	} else {
+		err = t.Init()
+	}
+	if err != nil {
+		return 0, err
+	}
+	if err := t.Start(); err != nil {
+		return 0, err
+	}
+	return t.WaitAgreement(ctx, 1)
+}
@@ -0,0 +1,106 @@
+package testnet
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestInit(t *testing.T) {
+	root, err := ioutil.TempDir("", "testnet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	tn, err := New(&Conf{RootPath: root, Nodes: 2, PortOffset: 500})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tn.Init(); err != nil {
+		t.Fatal(err)
+	}
+	if err := tn.Init(); err == nil {
+		t.Fatal("init twice should fail")
+	}
+
+	tn, err = Load(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tn.Nodes()) != 2 || tn.Conf().Consensus != "tdpos" {
+		t.Fatalf("load testnet conf not match.conf:%+v", tn.Conf())
+	}
+	node1, node2 := tn.Nodes()[0], tn.Nodes()[1]
+	if node2.Conf.P2PPort != node1.Conf.P2PPort+1 || node1.Info.Address == node2.Info.Address {
+		t.Fatal("nodes should have distinct ports and keys")
+	}
+	for _, node := range tn.Nodes() {
+		genesis, err := ioutil.ReadFile(filepath.Join(node.Conf.RootPath, "data", "blockchain", "xuper", "xuper.json"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(string(genesis), node1.Info.NetURL) || !strings.Contains(string(genesis), node2.Info.NetURL) {
+			t.Fatal("genesis should contain all validators")
+		}
+	}
+
+	for _, st := range tn.Status(context.Background()) {
+		if st.Running {
+			t.Fatal("node should not running")
+		}
+	}
+	if err := tn.Stop(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAgreed(t *testing.T) {
+	status := []*NodeStatus{
+		{Running: true, TrunkHeight: 3, TipBlockid: "aa"},
+		{Running: true, TrunkHeight: 3, TipBlockid: "aa"},
+	}
+	if height, ok := agreed(status, 1); !ok || height != 3 {
+		t.Fatal("nodes should agree")
+	}
+	if _, ok := agreed(status, 4); ok {
+		t.Fatal("height lower than min height")
+	}
+	status[1].TipBlockid = "bb"
+	if _, ok := agreed(status, 1); ok {
+		t.Fatal("tip block not match")
+	}
+}
+
+// 需要编译好的xuperos，且同目录下有wasm2c
+// XUPEROS_BIN=/path/to/output/bin/xuperos go test -run TestUp ./common/testnet
+func TestUp(t *testing.T) {
+	binPath := os.Getenv("XUPEROS_BIN")
+	if binPath == "" {
+		t.Skip("XUPEROS_BIN not set")
+	}
+	root, err := ioutil.TempDir("", "testnet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	tn, err := New(&Conf{RootPath: root, Nodes: 3, PortOffset: 600, BinPath: binPath})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tn.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+	if _, err := tn.Up(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tn.WaitAgreement(ctx, 3); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -10,6 +10,17 @@ var (
 	CommitID  = ""
 )
 
+// SetBuildInfo 由main包设置编译时注入的版本信息
+func SetBuildInfo(ver, commitId, buildTime string) {
+	version = ver
+	CommitID = commitId
+	BuildTime = buildTime
+}
+
+func GetVersion() string {
+	return version
+}
+
 func Version() {
 	fmt.Printf("%s-%s %s\n", version, CommitID, BuildTime)
 }
@@ -0,0 +1,1339 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: admin.proto
+
+package xupospb
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// 链运行状态
+type ChainState int32
+
+const (
+	// 数据目录存在但没有加载
+	ChainState_CHAIN_UNLOADED ChainState = 0
+	ChainState_CHAIN_RUNNING  ChainState = 1
+	// 已停止同步和出块，仍可以查询，重新运行需要先卸载再加载
+	ChainState_CHAIN_STOPPED ChainState = 2
+)
+
+var ChainState_name = map[int32]string{
+	0: "CHAIN_UNLOADED",
+	1: "CHAIN_RUNNING",
+	2: "CHAIN_STOPPED",
+}
+
+var ChainState_value = map[string]int32{
+	"CHAIN_UNLOADED": 0,
+	"CHAIN_RUNNING":  1,
+	"CHAIN_STOPPED":  2,
+}
+
+func (x ChainState) String() string {
+	return proto.EnumName(ChainState_name, int32(x))
+}
+
+func (ChainState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{0}
+}
+
+// 模块日志级别
+type LogLevel struct {
+	// 日志s_mod字段
+	Module               string   `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	Level                string   `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LogLevel) Reset()         { *m = LogLevel{} }
+func (m *LogLevel) String() string { return proto.CompactTextString(m) }
+func (*LogLevel) ProtoMessage()    {}
+func (*LogLevel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{0}
+}
+
+func (m *LogLevel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogLevel.Unmarshal(m, b)
+}
+func (m *LogLevel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogLevel.Marshal(b, m, deterministic)
+}
+func (m *LogLevel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogLevel.Merge(m, src)
+}
+func (m *LogLevel) XXX_Size() int {
+	return xxx_messageInfo_LogLevel.Size(m)
+}
+func (m *LogLevel) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogLevel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogLevel proto.InternalMessageInfo
+
+func (m *LogLevel) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *LogLevel) GetLevel() string {
+	if m != nil {
+		return m.Level
+	}
+	return ""
+}
+
+type LogLevelsResp struct {
+	Header *RespHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// 日志配置的级别，模块级别不能比它更详细
+	DefaultLevel string `protobuf:"bytes,2,opt,name=default_level,json=defaultLevel,proto3" json:"default_level,omitempty"`
+	// 已输出过日志的模块和单独设置过级别的模块
+	Levels               []*LogLevel `protobuf:"bytes,3,rep,name=levels,proto3" json:"levels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *LogLevelsResp) Reset()         { *m = LogLevelsResp{} }
+func (m *LogLevelsResp) String() string { return proto.CompactTextString(m) }
+func (*LogLevelsResp) ProtoMessage()    {}
+func (*LogLevelsResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{1}
+}
+
+func (m *LogLevelsResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogLevelsResp.Unmarshal(m, b)
+}
+func (m *LogLevelsResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogLevelsResp.Marshal(b, m, deterministic)
+}
+func (m *LogLevelsResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogLevelsResp.Merge(m, src)
+}
+func (m *LogLevelsResp) XXX_Size() int {
+	return xxx_messageInfo_LogLevelsResp.Size(m)
+}
+func (m *LogLevelsResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogLevelsResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogLevelsResp proto.InternalMessageInfo
+
+func (m *LogLevelsResp) GetHeader() *RespHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *LogLevelsResp) GetDefaultLevel() string {
+	if m != nil {
+		return m.DefaultLevel
+	}
+	return ""
+}
+
+func (m *LogLevelsResp) GetLevels() []*LogLevel {
+	if m != nil {
+		return m.Levels
+	}
+	return nil
+}
+
+type SetLogLevelReq struct {
+	Header *ReqHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Module string     `protobuf:"bytes,2,opt,name=module,proto3" json:"module,omitempty"`
+	// 为空恢复为默认级别
+	Level                string   `protobuf:"bytes,3,opt,name=level,proto3" json:"level,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetLogLevelReq) Reset()         { *m = SetLogLevelReq{} }
+func (m *SetLogLevelReq) String() string { return proto.CompactTextString(m) }
+func (*SetLogLevelReq) ProtoMessage()    {}
+func (*SetLogLevelReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{2}
+}
+
+func (m *SetLogLevelReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetLogLevelReq.Unmarshal(m, b)
+}
+func (m *SetLogLevelReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetLogLevelReq.Marshal(b, m, deterministic)
+}
+func (m *SetLogLevelReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetLogLevelReq.Merge(m, src)
+}
+func (m *SetLogLevelReq) XXX_Size() int {
+	return xxx_messageInfo_SetLogLevelReq.Size(m)
+}
+func (m *SetLogLevelReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetLogLevelReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetLogLevelReq proto.InternalMessageInfo
+
+func (m *SetLogLevelReq) GetHeader() *ReqHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *SetLogLevelReq) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *SetLogLevelReq) GetLevel() string {
+	if m != nil {
+		return m.Level
+	}
+	return ""
+}
+
+type ProfileReq struct {
+	Header *ReqHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// runtime/pprof名称，如goroutine、heap
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// 同pprof的debug参数，0为protobuf格式
+	Debug                int32    `protobuf:"varint,3,opt,name=debug,proto3" json:"debug,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProfileReq) Reset()         { *m = ProfileReq{} }
+func (m *ProfileReq) String() string { return proto.CompactTextString(m) }
+func (*ProfileReq) ProtoMessage()    {}
+func (*ProfileReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{3}
+}
+
+func (m *ProfileReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProfileReq.Unmarshal(m, b)
+}
+func (m *ProfileReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProfileReq.Marshal(b, m, deterministic)
+}
+func (m *ProfileReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProfileReq.Merge(m, src)
+}
+func (m *ProfileReq) XXX_Size() int {
+	return xxx_messageInfo_ProfileReq.Size(m)
+}
+func (m *ProfileReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProfileReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProfileReq proto.InternalMessageInfo
+
+func (m *ProfileReq) GetHeader() *ReqHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *ProfileReq) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ProfileReq) GetDebug() int32 {
+	if m != nil {
+		return m.Debug
+	}
+	return 0
+}
+
+type ProfileResp struct {
+	Header               *RespHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Data                 []byte      `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ProfileResp) Reset()         { *m = ProfileResp{} }
+func (m *ProfileResp) String() string { return proto.CompactTextString(m) }
+func (*ProfileResp) ProtoMessage()    {}
+func (*ProfileResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{4}
+}
+
+func (m *ProfileResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProfileResp.Unmarshal(m, b)
+}
+func (m *ProfileResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProfileResp.Marshal(b, m, deterministic)
+}
+func (m *ProfileResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProfileResp.Merge(m, src)
+}
+func (m *ProfileResp) XXX_Size() int {
+	return xxx_messageInfo_ProfileResp.Size(m)
+}
+func (m *ProfileResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProfileResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProfileResp proto.InternalMessageInfo
+
+func (m *ProfileResp) GetHeader() *RespHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *ProfileResp) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type PeerInfo struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address              string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Account              string   `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PeerInfo) Reset()         { *m = PeerInfo{} }
+func (m *PeerInfo) String() string { return proto.CompactTextString(m) }
+func (*PeerInfo) ProtoMessage()    {}
+func (*PeerInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{5}
+}
+
+func (m *PeerInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PeerInfo.Unmarshal(m, b)
+}
+func (m *PeerInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PeerInfo.Marshal(b, m, deterministic)
+}
+func (m *PeerInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerInfo.Merge(m, src)
+}
+func (m *PeerInfo) XXX_Size() int {
+	return xxx_messageInfo_PeerInfo.Size(m)
+}
+func (m *PeerInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerInfo proto.InternalMessageInfo
+
+func (m *PeerInfo) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *PeerInfo) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PeerInfo) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+type PeersResp struct {
+	Header *RespHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// 本节点信息
+	Local                *PeerInfo   `protobuf:"bytes,2,opt,name=local,proto3" json:"local,omitempty"`
+	Peers                []*PeerInfo `protobuf:"bytes,3,rep,name=peers,proto3" json:"peers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *PeersResp) Reset()         { *m = PeersResp{} }
+func (m *PeersResp) String() string { return proto.CompactTextString(m) }
+func (*PeersResp) ProtoMessage()    {}
+func (*PeersResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{6}
+}
+
+func (m *PeersResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PeersResp.Unmarshal(m, b)
+}
+func (m *PeersResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PeersResp.Marshal(b, m, deterministic)
+}
+func (m *PeersResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeersResp.Merge(m, src)
+}
+func (m *PeersResp) XXX_Size() int {
+	return xxx_messageInfo_PeersResp.Size(m)
+}
+func (m *PeersResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeersResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeersResp proto.InternalMessageInfo
+
+func (m *PeersResp) GetHeader() *RespHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *PeersResp) GetLocal() *PeerInfo {
+	if m != nil {
+		return m.Local
+	}
+	return nil
+}
+
+func (m *PeersResp) GetPeers() []*PeerInfo {
+	if m != nil {
+		return m.Peers
+	}
+	return nil
+}
+
+type PeerReq struct {
+	Header *ReqHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// 连接时为节点地址，断开时为节点id
+	Peer                 string   `protobuf:"bytes,2,opt,name=peer,proto3" json:"peer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PeerReq) Reset()         { *m = PeerReq{} }
+func (m *PeerReq) String() string { return proto.CompactTextString(m) }
+func (*PeerReq) ProtoMessage()    {}
+func (*PeerReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{7}
+}
+
+func (m *PeerReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PeerReq.Unmarshal(m, b)
+}
+func (m *PeerReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PeerReq.Marshal(b, m, deterministic)
+}
+func (m *PeerReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerReq.Merge(m, src)
+}
+func (m *PeerReq) XXX_Size() int {
+	return xxx_messageInfo_PeerReq.Size(m)
+}
+func (m *PeerReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerReq proto.InternalMessageInfo
+
+func (m *PeerReq) GetHeader() *ReqHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *PeerReq) GetPeer() string {
+	if m != nil {
+		return m.Peer
+	}
+	return ""
+}
+
+type ReloadConfigResp struct {
+	Header *RespHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// 已经生效的配置项
+	Applied []string `protobuf:"bytes,2,rep,name=applied,proto3" json:"applied,omitempty"`
+	// 有变更但需要重启生效的配置项
+	NeedRestart          []string `protobuf:"bytes,3,rep,name=need_restart,json=needRestart,proto3" json:"need_restart,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReloadConfigResp) Reset()         { *m = ReloadConfigResp{} }
+func (m *ReloadConfigResp) String() string { return proto.CompactTextString(m) }
+func (*ReloadConfigResp) ProtoMessage()    {}
+func (*ReloadConfigResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{8}
+}
+
+func (m *ReloadConfigResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReloadConfigResp.Unmarshal(m, b)
+}
+func (m *ReloadConfigResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReloadConfigResp.Marshal(b, m, deterministic)
+}
+func (m *ReloadConfigResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReloadConfigResp.Merge(m, src)
+}
+func (m *ReloadConfigResp) XXX_Size() int {
+	return xxx_messageInfo_ReloadConfigResp.Size(m)
+}
+func (m *ReloadConfigResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReloadConfigResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReloadConfigResp proto.InternalMessageInfo
+
+func (m *ReloadConfigResp) GetHeader() *RespHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *ReloadConfigResp) GetApplied() []string {
+	if m != nil {
+		return m.Applied
+	}
+	return nil
+}
+
+func (m *ReloadConfigResp) GetNeedRestart() []string {
+	if m != nil {
+		return m.NeedRestart
+	}
+	return nil
+}
+
+type BuildInfoResp struct {
+	Header               *RespHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Version              string      `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	CommitId             string      `protobuf:"bytes,3,opt,name=commit_id,json=commitId,proto3" json:"commit_id,omitempty"`
+	BuildTime            string      `protobuf:"bytes,4,opt,name=build_time,json=buildTime,proto3" json:"build_time,omitempty"`
+	GoVersion            string      `protobuf:"bytes,5,opt,name=go_version,json=goVersion,proto3" json:"go_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *BuildInfoResp) Reset()         { *m = BuildInfoResp{} }
+func (m *BuildInfoResp) String() string { return proto.CompactTextString(m) }
+func (*BuildInfoResp) ProtoMessage()    {}
+func (*BuildInfoResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{9}
+}
+
+func (m *BuildInfoResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BuildInfoResp.Unmarshal(m, b)
+}
+func (m *BuildInfoResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BuildInfoResp.Marshal(b, m, deterministic)
+}
+func (m *BuildInfoResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuildInfoResp.Merge(m, src)
+}
+func (m *BuildInfoResp) XXX_Size() int {
+	return xxx_messageInfo_BuildInfoResp.Size(m)
+}
+func (m *BuildInfoResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuildInfoResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BuildInfoResp proto.InternalMessageInfo
+
+func (m *BuildInfoResp) GetHeader() *RespHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *BuildInfoResp) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *BuildInfoResp) GetCommitId() string {
+	if m != nil {
+		return m.CommitId
+	}
+	return ""
+}
+
+func (m *BuildInfoResp) GetBuildTime() string {
+	if m != nil {
+		return m.BuildTime
+	}
+	return ""
+}
+
+func (m *BuildInfoResp) GetGoVersion() string {
+	if m != nil {
+		return m.GoVersion
+	}
+	return ""
+}
+
+type ChainStatus struct {
+	BcName string     `protobuf:"bytes,1,opt,name=bc_name,json=bcName,proto3" json:"bc_name,omitempty"`
+	State  ChainState `protobuf:"varint,2,opt,name=state,proto3,enum=xupospb.ChainState" json:"state,omitempty"`
+	IsRoot bool       `protobuf:"varint,3,opt,name=is_root,json=isRoot,proto3" json:"is_root,omitempty"`
+	// 未加载时为空
+	TrunkHeight          int64    `protobuf:"varint,4,opt,name=trunk_height,json=trunkHeight,proto3" json:"trunk_height,omitempty"`
+	TipBlockid           []byte   `protobuf:"bytes,5,opt,name=tip_blockid,json=tipBlockid,proto3" json:"tip_blockid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChainStatus) Reset()         { *m = ChainStatus{} }
+func (m *ChainStatus) String() string { return proto.CompactTextString(m) }
+func (*ChainStatus) ProtoMessage()    {}
+func (*ChainStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{10}
+}
+
+func (m *ChainStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChainStatus.Unmarshal(m, b)
+}
+func (m *ChainStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChainStatus.Marshal(b, m, deterministic)
+}
+func (m *ChainStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainStatus.Merge(m, src)
+}
+func (m *ChainStatus) XXX_Size() int {
+	return xxx_messageInfo_ChainStatus.Size(m)
+}
+func (m *ChainStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainStatus proto.InternalMessageInfo
+
+func (m *ChainStatus) GetBcName() string {
+	if m != nil {
+		return m.BcName
+	}
+	return ""
+}
+
+func (m *ChainStatus) GetState() ChainState {
+	if m != nil {
+		return m.State
+	}
+	return ChainState_CHAIN_UNLOADED
+}
+
+func (m *ChainStatus) GetIsRoot() bool {
+	if m != nil {
+		return m.IsRoot
+	}
+	return false
+}
+
+func (m *ChainStatus) GetTrunkHeight() int64 {
+	if m != nil {
+		return m.TrunkHeight
+	}
+	return 0
+}
+
+func (m *ChainStatus) GetTipBlockid() []byte {
+	if m != nil {
+		return m.TipBlockid
+	}
+	return nil
+}
+
+type ChainsResp struct {
+	Header               *RespHeader    `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Chains               []*ChainStatus `protobuf:"bytes,2,rep,name=chains,proto3" json:"chains,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ChainsResp) Reset()         { *m = ChainsResp{} }
+func (m *ChainsResp) String() string { return proto.CompactTextString(m) }
+func (*ChainsResp) ProtoMessage()    {}
+func (*ChainsResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{11}
+}
+
+func (m *ChainsResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChainsResp.Unmarshal(m, b)
+}
+func (m *ChainsResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChainsResp.Marshal(b, m, deterministic)
+}
+func (m *ChainsResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainsResp.Merge(m, src)
+}
+func (m *ChainsResp) XXX_Size() int {
+	return xxx_messageInfo_ChainsResp.Size(m)
+}
+func (m *ChainsResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainsResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainsResp proto.InternalMessageInfo
+
+func (m *ChainsResp) GetHeader() *RespHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *ChainsResp) GetChains() []*ChainStatus {
+	if m != nil {
+		return m.Chains
+	}
+	return nil
+}
+
+type ChainReq struct {
+	Header               *ReqHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	BcName               string     `protobuf:"bytes,2,opt,name=bc_name,json=bcName,proto3" json:"bc_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ChainReq) Reset()         { *m = ChainReq{} }
+func (m *ChainReq) String() string { return proto.CompactTextString(m) }
+func (*ChainReq) ProtoMessage()    {}
+func (*ChainReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{12}
+}
+
+func (m *ChainReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChainReq.Unmarshal(m, b)
+}
+func (m *ChainReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChainReq.Marshal(b, m, deterministic)
+}
+func (m *ChainReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainReq.Merge(m, src)
+}
+func (m *ChainReq) XXX_Size() int {
+	return xxx_messageInfo_ChainReq.Size(m)
+}
+func (m *ChainReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainReq proto.InternalMessageInfo
+
+func (m *ChainReq) GetHeader() *ReqHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *ChainReq) GetBcName() string {
+	if m != nil {
+		return m.BcName
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("xupospb.ChainState", ChainState_name, ChainState_value)
+	proto.RegisterType((*LogLevel)(nil), "xupospb.LogLevel")
+	proto.RegisterType((*LogLevelsResp)(nil), "xupospb.LogLevelsResp")
+	proto.RegisterType((*SetLogLevelReq)(nil), "xupospb.SetLogLevelReq")
+	proto.RegisterType((*ProfileReq)(nil), "xupospb.ProfileReq")
+	proto.RegisterType((*ProfileResp)(nil), "xupospb.ProfileResp")
+	proto.RegisterType((*PeerInfo)(nil), "xupospb.PeerInfo")
+	proto.RegisterType((*PeersResp)(nil), "xupospb.PeersResp")
+	proto.RegisterType((*PeerReq)(nil), "xupospb.PeerReq")
+	proto.RegisterType((*ReloadConfigResp)(nil), "xupospb.ReloadConfigResp")
+	proto.RegisterType((*BuildInfoResp)(nil), "xupospb.BuildInfoResp")
+	proto.RegisterType((*ChainStatus)(nil), "xupospb.ChainStatus")
+	proto.RegisterType((*ChainsResp)(nil), "xupospb.ChainsResp")
+	proto.RegisterType((*ChainReq)(nil), "xupospb.ChainReq")
+}
+
+func init() { proto.RegisterFile("admin.proto", fileDescriptor_73a7fc70dcc2027c) }
+
+var fileDescriptor_73a7fc70dcc2027c = []byte{
+	// 846 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x8e, 0x24, 0x4b, 0x96, 0x86, 0x92, 0x20, 0x6f, 0x8d, 0x44, 0x75, 0x51, 0xd4, 0x65, 0x0f,
+	0x4d, 0xd2, 0xc2, 0x07, 0x1b, 0x41, 0x8b, 0x06, 0x3d, 0xd8, 0x56, 0x1a, 0x0b, 0x30, 0x68, 0x63,
+	0x1d, 0x17, 0xbd, 0x11, 0x24, 0x77, 0x2d, 0x6f, 0x43, 0x72, 0x19, 0xee, 0x32, 0xc8, 0xa1, 0x4f,
+	0x50, 0xf4, 0x59, 0x0a, 0xf4, 0xda, 0xa7, 0x0b, 0x76, 0x96, 0xa4, 0x28, 0x5b, 0x09, 0x20, 0xdf,
+	0x38, 0xdf, 0xcc, 0xec, 0x7c, 0xf3, 0xe3, 0x4f, 0x06, 0x27, 0x60, 0x89, 0x48, 0x0f, 0xb2, 0x5c,
+	0x6a, 0x49, 0xb6, 0x3f, 0x14, 0x99, 0x54, 0x59, 0xb8, 0x37, 0xfa, 0x50, 0x64, 0x3c, 0x97, 0xca,
+	0xe2, 0xee, 0xcf, 0xd0, 0x3f, 0x97, 0x8b, 0x73, 0xfe, 0x9e, 0xc7, 0xe4, 0x31, 0xf4, 0x12, 0xc9,
+	0x8a, 0x98, 0x4f, 0x5b, 0xfb, 0xad, 0xa7, 0x03, 0x5a, 0x5a, 0x64, 0x17, 0xba, 0xb1, 0x09, 0x98,
+	0xb6, 0x11, 0xb6, 0x86, 0xfb, 0x4f, 0x0b, 0x46, 0x55, 0xaa, 0xa2, 0x5c, 0x65, 0xe4, 0x07, 0xe8,
+	0xdd, 0xf2, 0x80, 0xf1, 0x1c, 0xf3, 0x9d, 0xc3, 0x2f, 0x0e, 0xca, 0xa2, 0x07, 0xc6, 0x7d, 0x86,
+	0x2e, 0x5a, 0x86, 0x90, 0xef, 0x60, 0xc4, 0xf8, 0x4d, 0x50, 0xc4, 0xda, 0x6f, 0x3e, 0x3e, 0x2c,
+	0x41, 0xcb, 0xe8, 0x19, 0xf4, 0xd0, 0xa9, 0xa6, 0x9d, 0xfd, 0xce, 0x53, 0xe7, 0x70, 0xa7, 0x7e,
+	0xb1, 0xaa, 0x4c, 0xcb, 0x00, 0xf7, 0x4f, 0x18, 0x5f, 0x71, 0x5d, 0xc3, 0xfc, 0x1d, 0x79, 0x7e,
+	0x87, 0x0e, 0x69, 0xd0, 0x79, 0x77, 0x87, 0xcd, 0xb2, 0xf5, 0xf6, 0xfa, 0xd6, 0x3b, 0xcd, 0xd6,
+	0x43, 0x80, 0xcb, 0x5c, 0xde, 0x88, 0x98, 0x6f, 0x5a, 0x87, 0xc0, 0x56, 0x1a, 0x24, 0x55, 0x15,
+	0xfc, 0x36, 0x35, 0x18, 0x0f, 0x8b, 0x05, 0xd6, 0xe8, 0x52, 0x6b, 0xb8, 0x1e, 0x38, 0x75, 0x8d,
+	0x4d, 0x67, 0x4b, 0x60, 0x8b, 0x05, 0x3a, 0xc0, 0x2a, 0x43, 0x8a, 0xdf, 0xae, 0x07, 0xfd, 0x4b,
+	0xce, 0xf3, 0x79, 0x7a, 0x23, 0xc9, 0x18, 0xda, 0x82, 0x95, 0x4b, 0x6e, 0x0b, 0x46, 0xa6, 0xb0,
+	0x1d, 0x30, 0x96, 0x73, 0xa5, 0x4a, 0x62, 0x95, 0x89, 0x9e, 0x28, 0x92, 0x45, 0xaa, 0xcb, 0x09,
+	0x54, 0xa6, 0xfb, 0x77, 0x0b, 0x06, 0xe6, 0xc1, 0x07, 0xac, 0xfe, 0x7b, 0xe8, 0xc6, 0x32, 0x0a,
+	0xec, 0xca, 0x9b, 0x4b, 0xad, 0x08, 0x52, 0xeb, 0x37, 0x81, 0x99, 0x29, 0x71, 0x6f, 0xfb, 0xcb,
+	0x40, 0xf4, 0xbb, 0x73, 0xd8, 0x36, 0xd0, 0x03, 0xb6, 0x61, 0xf2, 0xab, 0x6d, 0x98, 0x6f, 0xf7,
+	0x2f, 0x98, 0x50, 0x1e, 0xcb, 0x80, 0x9d, 0xca, 0xf4, 0x46, 0x2c, 0x36, 0xef, 0xce, 0x8c, 0x2c,
+	0xcb, 0x62, 0xc1, 0xd9, 0xb4, 0xbd, 0xdf, 0xc1, 0x91, 0x59, 0x93, 0x7c, 0x0b, 0xc3, 0x94, 0x73,
+	0xe6, 0xe7, 0x5c, 0xe9, 0x20, 0xd7, 0xd8, 0xd5, 0x80, 0x3a, 0x06, 0xa3, 0x16, 0x72, 0xff, 0x6b,
+	0xc1, 0xe8, 0xa4, 0x10, 0x31, 0xc3, 0xee, 0x1e, 0x52, 0xfb, 0x3d, 0xcf, 0x95, 0x90, 0x69, 0xb5,
+	0xc8, 0xd2, 0x24, 0x5f, 0xc1, 0x20, 0x92, 0x49, 0x22, 0xb4, 0x2f, 0x58, 0xb9, 0xca, 0xbe, 0x05,
+	0xe6, 0x8c, 0x7c, 0x0d, 0x10, 0x9a, 0xa2, 0xbe, 0x16, 0x09, 0x9f, 0x6e, 0xa1, 0x77, 0x80, 0xc8,
+	0x1b, 0x91, 0x70, 0xe3, 0x5e, 0x48, 0xbf, 0x7a, 0xb8, 0x6b, 0xdd, 0x0b, 0xf9, 0xbb, 0x05, 0xdc,
+	0x7f, 0x5b, 0xe0, 0x9c, 0xde, 0x06, 0x22, 0xbd, 0xd2, 0x81, 0x2e, 0x14, 0x79, 0x02, 0xdb, 0x61,
+	0xe4, 0xe3, 0x99, 0x97, 0x3a, 0x12, 0x46, 0x9e, 0x39, 0xf4, 0x67, 0xd0, 0x55, 0x3a, 0xd0, 0xf6,
+	0xfa, 0xc7, 0x8d, 0x4e, 0xea, 0x6c, 0x4e, 0x6d, 0x84, 0x79, 0x43, 0x28, 0x3f, 0x97, 0xd2, 0xde,
+	0x5d, 0x9f, 0xf6, 0x84, 0xa2, 0x52, 0x6a, 0x33, 0x43, 0x9d, 0x17, 0xe9, 0x5b, 0xff, 0x96, 0x8b,
+	0xc5, 0xad, 0x46, 0xb2, 0x1d, 0xea, 0x20, 0x76, 0x86, 0x10, 0xf9, 0x06, 0x1c, 0x2d, 0x32, 0x3f,
+	0x8c, 0x65, 0xf4, 0x56, 0x30, 0xe4, 0x3b, 0xa4, 0xa0, 0x45, 0x76, 0x62, 0x11, 0x77, 0x01, 0x80,
+	0x15, 0x1f, 0x70, 0xba, 0x3f, 0x42, 0x2f, 0xc2, 0x54, 0xdc, 0xad, 0x73, 0xb8, 0x7b, 0xbf, 0x87,
+	0x42, 0xd1, 0x32, 0xc6, 0xbd, 0x80, 0x3e, 0xc2, 0x9b, 0xde, 0x65, 0x63, 0x82, 0xed, 0xe6, 0x04,
+	0x9f, 0xff, 0x06, 0x50, 0xd7, 0xe1, 0x84, 0xc0, 0xf8, 0xf4, 0xec, 0x78, 0xee, 0xf9, 0xd7, 0xde,
+	0xf9, 0xc5, 0xf1, 0xec, 0xd5, 0x6c, 0xf2, 0x88, 0xec, 0xc0, 0xc8, 0x62, 0xf4, 0xda, 0xf3, 0xe6,
+	0xde, 0xeb, 0x49, 0x6b, 0x09, 0x5d, 0xbd, 0xb9, 0xb8, 0xbc, 0x7c, 0x35, 0x9b, 0xb4, 0x0f, 0xff,
+	0xef, 0x02, 0xfc, 0x61, 0x7e, 0x07, 0x8e, 0xcd, 0x4f, 0x04, 0x79, 0x09, 0xa3, 0x73, 0xa1, 0x6a,
+	0xf1, 0x54, 0x64, 0x52, 0x93, 0x3b, 0x09, 0x94, 0x11, 0xb9, 0xbd, 0xc7, 0xf7, 0x94, 0x17, 0xa7,
+	0xe7, 0x3e, 0x22, 0x2f, 0xc1, 0x69, 0x08, 0x2f, 0x79, 0x52, 0x07, 0xae, 0xca, 0xf1, 0xde, 0xce,
+	0x9d, 0x37, 0x31, 0xf9, 0x17, 0x70, 0x66, 0x45, 0x92, 0x95, 0x4a, 0x47, 0x96, 0xb3, 0x5f, 0xea,
+	0xeb, 0xde, 0xee, 0x7d, 0x10, 0x73, 0x8f, 0x60, 0x60, 0x58, 0xa3, 0x08, 0xad, 0x61, 0x4c, 0x56,
+	0xd4, 0xa2, 0x62, 0xfb, 0x02, 0x9c, 0x53, 0x99, 0xa6, 0x3c, 0xc2, 0xbc, 0x46, 0x5a, 0xa9, 0x1f,
+	0x9f, 0x48, 0xfb, 0x09, 0xc6, 0x33, 0xa1, 0xa2, 0xcf, 0x66, 0xae, 0x6d, 0xf0, 0x57, 0x18, 0x36,
+	0xe5, 0x64, 0x0d, 0xcf, 0x2f, 0x1b, 0x87, 0xb0, 0xaa, 0x3b, 0x38, 0x9f, 0xe1, 0x6b, 0xae, 0x6b,
+	0x45, 0xf8, 0xec, 0x62, 0x56, 0x74, 0x03, 0x5b, 0x05, 0x33, 0x1f, 0x7b, 0xea, 0x6b, 0x32, 0xef,
+	0xfc, 0xfd, 0xa9, 0xc6, 0x58, 0x0d, 0x0d, 0x83, 0x91, 0x9d, 0xd5, 0x98, 0x4f, 0xb6, 0x79, 0x04,
+	0x83, 0x2b, 0x2d, 0xb3, 0xcd, 0x92, 0x5e, 0x80, 0x73, 0x9d, 0xc6, 0x9b, 0xd6, 0x0a, 0x7b, 0xf8,
+	0x9f, 0xcb, 0xd1, 0xc7, 0x01, 0x00, 0xed, 0x76, 0xf6, 0xcd, 0xe0, 0x08, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// XuperAdminClient is the client API for XuperAdmin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type XuperAdminClient interface {
+	ListLogLevels(ctx context.Context, in *BaseReq, opts ...grpc.CallOption) (*LogLevelsResp, error)
+	SetLogLevel(ctx context.Context, in *SetLogLevelReq, opts ...grpc.CallOption) (*BaseResp, error)
+	// 导出goroutine、heap等运行时profile
+	DumpProfile(ctx context.Context, in *ProfileReq, opts ...grpc.CallOption) (*ProfileResp, error)
+	ListPeers(ctx context.Context, in *BaseReq, opts ...grpc.CallOption) (*PeersResp, error)
+	// 连接指定地址的节点，返回对端节点信息
+	ConnectPeer(ctx context.Context, in *PeerReq, opts ...grpc.CallOption) (*PeersResp, error)
+	DisconnectPeer(ctx context.Context, in *PeerReq, opts ...grpc.CallOption) (*BaseResp, error)
+	// 重新加载服务配置
+	ReloadConfig(ctx context.Context, in *BaseReq, opts ...grpc.CallOption) (*ReloadConfigResp, error)
+	GetBuildInfo(ctx context.Context, in *BaseReq, opts ...grpc.CallOption) (*BuildInfoResp, error)
+	// 查询数据目录下所有链的状态
+	ListChains(ctx context.Context, in *BaseReq, opts ...grpc.CallOption) (*ChainsResp, error)
+	// 加载数据目录下新创建的链并启动
+	LoadChain(ctx context.Context, in *ChainReq, opts ...grpc.CallOption) (*BaseResp, error)
+	StopChain(ctx context.Context, in *ChainReq, opts ...grpc.CallOption) (*BaseResp, error)
+	// 停止链并从引擎移除，等待使用结束后关闭账本存储
+	UnloadChain(ctx context.Context, in *ChainReq, opts ...grpc.CallOption) (*BaseResp, error)
+}
+
+type xuperAdminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewXuperAdminClient(cc grpc.ClientConnInterface) XuperAdminClient {
+	return &xuperAdminClient{cc}
+}
+
+func (c *xuperAdminClient) ListLogLevels(ctx context.Context, in *BaseReq, opts ...grpc.CallOption) (*LogLevelsResp, error) {
+	out := new(LogLevelsResp)
+	err := c.cc.Invoke(ctx, "/xupospb.XuperAdmin/ListLogLevels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xuperAdminClient) SetLogLevel(ctx context.Context, in *SetLogLevelReq, opts ...grpc.CallOption) (*BaseResp, error) {
+	out := new(BaseResp)
+	err := c.cc.Invoke(ctx, "/xupospb.XuperAdmin/SetLogLevel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xuperAdminClient) DumpProfile(ctx context.Context, in *ProfileReq, opts ...grpc.CallOption) (*ProfileResp, error) {
+	out := new(ProfileResp)
+	err := c.cc.Invoke(ctx, "/xupospb.XuperAdmin/DumpProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xuperAdminClient) ListPeers(ctx context.Context, in *BaseReq, opts ...grpc.CallOption) (*PeersResp, error) {
+	out := new(PeersResp)
+	err := c.cc.Invoke(ctx, "/xupospb.XuperAdmin/ListPeers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xuperAdminClient) ConnectPeer(ctx context.Context, in *PeerReq, opts ...grpc.CallOption) (*PeersResp, error) {
+	out := new(PeersResp)
+	err := c.cc.Invoke(ctx, "/xupospb.XuperAdmin/ConnectPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xuperAdminClient) DisconnectPeer(ctx context.Context, in *PeerReq, opts ...grpc.CallOption) (*BaseResp, error) {
+	out := new(BaseResp)
+	err := c.cc.Invoke(ctx, "/xupospb.XuperAdmin/DisconnectPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xuperAdminClient) ReloadConfig(ctx context.Context, in *BaseReq, opts ...grpc.CallOption) (*ReloadConfigResp, error) {
+	out := new(ReloadConfigResp)
+	err := c.cc.Invoke(ctx, "/xupospb.XuperAdmin/ReloadConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xuperAdminClient) GetBuildInfo(ctx context.Context, in *BaseReq, opts ...grpc.CallOption) (*BuildInfoResp, error) {
+	out := new(BuildInfoResp)
+	err := c.cc.Invoke(ctx, "/xupospb.XuperAdmin/GetBuildInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xuperAdminClient) ListChains(ctx context.Context, in *BaseReq, opts ...grpc.CallOption) (*ChainsResp, error) {
+	out := new(ChainsResp)
+	err := c.cc.Invoke(ctx, "/xupospb.XuperAdmin/ListChains", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xuperAdminClient) LoadChain(ctx context.Context, in *ChainReq, opts ...grpc.CallOption) (*BaseResp, error) {
+	out := new(BaseResp)
+	err := c.cc.Invoke(ctx, "/xupospb.XuperAdmin/LoadChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xuperAdminClient) StopChain(ctx context.Context, in *ChainReq, opts ...grpc.CallOption) (*BaseResp, error) {
+	out := new(BaseResp)
+	err := c.cc.Invoke(ctx, "/xupospb.XuperAdmin/StopChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xuperAdminClient) UnloadChain(ctx context.Context, in *ChainReq, opts ...grpc.CallOption) (*BaseResp, error) {
+	out := new(BaseResp)
+	err := c.cc.Invoke(ctx, "/xupospb.XuperAdmin/UnloadChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// XuperAdminServer is the server API for XuperAdmin service.
+type XuperAdminServer interface {
+	ListLogLevels(context.Context, *BaseReq) (*LogLevelsResp, error)
+	SetLogLevel(context.Context, *SetLogLevelReq) (*BaseResp, error)
+	// 导出goroutine、heap等运行时profile
+	DumpProfile(context.Context, *ProfileReq) (*ProfileResp, error)
+	ListPeers(context.Context, *BaseReq) (*PeersResp, error)
+	// 连接指定地址的节点，返回对端节点信息
+	ConnectPeer(context.Context, *PeerReq) (*PeersResp, error)
+	DisconnectPeer(context.Context, *PeerReq) (*BaseResp, error)
+	// 重新加载服务配置
+	ReloadConfig(context.Context, *BaseReq) (*ReloadConfigResp, error)
+	GetBuildInfo(context.Context, *BaseReq) (*BuildInfoResp, error)
+	// 查询数据目录下所有链的状态
+	ListChains(context.Context, *BaseReq) (*ChainsResp, error)
+	// 加载数据目录下新创建的链并启动
+	LoadChain(context.Context, *ChainReq) (*BaseResp, error)
+	StopChain(context.Context, *ChainReq) (*BaseResp, error)
+	// 停止链并从引擎移除，等待使用结束后关闭账本存储
+	UnloadChain(context.Context, *ChainReq) (*BaseResp, error)
+}
+
+// UnimplementedXuperAdminServer can be embedded to have forward compatible implementations.
+type UnimplementedXuperAdminServer struct {
+}
+
+func (*UnimplementedXuperAdminServer) ListLogLevels(ctx context.Context, req *BaseReq) (*LogLevelsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLogLevels not implemented")
+}
+func (*UnimplementedXuperAdminServer) SetLogLevel(ctx context.Context, req *SetLogLevelReq) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLogLevel not implemented")
+}
+func (*UnimplementedXuperAdminServer) DumpProfile(ctx context.Context, req *ProfileReq) (*ProfileResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DumpProfile not implemented")
+}
+func (*UnimplementedXuperAdminServer) ListPeers(ctx context.Context, req *BaseReq) (*PeersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPeers not implemented")
+}
+func (*UnimplementedXuperAdminServer) ConnectPeer(ctx context.Context, req *PeerReq) (*PeersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectPeer not implemented")
+}
+func (*UnimplementedXuperAdminServer) DisconnectPeer(ctx context.Context, req *PeerReq) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisconnectPeer not implemented")
+}
+func (*UnimplementedXuperAdminServer) ReloadConfig(ctx context.Context, req *BaseReq) (*ReloadConfigResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReloadConfig not implemented")
+}
+func (*UnimplementedXuperAdminServer) GetBuildInfo(ctx context.Context, req *BaseReq) (*BuildInfoResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBuildInfo not implemented")
+}
+func (*UnimplementedXuperAdminServer) ListChains(ctx context.Context, req *BaseReq) (*ChainsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChains not implemented")
+}
+func (*UnimplementedXuperAdminServer) LoadChain(ctx context.Context, req *ChainReq) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoadChain not implemented")
+}
+func (*UnimplementedXuperAdminServer) StopChain(ctx context.Context, req *ChainReq) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopChain not implemented")
+}
+func (*UnimplementedXuperAdminServer) UnloadChain(ctx context.Context, req *ChainReq) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnloadChain not implemented")
+}
+
+func RegisterXuperAdminServer(s *grpc.Server, srv XuperAdminServer) {
+	s.RegisterService(&_XuperAdmin_serviceDesc, srv)
+}
+
+func _XuperAdmin_ListLogLevels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BaseReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperAdminServer).ListLogLevels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xupospb.XuperAdmin/ListLogLevels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperAdminServer).ListLogLevels(ctx, req.(*BaseReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XuperAdmin_SetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLogLevelReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperAdminServer).SetLogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xupospb.XuperAdmin/SetLogLevel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperAdminServer).SetLogLevel(ctx, req.(*SetLogLevelReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XuperAdmin_DumpProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProfileReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperAdminServer).DumpProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xupospb.XuperAdmin/DumpProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperAdminServer).DumpProfile(ctx, req.(*ProfileReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XuperAdmin_ListPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BaseReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperAdminServer).ListPeers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xupospb.XuperAdmin/ListPeers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperAdminServer).ListPeers(ctx, req.(*BaseReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XuperAdmin_ConnectPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeerReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperAdminServer).ConnectPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xupospb.XuperAdmin/ConnectPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperAdminServer).ConnectPeer(ctx, req.(*PeerReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XuperAdmin_DisconnectPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeerReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperAdminServer).DisconnectPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xupospb.XuperAdmin/DisconnectPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperAdminServer).DisconnectPeer(ctx, req.(*PeerReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XuperAdmin_ReloadConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BaseReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperAdminServer).ReloadConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xupospb.XuperAdmin/ReloadConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperAdminServer).ReloadConfig(ctx, req.(*BaseReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XuperAdmin_GetBuildInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BaseReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperAdminServer).GetBuildInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xupospb.XuperAdmin/GetBuildInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperAdminServer).GetBuildInfo(ctx, req.(*BaseReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XuperAdmin_ListChains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BaseReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperAdminServer).ListChains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xupospb.XuperAdmin/ListChains",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperAdminServer).ListChains(ctx, req.(*BaseReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XuperAdmin_LoadChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChainReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperAdminServer).LoadChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xupospb.XuperAdmin/LoadChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperAdminServer).LoadChain(ctx, req.(*ChainReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XuperAdmin_StopChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChainReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperAdminServer).StopChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xupospb.XuperAdmin/StopChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperAdminServer).StopChain(ctx, req.(*ChainReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XuperAdmin_UnloadChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChainReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperAdminServer).UnloadChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xupospb.XuperAdmin/UnloadChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperAdminServer).UnloadChain(ctx, req.(*ChainReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _XuperAdmin_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "xupospb.XuperAdmin",
+	HandlerType: (*XuperAdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListLogLevels",
+			Handler:    _XuperAdmin_ListLogLevels_Handler,
+		},
+		{
+			MethodName: "SetLogLevel",
+			Handler:    _XuperAdmin_SetLogLevel_Handler,
+		},
+		{
+			MethodName: "DumpProfile",
+			Handler:    _XuperAdmin_DumpProfile_Handler,
+		},
+		{
+			MethodName: "ListPeers",
+			Handler:    _XuperAdmin_ListPeers_Handler,
+		},
+		{
+			MethodName: "ConnectPeer",
+			Handler:    _XuperAdmin_ConnectPeer_Handler,
+		},
+		{
+			MethodName: "DisconnectPeer",
+			Handler:    _XuperAdmin_DisconnectPeer_Handler,
+		},
+		{
+			MethodName: "ReloadConfig",
+			Handler:    _XuperAdmin_ReloadConfig_Handler,
+		},
+		{
+			MethodName: "GetBuildInfo",
+			Handler:    _XuperAdmin_GetBuildInfo_Handler,
+		},
+		{
+			MethodName: "ListChains",
+			Handler:    _XuperAdmin_ListChains_Handler,
+		},
+		{
+			MethodName: "LoadChain",
+			Handler:    _XuperAdmin_LoadChain_Handler,
+		},
+		{
+			MethodName: "StopChain",
+			Handler:    _XuperAdmin_StopChain_Handler,
+		},
+		{
+			MethodName: "UnloadChain",
+			Handler:    _XuperAdmin_UnloadChain_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "admin.proto",
+}
@@ -6,3 +6,4 @@
 # go install github.com/grpc-ecosystem/grpc-gateway/protoc-gen-grpc-gateway
 
 protoc -I ./ -I ../../../ --go_opt=paths=source_relative --go_out=plugins=grpc:./ ./xuperos.proto
+protoc -I ./ -I ../../../ --go_opt=paths=source_relative --go_out=plugins=grpc:./ ./admin.proto
@@ -4,9 +4,29 @@
 # export GO111MODULES=on
 # go install github.com/golang/protobuf/protoc-gen-go
 # go install github.com/grpc-ecosystem/grpc-gateway/protoc-gen-grpc-gateway
+# go install github.com/grpc-ecosystem/grpc-gateway/protoc-gen-swagger
 
 protoc -I ./ ./*.proto \
     -I ./googleapis \
     --go_opt=paths=source_relative \
     --go_out=plugins=grpc:./ \
     --grpc-gateway_out=logtostderr=true:./
+
+# openapi v2 spec of gateway routes, served by gateway at /openapi.json
+protoc -I ./ ./xchain.proto ./xendorser.proto \
+    -I ./googleapis \
+    --swagger_out=logtostderr=true,allow_merge=true,merge_file_name=xuperchain:./
+
+# go1.14 has no embed, wrap spec into go source
+{
+    echo "// Code generated by build.sh. DO NOT EDIT."
+    echo "// source: xuperchain.swagger.json"
+    echo ""
+    echo "package pb"
+    echo ""
+    echo "// OpenAPISpec is the openapi v2 spec generated from google.api.http annotations"
+    echo -n 'const OpenAPISpec = `'
+    sed 's/`/` + "`" + `/g' xuperchain.swagger.json
+    echo '`'
+} > xuperchain.swagger.go
+gofmt -w xuperchain.swagger.go
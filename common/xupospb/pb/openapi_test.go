@@ -0,0 +1,35 @@
+package pb
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"regexp"
+	"testing"
+)
+
+// 校验openapi文档与proto中的http注解保持同步
+func TestOpenAPISpec(t *testing.T) {
+	var spec struct {
+		Swagger string                     `json:"swagger"`
+		Paths   map[string]json.RawMessage `json:"paths"`
+	}
+	if err := json.Unmarshal([]byte(OpenAPISpec), &spec); err != nil {
+		t.Fatal(err)
+	}
+	if spec.Swagger != "2.0" {
+		t.Fatalf("unexpected swagger version %s", spec.Swagger)
+	}
+
+	routeRegex := regexp.MustCompile(`(?:get|post|put|delete|patch)\s*:\s*"([^"]+)"`)
+	for _, file := range []string{"xchain.proto", "xendorser.proto"} {
+		buf, err := ioutil.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, match := range routeRegex.FindAllStringSubmatch(string(buf), -1) {
+			if _, ok := spec.Paths[match[1]]; !ok {
+				t.Errorf("route %s of %s missing in openapi spec, run build.sh", match[1], file)
+			}
+		}
+	}
+}
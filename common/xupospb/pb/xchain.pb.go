@@ -296,6 +296,65 @@ func (ResourceType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_db0991b9525664ca, []int{4}
 }
 
+type TxDirection int32
+
+const (
+	TxDirection_DIRECTION_NONE TxDirection = 0
+	TxDirection_DIRECTION_IN   TxDirection = 1
+	TxDirection_DIRECTION_OUT  TxDirection = 2
+)
+
+var TxDirection_name = map[int32]string{
+	0: "DIRECTION_NONE",
+	1: "DIRECTION_IN",
+	2: "DIRECTION_OUT",
+}
+
+var TxDirection_value = map[string]int32{
+	"DIRECTION_NONE": 0,
+	"DIRECTION_IN":   1,
+	"DIRECTION_OUT":  2,
+}
+
+func (x TxDirection) String() string {
+	return proto.EnumName(TxDirection_name, int32(x))
+}
+
+func (TxDirection) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{5}
+}
+
+type WaitTxResult int32
+
+const (
+	WaitTxResult_WAIT_UNDEFINE  WaitTxResult = 0
+	WaitTxResult_WAIT_CONFIRMED WaitTxResult = 1
+	WaitTxResult_WAIT_DROPPED   WaitTxResult = 2
+	WaitTxResult_WAIT_TIMEOUT   WaitTxResult = 3
+)
+
+var WaitTxResult_name = map[int32]string{
+	0: "WAIT_UNDEFINE",
+	1: "WAIT_CONFIRMED",
+	2: "WAIT_DROPPED",
+	3: "WAIT_TIMEOUT",
+}
+
+var WaitTxResult_value = map[string]int32{
+	"WAIT_UNDEFINE":  0,
+	"WAIT_CONFIRMED": 1,
+	"WAIT_DROPPED":   2,
+	"WAIT_TIMEOUT":   3,
+}
+
+func (x WaitTxResult) String() string {
+	return proto.EnumName(WaitTxResult_name, int32(x))
+}
+
+func (WaitTxResult) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{6}
+}
+
 type Block_EBlockStatus int32
 
 const (
@@ -1033,12 +1092,17 @@ func (m *TokenDetail) GetError() XChainErrorEnum {
 }
 
 type AddressStatus struct {
-	Header               *Header        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	Address              string         `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Bcs                  []*TokenDetail `protobuf:"bytes,3,rep,name=bcs,proto3" json:"bcs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	Header  *Header        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Address string         `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Bcs     []*TokenDetail `protobuf:"bytes,3,rep,name=bcs,proto3" json:"bcs,omitempty"`
+	// 查询指定高度的历史余额，blockid优先
+	// height大于0或hasHeight为true时按高度查询，查询创世块需设置hasHeight，都未设置表示当前状态
+	Height               int64    `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	Blockid              []byte   `protobuf:"bytes,5,opt,name=blockid,proto3" json:"blockid,omitempty"`
+	HasHeight            bool     `protobuf:"varint,6,opt,name=hasHeight,proto3" json:"hasHeight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *AddressStatus) Reset()         { *m = AddressStatus{} }
@@ -1087,6 +1151,27 @@ func (m *AddressStatus) GetBcs() []*TokenDetail {
 	return nil
 }
 
+func (m *AddressStatus) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *AddressStatus) GetBlockid() []byte {
+	if m != nil {
+		return m.Blockid
+	}
+	return nil
+}
+
+func (m *AddressStatus) GetHasHeight() bool {
+	if m != nil {
+		return m.HasHeight
+	}
+	return false
+}
+
 type TokenFrozenDetail struct {
 	Balance              string   `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance,omitempty"`
 	IsFrozen             bool     `protobuf:"varint,2,opt,name=isFrozen,proto3" json:"isFrozen,omitempty"`
@@ -4566,13 +4651,18 @@ func (m *Acl) GetAkSets() *AkSets {
 
 // 查询Acl
 type AclStatus struct {
-	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
-	AccountName          string   `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
-	ContractName         string   `protobuf:"bytes,4,opt,name=contractName,proto3" json:"contractName,omitempty"`
-	MethodName           string   `protobuf:"bytes,5,opt,name=methodName,proto3" json:"methodName,omitempty"`
-	Confirmed            bool     `protobuf:"varint,6,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
-	Acl                  *Acl     `protobuf:"bytes,7,opt,name=acl,proto3" json:"acl,omitempty"`
+	Header       *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname       string  `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	AccountName  string  `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	ContractName string  `protobuf:"bytes,4,opt,name=contractName,proto3" json:"contractName,omitempty"`
+	MethodName   string  `protobuf:"bytes,5,opt,name=methodName,proto3" json:"methodName,omitempty"`
+	Confirmed    bool    `protobuf:"varint,6,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
+	Acl          *Acl    `protobuf:"bytes,7,opt,name=acl,proto3" json:"acl,omitempty"`
+	// 查询指定高度的历史ACL，blockid优先
+	// height大于0或hasHeight为true时按高度查询，查询创世块需设置hasHeight，都未设置表示当前状态
+	Height               int64    `protobuf:"varint,8,opt,name=height,proto3" json:"height,omitempty"`
+	Blockid              []byte   `protobuf:"bytes,9,opt,name=blockid,proto3" json:"blockid,omitempty"`
+	HasHeight            bool     `protobuf:"varint,10,opt,name=hasHeight,proto3" json:"hasHeight,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -4652,6 +4742,27 @@ func (m *AclStatus) GetAcl() *Acl {
 	return nil
 }
 
+func (m *AclStatus) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *AclStatus) GetBlockid() []byte {
+	if m != nil {
+		return m.Blockid
+	}
+	return nil
+}
+
+func (m *AclStatus) GetHasHeight() bool {
+	if m != nil {
+		return m.HasHeight
+	}
+	return false
+}
+
 // Identity authentication request
 type IdentityAuth struct {
 	Sign                 []byte   `protobuf:"bytes,1,opt,name=sign,proto3" json:"sign,omitempty"`
@@ -5936,6 +6047,220 @@ func (m *AddressContractsResponse) GetContracts() map[string]*ContractList {
 	return nil
 }
 
+type AddressTx struct {
+	Height               int64       `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Txid                 []byte      `protobuf:"bytes,2,opt,name=txid,proto3" json:"txid,omitempty"`
+	Direction            TxDirection `protobuf:"varint,3,opt,name=direction,proto3,enum=pb.TxDirection" json:"direction,omitempty"`
+	Amount               string      `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Timestamp            int64       `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *AddressTx) Reset()         { *m = AddressTx{} }
+func (m *AddressTx) String() string { return proto.CompactTextString(m) }
+func (*AddressTx) ProtoMessage()    {}
+func (*AddressTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{89}
+}
+
+func (m *AddressTx) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddressTx.Unmarshal(m, b)
+}
+func (m *AddressTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddressTx.Marshal(b, m, deterministic)
+}
+func (m *AddressTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressTx.Merge(m, src)
+}
+func (m *AddressTx) XXX_Size() int {
+	return xxx_messageInfo_AddressTx.Size(m)
+}
+func (m *AddressTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressTx proto.InternalMessageInfo
+
+func (m *AddressTx) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *AddressTx) GetTxid() []byte {
+	if m != nil {
+		return m.Txid
+	}
+	return nil
+}
+
+func (m *AddressTx) GetDirection() TxDirection {
+	if m != nil {
+		return m.Direction
+	}
+	return TxDirection_DIRECTION_NONE
+}
+
+func (m *AddressTx) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *AddressTx) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type AddressTxsRequest struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Address              string   `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Cursor               string   `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit                int32    `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddressTxsRequest) Reset()         { *m = AddressTxsRequest{} }
+func (m *AddressTxsRequest) String() string { return proto.CompactTextString(m) }
+func (*AddressTxsRequest) ProtoMessage()    {}
+func (*AddressTxsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{90}
+}
+
+func (m *AddressTxsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddressTxsRequest.Unmarshal(m, b)
+}
+func (m *AddressTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddressTxsRequest.Marshal(b, m, deterministic)
+}
+func (m *AddressTxsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressTxsRequest.Merge(m, src)
+}
+func (m *AddressTxsRequest) XXX_Size() int {
+	return xxx_messageInfo_AddressTxsRequest.Size(m)
+}
+func (m *AddressTxsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressTxsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressTxsRequest proto.InternalMessageInfo
+
+func (m *AddressTxsRequest) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *AddressTxsRequest) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *AddressTxsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AddressTxsRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+func (m *AddressTxsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// Query address txs response, txs are ordered from newest to oldest
+type AddressTxsResponse struct {
+	Header               *Header      `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string       `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Address              string       `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Txs                  []*AddressTx `protobuf:"bytes,4,rep,name=txs,proto3" json:"txs,omitempty"`
+	NextCursor           string       `protobuf:"bytes,5,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *AddressTxsResponse) Reset()         { *m = AddressTxsResponse{} }
+func (m *AddressTxsResponse) String() string { return proto.CompactTextString(m) }
+func (*AddressTxsResponse) ProtoMessage()    {}
+func (*AddressTxsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{91}
+}
+
+func (m *AddressTxsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddressTxsResponse.Unmarshal(m, b)
+}
+func (m *AddressTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddressTxsResponse.Marshal(b, m, deterministic)
+}
+func (m *AddressTxsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressTxsResponse.Merge(m, src)
+}
+func (m *AddressTxsResponse) XXX_Size() int {
+	return xxx_messageInfo_AddressTxsResponse.Size(m)
+}
+func (m *AddressTxsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressTxsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressTxsResponse proto.InternalMessageInfo
+
+func (m *AddressTxsResponse) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *AddressTxsResponse) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *AddressTxsResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AddressTxsResponse) GetTxs() []*AddressTx {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+func (m *AddressTxsResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
 type CrossQueryRequest struct {
 	Bcname               string         `protobuf:"bytes,1,opt,name=bcname,proto3" json:"bcname,omitempty"`
 	Timestamp            int64          `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
@@ -5951,7 +6276,7 @@ func (m *CrossQueryRequest) Reset()         { *m = CrossQueryRequest{} }
 func (m *CrossQueryRequest) String() string { return proto.CompactTextString(m) }
 func (*CrossQueryRequest) ProtoMessage()    {}
 func (*CrossQueryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{89}
+	return fileDescriptor_db0991b9525664ca, []int{92}
 }
 
 func (m *CrossQueryRequest) XXX_Unmarshal(b []byte) error {
@@ -6018,7 +6343,7 @@ func (m *CrossQueryResponse) Reset()         { *m = CrossQueryResponse{} }
 func (m *CrossQueryResponse) String() string { return proto.CompactTextString(m) }
 func (*CrossQueryResponse) ProtoMessage()    {}
 func (*CrossQueryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{90}
+	return fileDescriptor_db0991b9525664ca, []int{93}
 }
 
 func (m *CrossQueryResponse) XXX_Unmarshal(b []byte) error {
@@ -6058,7 +6383,7 @@ func (m *CrossChainMeta) Reset()         { *m = CrossChainMeta{} }
 func (m *CrossChainMeta) String() string { return proto.CompactTextString(m) }
 func (*CrossChainMeta) ProtoMessage()    {}
 func (*CrossChainMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{91}
+	return fileDescriptor_db0991b9525664ca, []int{94}
 }
 
 func (m *CrossChainMeta) XXX_Unmarshal(b []byte) error {
@@ -6107,7 +6432,7 @@ func (m *CrossEndorsor) Reset()         { *m = CrossEndorsor{} }
 func (m *CrossEndorsor) String() string { return proto.CompactTextString(m) }
 func (*CrossEndorsor) ProtoMessage()    {}
 func (*CrossEndorsor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{92}
+	return fileDescriptor_db0991b9525664ca, []int{95}
 }
 
 func (m *CrossEndorsor) XXX_Unmarshal(b []byte) error {
@@ -6161,7 +6486,7 @@ func (m *CrossQueryMeta) Reset()         { *m = CrossQueryMeta{} }
 func (m *CrossQueryMeta) String() string { return proto.CompactTextString(m) }
 func (*CrossQueryMeta) ProtoMessage()    {}
 func (*CrossQueryMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{93}
+	return fileDescriptor_db0991b9525664ca, []int{96}
 }
 
 func (m *CrossQueryMeta) XXX_Unmarshal(b []byte) error {
@@ -6209,7 +6534,7 @@ func (m *CrossQueryInfo) Reset()         { *m = CrossQueryInfo{} }
 func (m *CrossQueryInfo) String() string { return proto.CompactTextString(m) }
 func (*CrossQueryInfo) ProtoMessage()    {}
 func (*CrossQueryInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{94}
+	return fileDescriptor_db0991b9525664ca, []int{97}
 }
 
 func (m *CrossQueryInfo) XXX_Unmarshal(b []byte) error {
@@ -6264,7 +6589,7 @@ func (m *ContractEvent) Reset()         { *m = ContractEvent{} }
 func (m *ContractEvent) String() string { return proto.CompactTextString(m) }
 func (*ContractEvent) ProtoMessage()    {}
 func (*ContractEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{95}
+	return fileDescriptor_db0991b9525664ca, []int{98}
 }
 
 func (m *ContractEvent) XXX_Unmarshal(b []byte) error {
@@ -6306,48 +6631,1453 @@ func (m *ContractEvent) GetBody() []byte {
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("pb.XChainErrorEnum", XChainErrorEnum_name, XChainErrorEnum_value)
-	proto.RegisterEnum("pb.TransactionStatus", TransactionStatus_name, TransactionStatus_value)
-	proto.RegisterEnum("pb.ViewOption", ViewOption_name, ViewOption_value)
-	proto.RegisterEnum("pb.PermissionRule", PermissionRule_name, PermissionRule_value)
-	proto.RegisterEnum("pb.ResourceType", ResourceType_name, ResourceType_value)
-	proto.RegisterEnum("pb.Block_EBlockStatus", Block_EBlockStatus_name, Block_EBlockStatus_value)
-	proto.RegisterType((*Header)(nil), "pb.Header")
-	proto.RegisterType((*TxDataAccount)(nil), "pb.TxDataAccount")
-	proto.RegisterType((*TxData)(nil), "pb.TxData")
-	proto.RegisterType((*TxStatus)(nil), "pb.TxStatus")
-	proto.RegisterType((*BatchTxs)(nil), "pb.BatchTxs")
-	proto.RegisterType((*Block)(nil), "pb.Block")
-	proto.RegisterType((*BlockID)(nil), "pb.BlockID")
-	proto.RegisterType((*BlockHeight)(nil), "pb.BlockHeight")
-	proto.RegisterType((*CommonReply)(nil), "pb.CommonReply")
-	proto.RegisterType((*CommonIn)(nil), "pb.CommonIn")
-	proto.RegisterType((*TokenDetail)(nil), "pb.TokenDetail")
-	proto.RegisterType((*AddressStatus)(nil), "pb.AddressStatus")
-	proto.RegisterType((*TokenFrozenDetail)(nil), "pb.TokenFrozenDetail")
-	proto.RegisterType((*TokenFrozenDetails)(nil), "pb.TokenFrozenDetails")
-	proto.RegisterType((*AddressBalanceStatus)(nil), "pb.AddressBalanceStatus")
-	proto.RegisterType((*TxInput)(nil), "pb.TxInput")
-	proto.RegisterType((*TxOutput)(nil), "pb.TxOutput")
-	proto.RegisterType((*XuperSignature)(nil), "pb.XuperSignature")
-	proto.RegisterType((*Transaction)(nil), "pb.Transaction")
-	proto.RegisterType((*LedgerMeta)(nil), "pb.LedgerMeta")
-	proto.RegisterType((*UtxoMeta)(nil), "pb.UtxoMeta")
-	proto.RegisterType((*GasPrice)(nil), "pb.GasPrice")
-	proto.RegisterType((*InternalBlock)(nil), "pb.InternalBlock")
-	proto.RegisterMapType((map[string]string)(nil), "pb.InternalBlock.FailedTxsEntry")
-	proto.RegisterType((*BCStatus)(nil), "pb.BCStatus")
-	proto.RegisterType((*BCTipStatus)(nil), "pb.BCTipStatus")
-	proto.RegisterType((*BlockChains)(nil), "pb.BlockChains")
-	proto.RegisterType((*Speeds)(nil), "pb.Speeds")
-	proto.RegisterMapType((map[string]*BCSpeeds)(nil), "pb.Speeds.BcSpeedsEntry")
-	proto.RegisterMapType((map[string]float64)(nil), "pb.Speeds.SumSpeedsEntry")
-	proto.RegisterType((*BCSpeeds)(nil), "pb.BCSpeeds")
-	proto.RegisterMapType((map[string]float64)(nil), "pb.BCSpeeds.BcSpeedEntry")
-	proto.RegisterType((*SystemsStatus)(nil), "pb.SystemsStatus")
-	proto.RegisterType((*SystemsStatusReply)(nil), "pb.SystemsStatusReply")
-	proto.RegisterType((*RawUrl)(nil), "pb.RawUrl")
+type BlockRangeRequest struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	From                 int64    `protobuf:"varint,3,opt,name=from,proto3" json:"from,omitempty"`
+	To                   int64    `protobuf:"varint,4,opt,name=to,proto3" json:"to,omitempty"`
+	NeedContent          bool     `protobuf:"varint,5,opt,name=need_content,json=needContent,proto3" json:"need_content,omitempty"`
+	ExcludeTx            bool     `protobuf:"varint,6,opt,name=exclude_tx,json=excludeTx,proto3" json:"exclude_tx,omitempty"`
+	Limit                int32    `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockRangeRequest) Reset()         { *m = BlockRangeRequest{} }
+func (m *BlockRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*BlockRangeRequest) ProtoMessage()    {}
+func (*BlockRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{99}
+}
+
+func (m *BlockRangeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockRangeRequest.Unmarshal(m, b)
+}
+func (m *BlockRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockRangeRequest.Marshal(b, m, deterministic)
+}
+func (m *BlockRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockRangeRequest.Merge(m, src)
+}
+func (m *BlockRangeRequest) XXX_Size() int {
+	return xxx_messageInfo_BlockRangeRequest.Size(m)
+}
+func (m *BlockRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockRangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockRangeRequest proto.InternalMessageInfo
+
+func (m *BlockRangeRequest) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *BlockRangeRequest) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *BlockRangeRequest) GetFrom() int64 {
+	if m != nil {
+		return m.From
+	}
+	return 0
+}
+
+func (m *BlockRangeRequest) GetTo() int64 {
+	if m != nil {
+		return m.To
+	}
+	return 0
+}
+
+func (m *BlockRangeRequest) GetNeedContent() bool {
+	if m != nil {
+		return m.NeedContent
+	}
+	return false
+}
+
+func (m *BlockRangeRequest) GetExcludeTx() bool {
+	if m != nil {
+		return m.ExcludeTx
+	}
+	return false
+}
+
+func (m *BlockRangeRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// Get block range response, blocks are ordered by height
+type BlockRangeResponse struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Blocks               []*Block `protobuf:"bytes,3,rep,name=blocks,proto3" json:"blocks,omitempty"`
+	NextHeight           int64    `protobuf:"varint,4,opt,name=next_height,json=nextHeight,proto3" json:"next_height,omitempty"`
+	HasMore              bool     `protobuf:"varint,5,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockRangeResponse) Reset()         { *m = BlockRangeResponse{} }
+func (m *BlockRangeResponse) String() string { return proto.CompactTextString(m) }
+func (*BlockRangeResponse) ProtoMessage()    {}
+func (*BlockRangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{100}
+}
+
+func (m *BlockRangeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockRangeResponse.Unmarshal(m, b)
+}
+func (m *BlockRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockRangeResponse.Marshal(b, m, deterministic)
+}
+func (m *BlockRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockRangeResponse.Merge(m, src)
+}
+func (m *BlockRangeResponse) XXX_Size() int {
+	return xxx_messageInfo_BlockRangeResponse.Size(m)
+}
+func (m *BlockRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockRangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockRangeResponse proto.InternalMessageInfo
+
+func (m *BlockRangeResponse) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *BlockRangeResponse) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *BlockRangeResponse) GetBlocks() []*Block {
+	if m != nil {
+		return m.Blocks
+	}
+	return nil
+}
+
+func (m *BlockRangeResponse) GetNextHeight() int64 {
+	if m != nil {
+		return m.NextHeight
+	}
+	return 0
+}
+
+func (m *BlockRangeResponse) GetHasMore() bool {
+	if m != nil {
+		return m.HasMore
+	}
+	return false
+}
+
+type WaitTxRequest struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Txid                 []byte   `protobuf:"bytes,3,opt,name=txid,proto3" json:"txid,omitempty"`
+	Confirmations        int64    `protobuf:"varint,4,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	TimeoutSeconds       int64    `protobuf:"varint,5,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WaitTxRequest) Reset()         { *m = WaitTxRequest{} }
+func (m *WaitTxRequest) String() string { return proto.CompactTextString(m) }
+func (*WaitTxRequest) ProtoMessage()    {}
+func (*WaitTxRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{101}
+}
+
+func (m *WaitTxRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WaitTxRequest.Unmarshal(m, b)
+}
+func (m *WaitTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WaitTxRequest.Marshal(b, m, deterministic)
+}
+func (m *WaitTxRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WaitTxRequest.Merge(m, src)
+}
+func (m *WaitTxRequest) XXX_Size() int {
+	return xxx_messageInfo_WaitTxRequest.Size(m)
+}
+func (m *WaitTxRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WaitTxRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WaitTxRequest proto.InternalMessageInfo
+
+func (m *WaitTxRequest) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *WaitTxRequest) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *WaitTxRequest) GetTxid() []byte {
+	if m != nil {
+		return m.Txid
+	}
+	return nil
+}
+
+func (m *WaitTxRequest) GetConfirmations() int64 {
+	if m != nil {
+		return m.Confirmations
+	}
+	return 0
+}
+
+func (m *WaitTxRequest) GetTimeoutSeconds() int64 {
+	if m != nil {
+		return m.TimeoutSeconds
+	}
+	return 0
+}
+
+type WaitTxResponse struct {
+	Header               *Header           `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string            `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Txid                 []byte            `protobuf:"bytes,3,opt,name=txid,proto3" json:"txid,omitempty"`
+	Result               WaitTxResult      `protobuf:"varint,4,opt,name=result,proto3,enum=pb.WaitTxResult" json:"result,omitempty"`
+	Status               TransactionStatus `protobuf:"varint,5,opt,name=status,proto3,enum=pb.TransactionStatus" json:"status,omitempty"`
+	Confirmations        int64             `protobuf:"varint,6,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	Blockid              []byte            `protobuf:"bytes,7,opt,name=blockid,proto3" json:"blockid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *WaitTxResponse) Reset()         { *m = WaitTxResponse{} }
+func (m *WaitTxResponse) String() string { return proto.CompactTextString(m) }
+func (*WaitTxResponse) ProtoMessage()    {}
+func (*WaitTxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{102}
+}
+
+func (m *WaitTxResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WaitTxResponse.Unmarshal(m, b)
+}
+func (m *WaitTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WaitTxResponse.Marshal(b, m, deterministic)
+}
+func (m *WaitTxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WaitTxResponse.Merge(m, src)
+}
+func (m *WaitTxResponse) XXX_Size() int {
+	return xxx_messageInfo_WaitTxResponse.Size(m)
+}
+func (m *WaitTxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WaitTxResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WaitTxResponse proto.InternalMessageInfo
+
+func (m *WaitTxResponse) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *WaitTxResponse) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *WaitTxResponse) GetTxid() []byte {
+	if m != nil {
+		return m.Txid
+	}
+	return nil
+}
+
+func (m *WaitTxResponse) GetResult() WaitTxResult {
+	if m != nil {
+		return m.Result
+	}
+	return WaitTxResult_WAIT_UNDEFINE
+}
+
+func (m *WaitTxResponse) GetStatus() TransactionStatus {
+	if m != nil {
+		return m.Status
+	}
+	return TransactionStatus_UNDEFINE
+}
+
+func (m *WaitTxResponse) GetConfirmations() int64 {
+	if m != nil {
+		return m.Confirmations
+	}
+	return 0
+}
+
+func (m *WaitTxResponse) GetBlockid() []byte {
+	if m != nil {
+		return m.Blockid
+	}
+	return nil
+}
+
+// Unconfirmed txs are ordered by timestamp, then txid
+type UnconfirmedTxsRequest struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Initiator            string   `protobuf:"bytes,3,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	Cursor               string   `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit                int32    `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnconfirmedTxsRequest) Reset()         { *m = UnconfirmedTxsRequest{} }
+func (m *UnconfirmedTxsRequest) String() string { return proto.CompactTextString(m) }
+func (*UnconfirmedTxsRequest) ProtoMessage()    {}
+func (*UnconfirmedTxsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{103}
+}
+
+func (m *UnconfirmedTxsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnconfirmedTxsRequest.Unmarshal(m, b)
+}
+func (m *UnconfirmedTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnconfirmedTxsRequest.Marshal(b, m, deterministic)
+}
+func (m *UnconfirmedTxsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnconfirmedTxsRequest.Merge(m, src)
+}
+func (m *UnconfirmedTxsRequest) XXX_Size() int {
+	return xxx_messageInfo_UnconfirmedTxsRequest.Size(m)
+}
+func (m *UnconfirmedTxsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnconfirmedTxsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnconfirmedTxsRequest proto.InternalMessageInfo
+
+func (m *UnconfirmedTxsRequest) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *UnconfirmedTxsRequest) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *UnconfirmedTxsRequest) GetInitiator() string {
+	if m != nil {
+		return m.Initiator
+	}
+	return ""
+}
+
+func (m *UnconfirmedTxsRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+func (m *UnconfirmedTxsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type UnconfirmedTxidsResponse struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Txids                [][]byte `protobuf:"bytes,3,rep,name=txids,proto3" json:"txids,omitempty"`
+	NextCursor           string   `protobuf:"bytes,4,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	Total                int64    `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnconfirmedTxidsResponse) Reset()         { *m = UnconfirmedTxidsResponse{} }
+func (m *UnconfirmedTxidsResponse) String() string { return proto.CompactTextString(m) }
+func (*UnconfirmedTxidsResponse) ProtoMessage()    {}
+func (*UnconfirmedTxidsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{104}
+}
+
+func (m *UnconfirmedTxidsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnconfirmedTxidsResponse.Unmarshal(m, b)
+}
+func (m *UnconfirmedTxidsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnconfirmedTxidsResponse.Marshal(b, m, deterministic)
+}
+func (m *UnconfirmedTxidsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnconfirmedTxidsResponse.Merge(m, src)
+}
+func (m *UnconfirmedTxidsResponse) XXX_Size() int {
+	return xxx_messageInfo_UnconfirmedTxidsResponse.Size(m)
+}
+func (m *UnconfirmedTxidsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnconfirmedTxidsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnconfirmedTxidsResponse proto.InternalMessageInfo
+
+func (m *UnconfirmedTxidsResponse) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *UnconfirmedTxidsResponse) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *UnconfirmedTxidsResponse) GetTxids() [][]byte {
+	if m != nil {
+		return m.Txids
+	}
+	return nil
+}
+
+func (m *UnconfirmedTxidsResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
+func (m *UnconfirmedTxidsResponse) GetTotal() int64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+type UnconfirmedTxsResponse struct {
+	Header               *Header        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string         `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Txs                  []*Transaction `protobuf:"bytes,3,rep,name=txs,proto3" json:"txs,omitempty"`
+	NextCursor           string         `protobuf:"bytes,4,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *UnconfirmedTxsResponse) Reset()         { *m = UnconfirmedTxsResponse{} }
+func (m *UnconfirmedTxsResponse) String() string { return proto.CompactTextString(m) }
+func (*UnconfirmedTxsResponse) ProtoMessage()    {}
+func (*UnconfirmedTxsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{105}
+}
+
+func (m *UnconfirmedTxsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnconfirmedTxsResponse.Unmarshal(m, b)
+}
+func (m *UnconfirmedTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnconfirmedTxsResponse.Marshal(b, m, deterministic)
+}
+func (m *UnconfirmedTxsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnconfirmedTxsResponse.Merge(m, src)
+}
+func (m *UnconfirmedTxsResponse) XXX_Size() int {
+	return xxx_messageInfo_UnconfirmedTxsResponse.Size(m)
+}
+func (m *UnconfirmedTxsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnconfirmedTxsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnconfirmedTxsResponse proto.InternalMessageInfo
+
+func (m *UnconfirmedTxsResponse) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *UnconfirmedTxsResponse) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *UnconfirmedTxsResponse) GetTxs() []*Transaction {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+func (m *UnconfirmedTxsResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
+type TxPoolStatusRequest struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TxPoolStatusRequest) Reset()         { *m = TxPoolStatusRequest{} }
+func (m *TxPoolStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*TxPoolStatusRequest) ProtoMessage()    {}
+func (*TxPoolStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{106}
+}
+
+func (m *TxPoolStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxPoolStatusRequest.Unmarshal(m, b)
+}
+func (m *TxPoolStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxPoolStatusRequest.Marshal(b, m, deterministic)
+}
+func (m *TxPoolStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxPoolStatusRequest.Merge(m, src)
+}
+func (m *TxPoolStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_TxPoolStatusRequest.Size(m)
+}
+func (m *TxPoolStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxPoolStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxPoolStatusRequest proto.InternalMessageInfo
+
+func (m *TxPoolStatusRequest) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *TxPoolStatusRequest) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+type TxPoolInitiatorStat struct {
+	Initiator            string   `protobuf:"bytes,1,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	TxCount              int64    `protobuf:"varint,2,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TxPoolInitiatorStat) Reset()         { *m = TxPoolInitiatorStat{} }
+func (m *TxPoolInitiatorStat) String() string { return proto.CompactTextString(m) }
+func (*TxPoolInitiatorStat) ProtoMessage()    {}
+func (*TxPoolInitiatorStat) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{107}
+}
+
+func (m *TxPoolInitiatorStat) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxPoolInitiatorStat.Unmarshal(m, b)
+}
+func (m *TxPoolInitiatorStat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxPoolInitiatorStat.Marshal(b, m, deterministic)
+}
+func (m *TxPoolInitiatorStat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxPoolInitiatorStat.Merge(m, src)
+}
+func (m *TxPoolInitiatorStat) XXX_Size() int {
+	return xxx_messageInfo_TxPoolInitiatorStat.Size(m)
+}
+func (m *TxPoolInitiatorStat) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxPoolInitiatorStat.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxPoolInitiatorStat proto.InternalMessageInfo
+
+func (m *TxPoolInitiatorStat) GetInitiator() string {
+	if m != nil {
+		return m.Initiator
+	}
+	return ""
+}
+
+func (m *TxPoolInitiatorStat) GetTxCount() int64 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+// utxo spent by more than one unconfirmed tx
+type TxPoolConflict struct {
+	RefTxid              []byte   `protobuf:"bytes,1,opt,name=ref_txid,json=refTxid,proto3" json:"ref_txid,omitempty"`
+	RefOffset            int32    `protobuf:"varint,2,opt,name=ref_offset,json=refOffset,proto3" json:"ref_offset,omitempty"`
+	Txids                [][]byte `protobuf:"bytes,3,rep,name=txids,proto3" json:"txids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TxPoolConflict) Reset()         { *m = TxPoolConflict{} }
+func (m *TxPoolConflict) String() string { return proto.CompactTextString(m) }
+func (*TxPoolConflict) ProtoMessage()    {}
+func (*TxPoolConflict) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{108}
+}
+
+func (m *TxPoolConflict) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxPoolConflict.Unmarshal(m, b)
+}
+func (m *TxPoolConflict) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxPoolConflict.Marshal(b, m, deterministic)
+}
+func (m *TxPoolConflict) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxPoolConflict.Merge(m, src)
+}
+func (m *TxPoolConflict) XXX_Size() int {
+	return xxx_messageInfo_TxPoolConflict.Size(m)
+}
+func (m *TxPoolConflict) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxPoolConflict.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxPoolConflict proto.InternalMessageInfo
+
+func (m *TxPoolConflict) GetRefTxid() []byte {
+	if m != nil {
+		return m.RefTxid
+	}
+	return nil
+}
+
+func (m *TxPoolConflict) GetRefOffset() int32 {
+	if m != nil {
+		return m.RefOffset
+	}
+	return 0
+}
+
+func (m *TxPoolConflict) GetTxids() [][]byte {
+	if m != nil {
+		return m.Txids
+	}
+	return nil
+}
+
+type TxPoolStatusResponse struct {
+	Header               *Header                `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string                 `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	TxCount              int64                  `protobuf:"varint,3,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	OldestTimestamp      int64                  `protobuf:"varint,4,opt,name=oldest_timestamp,json=oldestTimestamp,proto3" json:"oldest_timestamp,omitempty"`
+	NewestTimestamp      int64                  `protobuf:"varint,5,opt,name=newest_timestamp,json=newestTimestamp,proto3" json:"newest_timestamp,omitempty"`
+	MaxAgeSeconds        int64                  `protobuf:"varint,6,opt,name=max_age_seconds,json=maxAgeSeconds,proto3" json:"max_age_seconds,omitempty"`
+	TopInitiators        []*TxPoolInitiatorStat `protobuf:"bytes,7,rep,name=top_initiators,json=topInitiators,proto3" json:"top_initiators,omitempty"`
+	Conflicts            []*TxPoolConflict      `protobuf:"bytes,8,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *TxPoolStatusResponse) Reset()         { *m = TxPoolStatusResponse{} }
+func (m *TxPoolStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*TxPoolStatusResponse) ProtoMessage()    {}
+func (*TxPoolStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{109}
+}
+
+func (m *TxPoolStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxPoolStatusResponse.Unmarshal(m, b)
+}
+func (m *TxPoolStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxPoolStatusResponse.Marshal(b, m, deterministic)
+}
+func (m *TxPoolStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxPoolStatusResponse.Merge(m, src)
+}
+func (m *TxPoolStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_TxPoolStatusResponse.Size(m)
+}
+func (m *TxPoolStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxPoolStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxPoolStatusResponse proto.InternalMessageInfo
+
+func (m *TxPoolStatusResponse) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *TxPoolStatusResponse) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *TxPoolStatusResponse) GetTxCount() int64 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+func (m *TxPoolStatusResponse) GetOldestTimestamp() int64 {
+	if m != nil {
+		return m.OldestTimestamp
+	}
+	return 0
+}
+
+func (m *TxPoolStatusResponse) GetNewestTimestamp() int64 {
+	if m != nil {
+		return m.NewestTimestamp
+	}
+	return 0
+}
+
+func (m *TxPoolStatusResponse) GetMaxAgeSeconds() int64 {
+	if m != nil {
+		return m.MaxAgeSeconds
+	}
+	return 0
+}
+
+func (m *TxPoolStatusResponse) GetTopInitiators() []*TxPoolInitiatorStat {
+	if m != nil {
+		return m.TopInitiators
+	}
+	return nil
+}
+
+func (m *TxPoolStatusResponse) GetConflicts() []*TxPoolConflict {
+	if m != nil {
+		return m.Conflicts
+	}
+	return nil
+}
+
+type ContractEventInfo struct {
+	Contract             string   `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Body                 []byte   `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	Txid                 []byte   `protobuf:"bytes,4,opt,name=txid,proto3" json:"txid,omitempty"`
+	Height               int64    `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	Initiator            string   `protobuf:"bytes,6,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	Timestamp            int64    `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ContractEventInfo) Reset()         { *m = ContractEventInfo{} }
+func (m *ContractEventInfo) String() string { return proto.CompactTextString(m) }
+func (*ContractEventInfo) ProtoMessage()    {}
+func (*ContractEventInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{110}
+}
+
+func (m *ContractEventInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContractEventInfo.Unmarshal(m, b)
+}
+func (m *ContractEventInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContractEventInfo.Marshal(b, m, deterministic)
+}
+func (m *ContractEventInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractEventInfo.Merge(m, src)
+}
+func (m *ContractEventInfo) XXX_Size() int {
+	return xxx_messageInfo_ContractEventInfo.Size(m)
+}
+func (m *ContractEventInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractEventInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractEventInfo proto.InternalMessageInfo
+
+func (m *ContractEventInfo) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *ContractEventInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ContractEventInfo) GetBody() []byte {
+	if m != nil {
+		return m.Body
+	}
+	return nil
+}
+
+func (m *ContractEventInfo) GetTxid() []byte {
+	if m != nil {
+		return m.Txid
+	}
+	return nil
+}
+
+func (m *ContractEventInfo) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ContractEventInfo) GetInitiator() string {
+	if m != nil {
+		return m.Initiator
+	}
+	return ""
+}
+
+func (m *ContractEventInfo) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type ContractEventsRequest struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Contract             string   `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty"`
+	EventName            string   `protobuf:"bytes,4,opt,name=event_name,json=eventName,proto3" json:"event_name,omitempty"`
+	Initiator            string   `protobuf:"bytes,5,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	StartHeight          int64    `protobuf:"varint,6,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	EndHeight            int64    `protobuf:"varint,7,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	Cursor               string   `protobuf:"bytes,8,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit                int32    `protobuf:"varint,9,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ContractEventsRequest) Reset()         { *m = ContractEventsRequest{} }
+func (m *ContractEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*ContractEventsRequest) ProtoMessage()    {}
+func (*ContractEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{111}
+}
+
+func (m *ContractEventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContractEventsRequest.Unmarshal(m, b)
+}
+func (m *ContractEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContractEventsRequest.Marshal(b, m, deterministic)
+}
+func (m *ContractEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractEventsRequest.Merge(m, src)
+}
+func (m *ContractEventsRequest) XXX_Size() int {
+	return xxx_messageInfo_ContractEventsRequest.Size(m)
+}
+func (m *ContractEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractEventsRequest proto.InternalMessageInfo
+
+func (m *ContractEventsRequest) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *ContractEventsRequest) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *ContractEventsRequest) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *ContractEventsRequest) GetEventName() string {
+	if m != nil {
+		return m.EventName
+	}
+	return ""
+}
+
+func (m *ContractEventsRequest) GetInitiator() string {
+	if m != nil {
+		return m.Initiator
+	}
+	return ""
+}
+
+func (m *ContractEventsRequest) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *ContractEventsRequest) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *ContractEventsRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+func (m *ContractEventsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// Query contract events response, events are ordered from oldest to newest
+type ContractEventsResponse struct {
+	Header               *Header              `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string               `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Events               []*ContractEventInfo `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+	NextCursor           string               `protobuf:"bytes,4,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ContractEventsResponse) Reset()         { *m = ContractEventsResponse{} }
+func (m *ContractEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*ContractEventsResponse) ProtoMessage()    {}
+func (*ContractEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{112}
+}
+
+func (m *ContractEventsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContractEventsResponse.Unmarshal(m, b)
+}
+func (m *ContractEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContractEventsResponse.Marshal(b, m, deterministic)
+}
+func (m *ContractEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractEventsResponse.Merge(m, src)
+}
+func (m *ContractEventsResponse) XXX_Size() int {
+	return xxx_messageInfo_ContractEventsResponse.Size(m)
+}
+func (m *ContractEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractEventsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractEventsResponse proto.InternalMessageInfo
+
+func (m *ContractEventsResponse) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *ContractEventsResponse) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *ContractEventsResponse) GetEvents() []*ContractEventInfo {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *ContractEventsResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
+// tx和requests二选一，指定tx时使用草稿交易中的合约请求、发起者和转账输出
+type EstimateFeeRequest struct {
+	Header               *Header          `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string           `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Tx                   *Transaction     `protobuf:"bytes,3,opt,name=tx,proto3" json:"tx,omitempty"`
+	Requests             []*InvokeRequest `protobuf:"bytes,4,rep,name=requests,proto3" json:"requests,omitempty"`
+	Initiator            string           `protobuf:"bytes,5,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	AuthRequire          []string         `protobuf:"bytes,6,rep,name=auth_require,json=authRequire,proto3" json:"auth_require,omitempty"`
+	Amount               string           `protobuf:"bytes,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *EstimateFeeRequest) Reset()         { *m = EstimateFeeRequest{} }
+func (m *EstimateFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*EstimateFeeRequest) ProtoMessage()    {}
+func (*EstimateFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{113}
+}
+
+func (m *EstimateFeeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EstimateFeeRequest.Unmarshal(m, b)
+}
+func (m *EstimateFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EstimateFeeRequest.Marshal(b, m, deterministic)
+}
+func (m *EstimateFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateFeeRequest.Merge(m, src)
+}
+func (m *EstimateFeeRequest) XXX_Size() int {
+	return xxx_messageInfo_EstimateFeeRequest.Size(m)
+}
+func (m *EstimateFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateFeeRequest proto.InternalMessageInfo
+
+func (m *EstimateFeeRequest) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *EstimateFeeRequest) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *EstimateFeeRequest) GetTx() *Transaction {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+func (m *EstimateFeeRequest) GetRequests() []*InvokeRequest {
+	if m != nil {
+		return m.Requests
+	}
+	return nil
+}
+
+func (m *EstimateFeeRequest) GetInitiator() string {
+	if m != nil {
+		return m.Initiator
+	}
+	return ""
+}
+
+func (m *EstimateFeeRequest) GetAuthRequire() []string {
+	if m != nil {
+		return m.AuthRequire
+	}
+	return nil
+}
+
+func (m *EstimateFeeRequest) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+type EstimateFeeResponse struct {
+	Header               *Header          `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string           `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	GasUsed              int64            `protobuf:"varint,3,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	Resources            []*ResourceLimit `protobuf:"bytes,4,rep,name=resources,proto3" json:"resources,omitempty"`
+	GasPrice             *GasPrice        `protobuf:"bytes,5,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+	Fee                  int64            `protobuf:"varint,6,opt,name=fee,proto3" json:"fee,omitempty"`
+	TotalNeed            string           `protobuf:"bytes,7,opt,name=total_need,json=totalNeed,proto3" json:"total_need,omitempty"`
+	UtxoCount            int64            `protobuf:"varint,8,opt,name=utxo_count,json=utxoCount,proto3" json:"utxo_count,omitempty"`
+	UtxoEnough           bool             `protobuf:"varint,9,opt,name=utxo_enough,json=utxoEnough,proto3" json:"utxo_enough,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *EstimateFeeResponse) Reset()         { *m = EstimateFeeResponse{} }
+func (m *EstimateFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*EstimateFeeResponse) ProtoMessage()    {}
+func (*EstimateFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{114}
+}
+
+func (m *EstimateFeeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EstimateFeeResponse.Unmarshal(m, b)
+}
+func (m *EstimateFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EstimateFeeResponse.Marshal(b, m, deterministic)
+}
+func (m *EstimateFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateFeeResponse.Merge(m, src)
+}
+func (m *EstimateFeeResponse) XXX_Size() int {
+	return xxx_messageInfo_EstimateFeeResponse.Size(m)
+}
+func (m *EstimateFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateFeeResponse proto.InternalMessageInfo
+
+func (m *EstimateFeeResponse) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *EstimateFeeResponse) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *EstimateFeeResponse) GetGasUsed() int64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *EstimateFeeResponse) GetResources() []*ResourceLimit {
+	if m != nil {
+		return m.Resources
+	}
+	return nil
+}
+
+func (m *EstimateFeeResponse) GetGasPrice() *GasPrice {
+	if m != nil {
+		return m.GasPrice
+	}
+	return nil
+}
+
+func (m *EstimateFeeResponse) GetFee() int64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+func (m *EstimateFeeResponse) GetTotalNeed() string {
+	if m != nil {
+		return m.TotalNeed
+	}
+	return ""
+}
+
+func (m *EstimateFeeResponse) GetUtxoCount() int64 {
+	if m != nil {
+		return m.UtxoCount
+	}
+	return 0
+}
+
+func (m *EstimateFeeResponse) GetUtxoEnough() bool {
+	if m != nil {
+		return m.UtxoEnough
+	}
+	return false
+}
+
+type TokenStatsRequest struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	TopN                 int32    `protobuf:"varint,3,opt,name=top_n,json=topN,proto3" json:"top_n,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenStatsRequest) Reset()         { *m = TokenStatsRequest{} }
+func (m *TokenStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*TokenStatsRequest) ProtoMessage()    {}
+func (*TokenStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{115}
+}
+
+func (m *TokenStatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenStatsRequest.Unmarshal(m, b)
+}
+func (m *TokenStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenStatsRequest.Marshal(b, m, deterministic)
+}
+func (m *TokenStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenStatsRequest.Merge(m, src)
+}
+func (m *TokenStatsRequest) XXX_Size() int {
+	return xxx_messageInfo_TokenStatsRequest.Size(m)
+}
+func (m *TokenStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenStatsRequest proto.InternalMessageInfo
+
+func (m *TokenStatsRequest) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *TokenStatsRequest) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *TokenStatsRequest) GetTopN() int32 {
+	if m != nil {
+		return m.TopN
+	}
+	return 0
+}
+
+type TokenHolder struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Balance              string   `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenHolder) Reset()         { *m = TokenHolder{} }
+func (m *TokenHolder) String() string { return proto.CompactTextString(m) }
+func (*TokenHolder) ProtoMessage()    {}
+func (*TokenHolder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{116}
+}
+
+func (m *TokenHolder) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenHolder.Unmarshal(m, b)
+}
+func (m *TokenHolder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenHolder.Marshal(b, m, deterministic)
+}
+func (m *TokenHolder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenHolder.Merge(m, src)
+}
+func (m *TokenHolder) XXX_Size() int {
+	return xxx_messageInfo_TokenHolder.Size(m)
+}
+func (m *TokenHolder) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenHolder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenHolder proto.InternalMessageInfo
+
+func (m *TokenHolder) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *TokenHolder) GetBalance() string {
+	if m != nil {
+		return m.Balance
+	}
+	return ""
+}
+
+// Token stats response, top holders are ordered by balance descending
+type TokenStatsResponse struct {
+	Header               *Header        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string         `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Height               int64          `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	TotalSupply          string         `protobuf:"bytes,4,opt,name=total_supply,json=totalSupply,proto3" json:"total_supply,omitempty"`
+	FrozenAmount         string         `protobuf:"bytes,5,opt,name=frozen_amount,json=frozenAmount,proto3" json:"frozen_amount,omitempty"`
+	HolderCount          int64          `protobuf:"varint,6,opt,name=holder_count,json=holderCount,proto3" json:"holder_count,omitempty"`
+	TopHolders           []*TokenHolder `protobuf:"bytes,7,rep,name=top_holders,json=topHolders,proto3" json:"top_holders,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *TokenStatsResponse) Reset()         { *m = TokenStatsResponse{} }
+func (m *TokenStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*TokenStatsResponse) ProtoMessage()    {}
+func (*TokenStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{117}
+}
+
+func (m *TokenStatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenStatsResponse.Unmarshal(m, b)
+}
+func (m *TokenStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenStatsResponse.Marshal(b, m, deterministic)
+}
+func (m *TokenStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenStatsResponse.Merge(m, src)
+}
+func (m *TokenStatsResponse) XXX_Size() int {
+	return xxx_messageInfo_TokenStatsResponse.Size(m)
+}
+func (m *TokenStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenStatsResponse proto.InternalMessageInfo
+
+func (m *TokenStatsResponse) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *TokenStatsResponse) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *TokenStatsResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *TokenStatsResponse) GetTotalSupply() string {
+	if m != nil {
+		return m.TotalSupply
+	}
+	return ""
+}
+
+func (m *TokenStatsResponse) GetFrozenAmount() string {
+	if m != nil {
+		return m.FrozenAmount
+	}
+	return ""
+}
+
+func (m *TokenStatsResponse) GetHolderCount() int64 {
+	if m != nil {
+		return m.HolderCount
+	}
+	return 0
+}
+
+func (m *TokenStatsResponse) GetTopHolders() []*TokenHolder {
+	if m != nil {
+		return m.TopHolders
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("pb.XChainErrorEnum", XChainErrorEnum_name, XChainErrorEnum_value)
+	proto.RegisterEnum("pb.TransactionStatus", TransactionStatus_name, TransactionStatus_value)
+	proto.RegisterEnum("pb.ViewOption", ViewOption_name, ViewOption_value)
+	proto.RegisterEnum("pb.PermissionRule", PermissionRule_name, PermissionRule_value)
+	proto.RegisterEnum("pb.ResourceType", ResourceType_name, ResourceType_value)
+	proto.RegisterEnum("pb.TxDirection", TxDirection_name, TxDirection_value)
+	proto.RegisterEnum("pb.WaitTxResult", WaitTxResult_name, WaitTxResult_value)
+	proto.RegisterEnum("pb.Block_EBlockStatus", Block_EBlockStatus_name, Block_EBlockStatus_value)
+	proto.RegisterType((*Header)(nil), "pb.Header")
+	proto.RegisterType((*TxDataAccount)(nil), "pb.TxDataAccount")
+	proto.RegisterType((*TxData)(nil), "pb.TxData")
+	proto.RegisterType((*TxStatus)(nil), "pb.TxStatus")
+	proto.RegisterType((*BatchTxs)(nil), "pb.BatchTxs")
+	proto.RegisterType((*Block)(nil), "pb.Block")
+	proto.RegisterType((*BlockID)(nil), "pb.BlockID")
+	proto.RegisterType((*BlockHeight)(nil), "pb.BlockHeight")
+	proto.RegisterType((*CommonReply)(nil), "pb.CommonReply")
+	proto.RegisterType((*CommonIn)(nil), "pb.CommonIn")
+	proto.RegisterType((*TokenDetail)(nil), "pb.TokenDetail")
+	proto.RegisterType((*AddressStatus)(nil), "pb.AddressStatus")
+	proto.RegisterType((*TokenFrozenDetail)(nil), "pb.TokenFrozenDetail")
+	proto.RegisterType((*TokenFrozenDetails)(nil), "pb.TokenFrozenDetails")
+	proto.RegisterType((*AddressBalanceStatus)(nil), "pb.AddressBalanceStatus")
+	proto.RegisterType((*TxInput)(nil), "pb.TxInput")
+	proto.RegisterType((*TxOutput)(nil), "pb.TxOutput")
+	proto.RegisterType((*XuperSignature)(nil), "pb.XuperSignature")
+	proto.RegisterType((*Transaction)(nil), "pb.Transaction")
+	proto.RegisterType((*LedgerMeta)(nil), "pb.LedgerMeta")
+	proto.RegisterType((*UtxoMeta)(nil), "pb.UtxoMeta")
+	proto.RegisterType((*GasPrice)(nil), "pb.GasPrice")
+	proto.RegisterType((*InternalBlock)(nil), "pb.InternalBlock")
+	proto.RegisterMapType((map[string]string)(nil), "pb.InternalBlock.FailedTxsEntry")
+	proto.RegisterType((*BCStatus)(nil), "pb.BCStatus")
+	proto.RegisterType((*BCTipStatus)(nil), "pb.BCTipStatus")
+	proto.RegisterType((*BlockChains)(nil), "pb.BlockChains")
+	proto.RegisterType((*Speeds)(nil), "pb.Speeds")
+	proto.RegisterMapType((map[string]*BCSpeeds)(nil), "pb.Speeds.BcSpeedsEntry")
+	proto.RegisterMapType((map[string]float64)(nil), "pb.Speeds.SumSpeedsEntry")
+	proto.RegisterType((*BCSpeeds)(nil), "pb.BCSpeeds")
+	proto.RegisterMapType((map[string]float64)(nil), "pb.BCSpeeds.BcSpeedEntry")
+	proto.RegisterType((*SystemsStatus)(nil), "pb.SystemsStatus")
+	proto.RegisterType((*SystemsStatusReply)(nil), "pb.SystemsStatusReply")
+	proto.RegisterType((*RawUrl)(nil), "pb.RawUrl")
 	proto.RegisterType((*Utxo)(nil), "pb.Utxo")
 	proto.RegisterType((*UtxoInput)(nil), "pb.UtxoInput")
 	proto.RegisterType((*UtxoOutput)(nil), "pb.UtxoOutput")
@@ -6410,6 +8140,9 @@ func init() {
 	proto.RegisterType((*ContractList)(nil), "pb.ContractList")
 	proto.RegisterType((*AddressContractsResponse)(nil), "pb.AddressContractsResponse")
 	proto.RegisterMapType((map[string]*ContractList)(nil), "pb.AddressContractsResponse.ContractsEntry")
+	proto.RegisterType((*AddressTx)(nil), "pb.AddressTx")
+	proto.RegisterType((*AddressTxsRequest)(nil), "pb.AddressTxsRequest")
+	proto.RegisterType((*AddressTxsResponse)(nil), "pb.AddressTxsResponse")
 	proto.RegisterType((*CrossQueryRequest)(nil), "pb.CrossQueryRequest")
 	proto.RegisterType((*CrossQueryResponse)(nil), "pb.CrossQueryResponse")
 	proto.RegisterType((*CrossChainMeta)(nil), "pb.CrossChainMeta")
@@ -6417,384 +8150,481 @@ func init() {
 	proto.RegisterType((*CrossQueryMeta)(nil), "pb.CrossQueryMeta")
 	proto.RegisterType((*CrossQueryInfo)(nil), "pb.CrossQueryInfo")
 	proto.RegisterType((*ContractEvent)(nil), "pb.ContractEvent")
+	proto.RegisterType((*BlockRangeRequest)(nil), "pb.BlockRangeRequest")
+	proto.RegisterType((*BlockRangeResponse)(nil), "pb.BlockRangeResponse")
+	proto.RegisterType((*WaitTxRequest)(nil), "pb.WaitTxRequest")
+	proto.RegisterType((*WaitTxResponse)(nil), "pb.WaitTxResponse")
+	proto.RegisterType((*UnconfirmedTxsRequest)(nil), "pb.UnconfirmedTxsRequest")
+	proto.RegisterType((*UnconfirmedTxidsResponse)(nil), "pb.UnconfirmedTxidsResponse")
+	proto.RegisterType((*UnconfirmedTxsResponse)(nil), "pb.UnconfirmedTxsResponse")
+	proto.RegisterType((*TxPoolStatusRequest)(nil), "pb.TxPoolStatusRequest")
+	proto.RegisterType((*TxPoolInitiatorStat)(nil), "pb.TxPoolInitiatorStat")
+	proto.RegisterType((*TxPoolConflict)(nil), "pb.TxPoolConflict")
+	proto.RegisterType((*TxPoolStatusResponse)(nil), "pb.TxPoolStatusResponse")
+	proto.RegisterType((*ContractEventInfo)(nil), "pb.ContractEventInfo")
+	proto.RegisterType((*ContractEventsRequest)(nil), "pb.ContractEventsRequest")
+	proto.RegisterType((*ContractEventsResponse)(nil), "pb.ContractEventsResponse")
+	proto.RegisterType((*EstimateFeeRequest)(nil), "pb.EstimateFeeRequest")
+	proto.RegisterType((*EstimateFeeResponse)(nil), "pb.EstimateFeeResponse")
+	proto.RegisterType((*TokenStatsRequest)(nil), "pb.TokenStatsRequest")
+	proto.RegisterType((*TokenHolder)(nil), "pb.TokenHolder")
+	proto.RegisterType((*TokenStatsResponse)(nil), "pb.TokenStatsResponse")
 }
 
 func init() { proto.RegisterFile("xchain.proto", fileDescriptor_db0991b9525664ca) }
 
 var fileDescriptor_db0991b9525664ca = []byte{
-	// 5948 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x7c, 0xcd, 0x6f, 0x23, 0xc9,
-	0x75, 0xf8, 0x36, 0x29, 0xf1, 0xe3, 0xf1, 0x43, 0x54, 0xcd, 0x48, 0xc3, 0xa1, 0xb4, 0xf3, 0xd1,
-	0x3b, 0xde, 0x95, 0x67, 0x7f, 0xd6, 0xfc, 0x56, 0xb6, 0xb3, 0x8b, 0xb1, 0xbd, 0x0e, 0x45, 0x51,
-	0x33, 0xb4, 0x34, 0xa4, 0xb6, 0x49, 0xce, 0x68, 0xe1, 0x00, 0xed, 0x16, 0x59, 0x92, 0xda, 0x22,
-	0xbb, 0xe9, 0xee, 0xa6, 0x86, 0x5a, 0x1b, 0xc9, 0xc6, 0xc8, 0xc9, 0xb7, 0x24, 0x40, 0x6e, 0x31,
-	0x82, 0x1c, 0x03, 0xe4, 0x12, 0x04, 0xc8, 0x21, 0x40, 0x80, 0x04, 0x41, 0x8e, 0xb9, 0x04, 0x39,
-	0x24, 0x40, 0x4e, 0x0e, 0xf2, 0x1f, 0xe4, 0x1e, 0xd4, 0xab, 0xaa, 0xee, 0x6a, 0x7e, 0xcc, 0x8e,
-	0xbc, 0xb3, 0x7b, 0x19, 0xb1, 0x5e, 0xbd, 0x7a, 0x55, 0xef, 0x55, 0xd5, 0xfb, 0xaa, 0xd7, 0x03,
-	0xf9, 0x49, 0xef, 0xdc, 0xb2, 0x9d, 0xed, 0x91, 0xe7, 0x06, 0x2e, 0x49, 0x8c, 0x4e, 0x2a, 0x9b,
-	0x67, 0xae, 0x7b, 0x36, 0xa0, 0x8f, 0xac, 0x91, 0xfd, 0xc8, 0x72, 0x1c, 0x37, 0xb0, 0x02, 0xdb,
-	0x75, 0x7c, 0x8e, 0x51, 0x29, 0x21, 0x3a, 0xed, 0x9f, 0x9c, 0x06, 0x1c, 0xa2, 0x9f, 0x42, 0xea,
-	0x29, 0xb5, 0xfa, 0xd4, 0x23, 0x37, 0x61, 0x79, 0xe0, 0x9e, 0xd9, 0xfd, 0xb2, 0x76, 0x4f, 0xdb,
-	0xca, 0x1a, 0xbc, 0x41, 0x36, 0x20, 0x7b, 0xea, 0xb9, 0x43, 0xd3, 0x71, 0xfb, 0xb4, 0x9c, 0xc0,
-	0x9e, 0x0c, 0x03, 0x34, 0xdd, 0x3e, 0x25, 0xdf, 0x84, 0x65, 0xea, 0x79, 0xae, 0x57, 0x4e, 0xde,
-	0xd3, 0xb6, 0x8a, 0x3b, 0x37, 0xb6, 0x47, 0x27, 0xdb, 0xc7, 0x35, 0x36, 0x45, 0x9d, 0x81, 0xeb,
-	0xce, 0x78, 0x68, 0x70, 0x0c, 0xfd, 0x14, 0x0a, 0x9d, 0xc9, 0x9e, 0x15, 0x58, 0xd5, 0x5e, 0xcf,
-	0x1d, 0x3b, 0x01, 0x29, 0x43, 0xda, 0xea, 0xf7, 0x3d, 0xea, 0xfb, 0x62, 0x42, 0xd9, 0x24, 0xeb,
-	0x90, 0xb2, 0x86, 0x0c, 0x47, 0xcc, 0x27, 0x5a, 0xe4, 0x1d, 0x28, 0x9c, 0x7a, 0xee, 0x67, 0xd4,
-	0x31, 0xcf, 0xa9, 0x7d, 0x76, 0x1e, 0xe0, 0xac, 0x49, 0x23, 0xcf, 0x81, 0x4f, 0x11, 0xa6, 0xff,
-	0x26, 0x01, 0x29, 0x3e, 0x11, 0xd1, 0x21, 0x75, 0x8e, 0xac, 0x95, 0x0b, 0xf7, 0xb4, 0xad, 0xdc,
-	0x0e, 0xb0, 0xe5, 0x71, 0x66, 0x0d, 0xd1, 0x43, 0x08, 0x2c, 0x05, 0x13, 0xc1, 0x73, 0xde, 0xc0,
-	0xdf, 0x6c, 0xfe, 0x93, 0x9e, 0x63, 0x0d, 0x25, 0xbf, 0xa2, 0x15, 0x8a, 0x82, 0xad, 0x13, 0xe7,
-	0x16, 0xa2, 0xa8, 0xf6, 0xfb, 0x1e, 0xb9, 0x0b, 0x39, 0xec, 0x1c, 0x8d, 0x4f, 0x2e, 0xe8, 0x55,
-	0x79, 0x09, 0xbb, 0x81, 0x81, 0x8e, 0x10, 0x12, 0x22, 0xf8, 0x3d, 0x8f, 0x21, 0x2c, 0x47, 0x08,
-	0x6d, 0x84, 0x30, 0xf2, 0x63, 0x9f, 0x7a, 0xa6, 0x6f, 0x9f, 0x39, 0xe5, 0x22, 0xae, 0x27, 0xc3,
-	0x00, 0x6d, 0xfb, 0xcc, 0x21, 0xef, 0x43, 0xda, 0xe2, 0x82, 0x2b, 0xa7, 0xee, 0x25, 0xb7, 0x72,
-	0x3b, 0xab, 0x8c, 0x99, 0x98, 0x44, 0x0d, 0x89, 0xc1, 0x76, 0xd2, 0x71, 0x9d, 0x1e, 0x2d, 0x67,
-	0xf8, 0x4e, 0x62, 0x83, 0x6c, 0x42, 0x36, 0xb0, 0x87, 0xd4, 0x0f, 0xac, 0xe1, 0xa8, 0x9c, 0x45,
-	0xd1, 0x45, 0x00, 0x26, 0x88, 0x3e, 0xf5, 0x7b, 0xe5, 0x3c, 0x17, 0x04, 0xfb, 0xcd, 0xb6, 0xe8,
-	0x92, 0x7a, 0xbe, 0xed, 0x3a, 0xe5, 0x95, 0x7b, 0xda, 0xd6, 0xb2, 0x21, 0x9b, 0xfa, 0xbf, 0x68,
-	0x90, 0xe9, 0x4c, 0xda, 0x81, 0x15, 0x8c, 0x7d, 0x45, 0xce, 0xda, 0x42, 0x39, 0x2f, 0x92, 0xa9,
-	0x94, 0x7f, 0x52, 0x91, 0xff, 0xb7, 0x20, 0xe5, 0x23, 0x65, 0x94, 0x62, 0x71, 0x67, 0x0d, 0x59,
-	0xf5, 0x2c, 0xc7, 0xb7, 0x7a, 0xec, 0x30, 0xf3, 0x69, 0x0d, 0x81, 0x44, 0x2a, 0x90, 0xe9, 0xdb,
-	0x7e, 0x60, 0x31, 0x86, 0x97, 0x91, 0xad, 0xb0, 0x4d, 0xee, 0x42, 0x22, 0x98, 0x94, 0xd3, 0xb8,
-	0xac, 0x95, 0x29, 0x32, 0x46, 0x22, 0x98, 0xe8, 0x4d, 0xc8, 0xec, 0x5a, 0x41, 0xef, 0xbc, 0x33,
-	0x79, 0x3d, 0x3e, 0xee, 0x40, 0xb2, 0x33, 0xf1, 0xcb, 0x09, 0xdc, 0x83, 0x3c, 0xdf, 0x03, 0xb1,
-	0x1e, 0xd6, 0xa1, 0xff, 0xaf, 0x06, 0xcb, 0xbb, 0x03, 0xb7, 0x77, 0xf1, 0xa5, 0xa4, 0x52, 0x86,
-	0xf4, 0x09, 0x23, 0x12, 0x0a, 0x46, 0x36, 0xc9, 0xf6, 0x94, 0x6c, 0xd6, 0x19, 0x55, 0x9c, 0x70,
-	0xbb, 0x8e, 0x7f, 0xa6, 0x84, 0xf3, 0x1e, 0x2c, 0xe3, 0x50, 0x94, 0x8c, 0x38, 0x35, 0x0d, 0x27,
-	0xa0, 0x9e, 0x63, 0x0d, 0x10, 0xdf, 0xe0, 0xfd, 0xfa, 0x0f, 0x20, 0xaf, 0x12, 0x20, 0x59, 0x58,
-	0xae, 0x1b, 0x46, 0xcb, 0x28, 0xbd, 0xc5, 0x7e, 0x76, 0x8c, 0x6e, 0xf3, 0xa0, 0xa4, 0x11, 0x80,
-	0xd4, 0xae, 0x51, 0x6d, 0xd6, 0x9e, 0x96, 0x12, 0x24, 0x07, 0xe9, 0x66, 0xab, 0x7e, 0xdc, 0x68,
-	0x77, 0x4a, 0x49, 0xfd, 0x97, 0x1a, 0xa4, 0x71, 0x78, 0x63, 0x4f, 0xe1, 0x7c, 0xe9, 0x35, 0x38,
-	0xd7, 0x16, 0x71, 0x9e, 0x88, 0x73, 0x7e, 0x1f, 0xf2, 0x0e, 0xa5, 0x7d, 0xb3, 0xe7, 0x3a, 0x01,
-	0x75, 0xf8, 0xe5, 0xcf, 0x18, 0x39, 0x06, 0xab, 0x71, 0x90, 0x6e, 0x41, 0x0e, 0xd7, 0xc0, 0x55,
-	0x81, 0xb2, 0x8e, 0xe4, 0xb5, 0xd7, 0xb1, 0xce, 0xc6, 0xa2, 0x92, 0x49, 0xe0, 0x91, 0x12, 0x2d,
-	0xfd, 0x03, 0xc8, 0xd5, 0xdc, 0xe1, 0xd0, 0x75, 0x0c, 0x3a, 0x1a, 0x5c, 0xbd, 0xce, 0x26, 0xeb,
-	0x26, 0x64, 0xf8, 0x90, 0x86, 0xf3, 0x5a, 0x87, 0xe2, 0x11, 0xe4, 0x2e, 0x6d, 0xfa, 0xd2, 0x74,
-	0x47, 0xec, 0x94, 0xe2, 0xfc, 0xc5, 0x9d, 0x22, 0x43, 0x7c, 0x6e, 0xd3, 0x97, 0x2d, 0x84, 0x1a,
-	0x70, 0x19, 0xfe, 0xd6, 0x7f, 0x0a, 0xb9, 0x8e, 0x7b, 0x41, 0x9d, 0x3d, 0x1a, 0x58, 0xf6, 0xe0,
-	0x95, 0xa2, 0xb5, 0x06, 0x78, 0x4d, 0xf8, 0x69, 0x93, 0xcd, 0xeb, 0xa8, 0xf1, 0x11, 0x14, 0xaa,
-	0x5c, 0x4d, 0x5f, 0xe3, 0xf2, 0x2b, 0xaa, 0x3e, 0x11, 0x57, 0xf5, 0xf7, 0x21, 0x79, 0xd2, 0xf3,
-	0xcb, 0x49, 0xbc, 0x4e, 0xfc, 0x82, 0x46, 0x9c, 0x18, 0xac, 0x4f, 0x6f, 0xc0, 0x2a, 0xc2, 0xf6,
-	0x51, 0xcb, 0x0b, 0x1e, 0x15, 0x5e, 0xb4, 0x38, 0x2f, 0x15, 0xc8, 0xd8, 0x3e, 0xc7, 0xc5, 0xc9,
-	0x32, 0x46, 0xd8, 0xd6, 0x3f, 0xd7, 0x80, 0xcc, 0xd0, 0xf2, 0x17, 0x0a, 0xec, 0x3d, 0x48, 0x06,
-	0xa7, 0x7d, 0x71, 0xd7, 0xd7, 0xc2, 0xc5, 0xa9, 0x83, 0x0d, 0x86, 0x71, 0x1d, 0xf9, 0x7d, 0xae,
-	0xc1, 0x4d, 0x21, 0xc0, 0x5d, 0xbe, 0xe2, 0x37, 0x22, 0xc7, 0x87, 0xb0, 0x14, 0x9c, 0xf6, 0xa5,
-	0x20, 0xd7, 0xe7, 0xae, 0xd5, 0x37, 0x10, 0x47, 0xff, 0x73, 0x0d, 0xd2, 0x9d, 0x49, 0xc3, 0x19,
-	0x8d, 0x03, 0x72, 0x1b, 0x32, 0x1e, 0x3d, 0x35, 0x15, 0x13, 0x98, 0xf6, 0xe8, 0x69, 0x87, 0x69,
-	0xe1, 0xb7, 0x01, 0x58, 0x97, 0x7b, 0x7a, 0xea, 0x53, 0x7e, 0x0b, 0x96, 0x8d, 0xac, 0x47, 0x4f,
-	0x5b, 0x08, 0x88, 0x1b, 0xc3, 0x65, 0x6e, 0xad, 0x42, 0x63, 0x18, 0x59, 0xf0, 0x14, 0xf6, 0x2c,
-	0xb4, 0xe0, 0xe9, 0x39, 0x16, 0xfc, 0x27, 0xcc, 0xb4, 0xb4, 0xc6, 0x01, 0x5b, 0x5f, 0x44, 0x48,
-	0x8b, 0x11, 0xba, 0x05, 0xe9, 0xc0, 0xe5, 0x73, 0x73, 0x35, 0x91, 0x0a, 0x5c, 0x9c, 0x79, 0x66,
-	0x86, 0xa5, 0x39, 0x33, 0xb4, 0xa0, 0x78, 0x3c, 0x1e, 0x71, 0xcb, 0x6a, 0x05, 0x63, 0x8f, 0xd9,
-	0x89, 0xdc, 0x68, 0x7c, 0x32, 0xb0, 0x7b, 0xe6, 0x05, 0xbd, 0x62, 0x0e, 0x49, 0x72, 0x2b, 0x6f,
-	0x00, 0x07, 0x1d, 0xd0, 0x2b, 0x9f, 0x19, 0x4f, 0x5f, 0x62, 0x8b, 0x29, 0x23, 0x80, 0xfe, 0xaf,
-	0x29, 0xc8, 0x29, 0x96, 0x65, 0xae, 0x57, 0xb1, 0x58, 0xb3, 0x6d, 0x41, 0x36, 0x98, 0x98, 0x36,
-	0xdb, 0x10, 0xb9, 0x83, 0x39, 0x6e, 0x59, 0x70, 0x93, 0x8c, 0x4c, 0xc0, 0x7f, 0xf8, 0xe4, 0x7d,
-	0x80, 0x60, 0x62, 0xba, 0x28, 0x1b, 0x66, 0x01, 0x14, 0x23, 0xc4, 0x05, 0x66, 0x64, 0x03, 0xf1,
-	0xcb, 0x0f, 0x2d, 0x7a, 0x4a, 0xb1, 0xe8, 0x15, 0xc8, 0xf4, 0x5c, 0xdb, 0x39, 0xb1, 0x7c, 0x8a,
-	0xb2, 0xcf, 0x18, 0x61, 0xfb, 0xb7, 0xf2, 0x1a, 0x14, 0x0f, 0x01, 0x62, 0x1e, 0x02, 0x9e, 0xd5,
-	0x71, 0xe0, 0x9e, 0x51, 0xa7, 0x9c, 0xc3, 0x89, 0x64, 0x93, 0xec, 0x40, 0x21, 0x64, 0xd7, 0xa4,
-	0x93, 0xa0, 0x7c, 0x0b, 0xf9, 0x28, 0x2a, 0x2c, 0xd7, 0x27, 0x81, 0x91, 0x93, 0x5c, 0xd7, 0x27,
-	0x01, 0xf9, 0x2e, 0x14, 0x23, 0xc6, 0x71, 0x50, 0x59, 0x51, 0x19, 0x82, 0x65, 0x36, 0x2a, 0x1f,
-	0xf2, 0xcf, 0x86, 0x7d, 0x0c, 0xab, 0xcc, 0x5c, 0x78, 0x56, 0x2f, 0x30, 0x3d, 0xfa, 0xb3, 0x31,
-	0xf5, 0x03, 0xbf, 0x7c, 0x3b, 0xf2, 0x9f, 0x1a, 0xce, 0xa5, 0x7b, 0x41, 0x0d, 0xde, 0x63, 0x94,
-	0x24, 0xae, 0x00, 0xe0, 0xae, 0xdb, 0x8e, 0x1d, 0xd8, 0x56, 0xe0, 0x7a, 0xe5, 0x0a, 0x8a, 0x25,
-	0x02, 0x30, 0x8b, 0x64, 0x8d, 0x83, 0x73, 0xa4, 0x6c, 0x7b, 0xb4, 0xbc, 0x71, 0x2f, 0xb9, 0x95,
-	0x35, 0x72, 0x0c, 0x66, 0x70, 0x10, 0x79, 0x0c, 0x2b, 0x21, 0x3e, 0x3a, 0x76, 0x7e, 0x79, 0x33,
-	0x9a, 0x3e, 0x3c, 0x7f, 0x0d, 0xe7, 0xd4, 0x35, 0x8a, 0x21, 0x26, 0x83, 0xfb, 0xe4, 0x87, 0x40,
-	0x54, 0xf2, 0x62, 0xf8, 0xdb, 0x8b, 0x86, 0x97, 0x94, 0x79, 0x39, 0x81, 0x6f, 0x01, 0xf1, 0x68,
-	0x8f, 0xda, 0x97, 0xb4, 0x6f, 0x46, 0x7b, 0x78, 0x07, 0xf7, 0x70, 0x55, 0xf6, 0x74, 0xc2, 0xbd,
-	0xfc, 0x00, 0x60, 0xc2, 0x6e, 0x05, 0x77, 0x40, 0xef, 0xa2, 0x16, 0x22, 0xa8, 0xca, 0x62, 0x77,
-	0xc5, 0xc8, 0x4e, 0x64, 0x9b, 0xec, 0x40, 0x7e, 0xe8, 0xf6, 0xed, 0xd3, 0x2b, 0x93, 0x3b, 0x19,
-	0xf7, 0x22, 0x47, 0xeb, 0x19, 0xc2, 0xb9, 0x8b, 0x91, 0x1b, 0x46, 0x0d, 0xf2, 0x0e, 0xa4, 0x9f,
-	0xee, 0x99, 0xb6, 0x73, 0xea, 0x96, 0xef, 0x2b, 0x9a, 0x6e, 0x0f, 0x99, 0x48, 0xf1, 0xbf, 0xba,
-	0x0f, 0x70, 0x48, 0xfb, 0x67, 0xd4, 0x7b, 0x46, 0x03, 0x8b, 0x09, 0xda, 0x73, 0xdd, 0xc0, 0x94,
-	0xf7, 0x87, 0x5f, 0xab, 0x1c, 0x83, 0xed, 0x8a, 0x3b, 0x74, 0x17, 0x72, 0x81, 0x3d, 0x32, 0xe3,
-	0x37, 0x0c, 0x02, 0x7b, 0xb4, 0x1b, 0xb9, 0x0f, 0x81, 0x37, 0x76, 0x2e, 0xe2, 0xb1, 0x43, 0x0e,
-	0x61, 0x42, 0x2d, 0xfc, 0x6a, 0x19, 0x32, 0xdd, 0x60, 0xe2, 0xe2, 0x9c, 0xdf, 0x80, 0xe2, 0xc0,
-	0x0a, 0xa8, 0x3f, 0x3d, 0x6b, 0x81, 0x43, 0x25, 0x59, 0x1d, 0x0a, 0xec, 0x17, 0x53, 0x1b, 0xe6,
-	0xc0, 0xf6, 0x03, 0xb4, 0x16, 0x59, 0x23, 0xc7, 0x80, 0x07, 0xf4, 0xea, 0xd0, 0xf6, 0x03, 0xa6,
-	0x49, 0xc7, 0xc1, 0xc4, 0x35, 0x03, 0x37, 0xb0, 0x06, 0x22, 0x70, 0xc8, 0x32, 0x48, 0x87, 0x01,
-	0xd8, 0x9d, 0xb4, 0x2e, 0xcf, 0xf6, 0xe8, 0xc0, 0xba, 0x12, 0xda, 0x2a, 0x6c, 0x93, 0xff, 0x07,
-	0xab, 0x63, 0xa7, 0xe7, 0x3a, 0xa7, 0xb6, 0x37, 0xec, 0x4c, 0xaa, 0x5c, 0x15, 0x72, 0x27, 0x77,
-	0xb6, 0x83, 0x3c, 0x80, 0xe2, 0xd0, 0x9a, 0xf0, 0x05, 0x9b, 0xbe, 0xfd, 0x19, 0xc5, 0xbb, 0x9f,
-	0x34, 0xf2, 0x43, 0x6b, 0xc2, 0x7d, 0x3b, 0xfb, 0x33, 0x4a, 0x7e, 0x97, 0x1d, 0x0b, 0x9f, 0x7a,
-	0x97, 0xc2, 0x99, 0x62, 0x27, 0xde, 0x2f, 0xa7, 0x17, 0xdd, 0x8a, 0x55, 0x89, 0x5c, 0x93, 0xb8,
-	0x8c, 0xc2, 0xa9, 0xeb, 0x9d, 0xd8, 0xfd, 0x3e, 0x75, 0x42, 0x12, 0xa8, 0x36, 0xe6, 0x53, 0x08,
-	0x91, 0x25, 0x09, 0xf2, 0x03, 0xd8, 0x70, 0xe8, 0x4b, 0x53, 0x04, 0x2c, 0xa6, 0x47, 0x7d, 0x77,
-	0xec, 0xf5, 0xa8, 0x29, 0x94, 0x3d, 0xd7, 0x33, 0x65, 0x87, 0xbe, 0x94, 0xb1, 0x8d, 0x40, 0x10,
-	0x8c, 0x7e, 0x04, 0xb7, 0x6c, 0xcf, 0xa3, 0xa8, 0x6b, 0x4e, 0x06, 0x54, 0x71, 0xfa, 0x50, 0x0d,
-	0x25, 0x8d, 0x45, 0xdd, 0xd3, 0x23, 0xdb, 0x03, 0xbb, 0x4f, 0x5f, 0xd8, 0x4e, 0xdf, 0x7d, 0x89,
-	0x6a, 0x6a, 0x6a, 0xa4, 0xd2, 0x4d, 0xb6, 0x20, 0x73, 0x66, 0xf9, 0x47, 0x9e, 0xdd, 0xa3, 0x18,
-	0x24, 0x09, 0xcd, 0xfb, 0x44, 0xc0, 0x8c, 0xb0, 0x97, 0xd4, 0xe0, 0xe6, 0x99, 0xe7, 0x8e, 0x47,
-	0x26, 0x06, 0xdb, 0x91, 0x80, 0x0a, 0x8b, 0x04, 0x44, 0x10, 0x1d, 0x1d, 0x06, 0x29, 0x21, 0xfd,
-	0x33, 0xc8, 0x48, 0xd2, 0xcc, 0x4a, 0xf7, 0x46, 0x63, 0xd3, 0xb3, 0x02, 0xee, 0xa2, 0x24, 0x8d,
-	0x74, 0x6f, 0x34, 0x36, 0xac, 0x00, 0xbb, 0x86, 0x74, 0xc8, 0xbb, 0xb8, 0xa7, 0x9a, 0x1e, 0xd2,
-	0x21, 0x76, 0x6d, 0x40, 0xb6, 0x6f, 0xfb, 0x17, 0xbc, 0x2f, 0x19, 0x06, 0x46, 0x17, 0xb2, 0x73,
-	0x72, 0x4a, 0x29, 0xef, 0x14, 0xa7, 0x8e, 0x01, 0x58, 0xa7, 0xfe, 0x8f, 0xcb, 0x50, 0x88, 0x05,
-	0x09, 0xaa, 0x9e, 0xd7, 0xe2, 0x7a, 0x3e, 0xb4, 0x1a, 0xdc, 0x43, 0x10, 0x56, 0x63, 0x71, 0x00,
-	0x73, 0x1b, 0x32, 0x23, 0x8f, 0x9a, 0xe7, 0x96, 0x7f, 0x8e, 0xf3, 0xe6, 0x8d, 0xf4, 0xc8, 0xa3,
-	0x4f, 0x2d, 0xff, 0x9c, 0x5d, 0x84, 0x91, 0xe7, 0x8e, 0x5c, 0x9f, 0x86, 0x1e, 0x85, 0x6c, 0x33,
-	0x63, 0x86, 0x6a, 0x49, 0x18, 0x33, 0xf6, 0x9b, 0x39, 0x07, 0x22, 0xda, 0x4e, 0x73, 0x1f, 0x60,
-	0x14, 0x46, 0xda, 0x43, 0xea, 0x5d, 0x0c, 0xa8, 0xc9, 0x34, 0x04, 0x9e, 0xcb, 0xbc, 0x01, 0x1c,
-	0x64, 0xb8, 0x6e, 0xa0, 0x38, 0xf7, 0x59, 0xd5, 0xb9, 0x8f, 0xdb, 0x3a, 0x98, 0xb6, 0x75, 0xdf,
-	0x66, 0x1a, 0x24, 0xb4, 0xf1, 0x7e, 0x39, 0xa7, 0x58, 0x20, 0x25, 0xaa, 0x8c, 0x21, 0x31, 0x76,
-	0x83, 0x89, 0xc9, 0x03, 0xf7, 0x3c, 0x97, 0x5c, 0x30, 0xa9, 0xe1, 0x21, 0x8e, 0x96, 0x19, 0x78,
-	0x94, 0x96, 0x0b, 0xdc, 0xe7, 0xe0, 0xa0, 0x8e, 0x47, 0x51, 0x88, 0xbd, 0xb1, 0xd7, 0xa1, 0xde,
-	0xb0, 0x5c, 0x12, 0xbb, 0xce, 0x9b, 0xe4, 0x1e, 0xe4, 0x7a, 0x63, 0x0f, 0xb7, 0xa6, 0x39, 0x1e,
-	0x96, 0x57, 0xb9, 0x2e, 0x53, 0x40, 0xe4, 0x87, 0x00, 0xa7, 0x96, 0x3d, 0x60, 0x9a, 0x7f, 0xe2,
-	0x97, 0x09, 0x2e, 0xf5, 0xde, 0x4c, 0xf0, 0xb7, 0xbd, 0x8f, 0x38, 0x9d, 0x89, 0x5f, 0x77, 0x02,
-	0xef, 0xca, 0xc8, 0x9e, 0xca, 0x36, 0xb9, 0x03, 0x10, 0x58, 0xde, 0x19, 0x0d, 0x76, 0xed, 0xc0,
-	0x2f, 0xdf, 0xc0, 0xa5, 0x2b, 0x10, 0xb2, 0x05, 0xe9, 0x1f, 0x8d, 0xfd, 0xc0, 0x3e, 0xbd, 0x2a,
-	0xdf, 0xc4, 0x73, 0x8d, 0xf6, 0xfb, 0x93, 0xb1, 0xeb, 0x8d, 0x87, 0x35, 0xea, 0x05, 0x86, 0xec,
-	0x66, 0x22, 0xb0, 0x1d, 0x13, 0x15, 0x2d, 0xa6, 0x35, 0x32, 0x46, 0xda, 0x76, 0x3a, 0xac, 0xc9,
-	0x4e, 0xa1, 0x43, 0x27, 0x01, 0x3f, 0x0d, 0x2b, 0x7c, 0xcb, 0x19, 0x80, 0x1d, 0x87, 0xca, 0xf7,
-	0xa1, 0x18, 0x5f, 0x1e, 0x29, 0x41, 0x92, 0xed, 0x36, 0xf7, 0xd2, 0xd9, 0x4f, 0x76, 0xfa, 0x2e,
-	0xad, 0xc1, 0x58, 0x46, 0x34, 0xbc, 0xf1, 0x38, 0xf1, 0x91, 0xa6, 0xff, 0x46, 0x83, 0xcc, 0x6e,
-	0xed, 0x0d, 0x64, 0x28, 0x74, 0x58, 0x1a, 0xd2, 0xc0, 0x12, 0x31, 0x24, 0x72, 0x19, 0x99, 0x26,
-	0x03, 0xfb, 0xa2, 0x28, 0x7b, 0xe9, 0xd5, 0x51, 0x36, 0x53, 0x22, 0x63, 0x61, 0x61, 0x44, 0x44,
-	0x8e, 0x4a, 0x44, 0x5a, 0x1d, 0x23, 0xec, 0x25, 0x0f, 0xa0, 0x70, 0xe2, 0x59, 0x4e, 0xef, 0x5c,
-	0x58, 0x1a, 0x4c, 0xfb, 0x64, 0x8d, 0x38, 0x50, 0x6f, 0x43, 0x6e, 0xb7, 0xd6, 0xb1, 0x47, 0xd7,
-	0xe0, 0xf3, 0x1e, 0xe4, 0x6d, 0x9f, 0x6f, 0x87, 0x19, 0xd8, 0x23, 0x11, 0x24, 0x81, 0xed, 0xe3,
-	0x96, 0x74, 0xec, 0x11, 0x12, 0x65, 0xf4, 0x51, 0x21, 0xbd, 0x2e, 0xd1, 0x1c, 0x32, 0x88, 0x1a,
-	0xcf, 0x97, 0x46, 0x50, 0x01, 0xe9, 0x9f, 0x27, 0x20, 0xd5, 0x1e, 0x51, 0xda, 0xf7, 0xc9, 0x87,
-	0x90, 0x6d, 0x8f, 0x87, 0xbc, 0x81, 0xae, 0x76, 0x6e, 0xe7, 0x36, 0xfa, 0x33, 0x08, 0xd9, 0x0e,
-	0xfb, 0xc4, 0x99, 0x0c, 0xdb, 0xe4, 0x3b, 0x90, 0xd9, 0xed, 0x89, 0x71, 0x3c, 0x2a, 0x2b, 0x2b,
-	0xe3, 0x64, 0x17, 0x1f, 0x16, 0x62, 0xb2, 0x73, 0x14, 0x27, 0xf9, 0x45, 0xe7, 0x48, 0x53, 0xce,
-	0x51, 0xa5, 0x01, 0x85, 0x18, 0xe1, 0x39, 0x83, 0x75, 0x75, 0xb0, 0xd8, 0xd1, 0xdd, 0x1a, 0x1f,
-	0xa3, 0x1e, 0xc9, 0x9f, 0xe3, 0x89, 0xe4, 0xac, 0x7c, 0x1b, 0xd2, 0x82, 0xac, 0x2a, 0x01, 0xd9,
-	0x2d, 0x79, 0xe1, 0xac, 0x48, 0xcc, 0xca, 0x63, 0xc8, 0xab, 0x1d, 0xd7, 0xe1, 0x43, 0xff, 0x0b,
-	0x0d, 0x0a, 0xed, 0x2b, 0x3f, 0xa0, 0xc3, 0xeb, 0x44, 0xee, 0xef, 0x03, 0x9c, 0xf4, 0x7c, 0x53,
-	0xa4, 0x9c, 0x94, 0xac, 0x97, 0xbc, 0x5a, 0x46, 0xf6, 0xa4, 0xa7, 0x10, 0xf4, 0xf9, 0xe6, 0x28,
-	0xf9, 0x16, 0x21, 0x06, 0xd1, 0x83, 0x3a, 0x9e, 0x52, 0xaf, 0xeb, 0x0d, 0x78, 0xfc, 0x92, 0x35,
-	0xc2, 0xb6, 0xee, 0x01, 0x89, 0xad, 0xf0, 0xb5, 0x53, 0x2c, 0xe4, 0x23, 0x28, 0xfa, 0x7c, 0x64,
-	0xb4, 0xd4, 0xf0, 0x22, 0xc6, 0x69, 0x16, 0x7c, 0xb5, 0xa9, 0xef, 0x41, 0xca, 0xb0, 0x5e, 0x76,
-	0xbd, 0xc1, 0xeb, 0xea, 0x08, 0x0f, 0xb1, 0xa5, 0x8e, 0xe0, 0x2d, 0xfd, 0x57, 0x1a, 0x2c, 0xb1,
-	0x3b, 0xbc, 0x30, 0x5e, 0x5d, 0x07, 0x11, 0xa0, 0x4e, 0x85, 0xab, 0x15, 0xc8, 0x04, 0x2e, 0x4f,
-	0x10, 0x0b, 0x43, 0x19, 0xb6, 0x99, 0xfa, 0x17, 0xb1, 0xb8, 0x34, 0x94, 0x32, 0x34, 0xdf, 0x84,
-	0x28, 0x10, 0x47, 0x35, 0xa2, 0x46, 0xe6, 0xfa, 0xbf, 0x6b, 0x90, 0x65, 0x8b, 0xe1, 0x11, 0xfe,
-	0x97, 0x4c, 0x43, 0xca, 0x7c, 0x43, 0x32, 0x9e, 0x6f, 0xd8, 0x84, 0x2c, 0x0f, 0x8e, 0xa3, 0x5c,
-	0x77, 0x04, 0x40, 0x3b, 0xca, 0x5c, 0xdb, 0x26, 0x3b, 0xde, 0x3c, 0xd1, 0x1d, 0x01, 0x18, 0xcf,
-	0x32, 0xad, 0x2d, 0x0c, 0x77, 0x94, 0xe6, 0xae, 0x40, 0xc6, 0xa1, 0xb4, 0x7f, 0xc8, 0x74, 0x69,
-	0x86, 0xc7, 0xa7, 0xb2, 0xad, 0xff, 0x02, 0x80, 0xb1, 0x25, 0x32, 0x03, 0xaf, 0xc3, 0xd7, 0x03,
-	0xae, 0x6d, 0x0f, 0xa5, 0x5f, 0x9e, 0xdb, 0xc9, 0x48, 0x6d, 0x6b, 0x84, 0x3d, 0x4c, 0xd3, 0xe2,
-	0xe2, 0xda, 0x74, 0x40, 0x7b, 0x01, 0xed, 0x0b, 0x5e, 0xe3, 0x40, 0xfd, 0x2f, 0x35, 0x28, 0x36,
-	0xad, 0xc0, 0xbe, 0xa4, 0x35, 0xb7, 0x4f, 0xf7, 0x58, 0x30, 0x4d, 0x60, 0x49, 0xc9, 0x1a, 0x2d,
-	0x49, 0x91, 0x49, 0x47, 0x49, 0xa4, 0x68, 0xa4, 0xa3, 0xb4, 0x0e, 0xa9, 0xbe, 0x7d, 0x46, 0xfd,
-	0x40, 0x6c, 0xb4, 0x68, 0x31, 0xd5, 0x39, 0xf2, 0xe8, 0xe5, 0x73, 0x31, 0x8a, 0x0b, 0x53, 0x05,
-	0x91, 0x2d, 0x58, 0xc1, 0x90, 0xab, 0x3a, 0xb2, 0x25, 0x16, 0xdf, 0xf4, 0x69, 0x30, 0x5b, 0x64,
-	0xfe, 0x85, 0xe5, 0x0f, 0xc3, 0x25, 0xb2, 0x33, 0x34, 0x76, 0x98, 0x0f, 0x23, 0xf3, 0x64, 0xa2,
-	0xc9, 0x33, 0x01, 0xc3, 0x91, 0x3d, 0xa0, 0x9e, 0x7c, 0xd6, 0x91, 0xed, 0x85, 0x4b, 0xbd, 0x0b,
-	0xb9, 0xcb, 0xa1, 0x19, 0x0e, 0x13, 0x6f, 0x1c, 0x97, 0xc3, 0x9a, 0x1c, 0xf8, 0x0e, 0x14, 0xc2,
-	0x78, 0x3b, 0xb8, 0x1a, 0x51, 0xb1, 0xf9, 0x79, 0x09, 0xec, 0x5c, 0x8d, 0xa8, 0x3e, 0x80, 0x52,
-	0x24, 0x48, 0xa1, 0x3a, 0xde, 0x15, 0xb9, 0x0a, 0x2d, 0x8a, 0x3a, 0xe3, 0xc2, 0x16, 0xf9, 0x8b,
-	0xf5, 0x30, 0xfd, 0xcd, 0xdd, 0x4d, 0x99, 0xe6, 0x2e, 0x43, 0xfa, 0x9c, 0x5a, 0x83, 0xe0, 0xfc,
-	0x4a, 0xe4, 0x85, 0x65, 0x53, 0x6f, 0xc3, 0xda, 0xde, 0xc8, 0xf5, 0x6b, 0x96, 0xd3, 0xb7, 0xfb,
-	0x2c, 0x74, 0x13, 0x4e, 0xf7, 0x97, 0xb9, 0x18, 0x7a, 0x1f, 0xd6, 0xa7, 0x89, 0xfa, 0x23, 0xd7,
-	0xf1, 0xe9, 0x6b, 0x51, 0x7d, 0x17, 0x8a, 0xbd, 0x70, 0x24, 0x0b, 0x77, 0x85, 0xbd, 0x9c, 0x82,
-	0xea, 0x1e, 0x54, 0xd8, 0x2c, 0x4d, 0x77, 0x68, 0x3b, 0x56, 0x40, 0x0d, 0xda, 0x73, 0xbd, 0xfe,
-	0x9b, 0x58, 0xff, 0xe2, 0x8b, 0xad, 0xef, 0x41, 0x49, 0x9d, 0x93, 0xad, 0x83, 0x5d, 0xe7, 0x70,
-	0x65, 0xe2, 0x18, 0x45, 0x80, 0x30, 0xd7, 0xc5, 0x67, 0xc0, 0xdf, 0xfa, 0x1f, 0x6a, 0xb0, 0x31,
-	0x77, 0xe9, 0xd7, 0x90, 0xd2, 0xc7, 0xb0, 0xe2, 0xc4, 0x87, 0x8b, 0x3b, 0x7c, 0x93, 0x21, 0x4f,
-	0x2f, 0xd2, 0x98, 0x46, 0xd6, 0x7f, 0x06, 0xb7, 0x43, 0x24, 0xfa, 0xf5, 0x08, 0xaf, 0xa3, 0x6c,
-	0x18, 0xfd, 0xad, 0x98, 0x9e, 0x27, 0x4c, 0x87, 0x1f, 0xb6, 0xe7, 0xee, 0xd7, 0x74, 0x04, 0x3e,
-	0x06, 0xb8, 0x0c, 0xe7, 0xfa, 0x2d, 0x36, 0xff, 0x25, 0xdc, 0x9a, 0x59, 0xef, 0x35, 0x44, 0xf0,
-	0x11, 0xac, 0xb0, 0xe9, 0x99, 0xa1, 0x8b, 0xef, 0x3b, 0xba, 0xde, 0xd1, 0xca, 0x8c, 0x69, 0x34,
-	0xdd, 0x8d, 0x26, 0xee, 0x7f, 0x2d, 0x92, 0xfa, 0x10, 0x72, 0x97, 0xd1, 0x64, 0xe8, 0x7c, 0xb9,
-	0x81, 0x98, 0x83, 0x05, 0x23, 0xac, 0x31, 0x57, 0x44, 0x3f, 0x87, 0xf2, 0xec, 0x4a, 0xaf, 0x21,
-	0xa3, 0xef, 0x41, 0x09, 0x27, 0x9e, 0x15, 0xd2, 0x8a, 0x14, 0x92, 0x80, 0x1b, 0x33, 0x88, 0xba,
-	0xcd, 0xc5, 0x54, 0x3b, 0xa7, 0xbd, 0x0b, 0x83, 0xfa, 0xe3, 0x41, 0xf0, 0x46, 0xc4, 0xc4, 0xf8,
-	0x64, 0xa1, 0x2a, 0xcf, 0x34, 0xe0, 0x6f, 0x3d, 0xe0, 0x7c, 0xc6, 0xa7, 0xba, 0xe6, 0x75, 0x60,
-	0x34, 0x13, 0x11, 0x4d, 0x8c, 0x7d, 0x23, 0x7a, 0x98, 0x2f, 0xcf, 0x1a, 0x2a, 0x48, 0x6f, 0xc1,
-	0x2a, 0x9b, 0x55, 0x3a, 0x91, 0x5f, 0x5e, 0xdd, 0xff, 0x04, 0x88, 0x4a, 0xf0, 0x5a, 0xaa, 0x3e,
-	0x15, 0x73, 0x48, 0x8b, 0x52, 0x77, 0xc5, 0x9f, 0x69, 0xf5, 0x5f, 0x6b, 0x00, 0x11, 0x38, 0xe4,
-	0x5b, 0x53, 0xf8, 0xde, 0x80, 0x2c, 0x4f, 0xec, 0x39, 0x63, 0x29, 0x90, 0xcc, 0x89, 0x0c, 0xf7,
-	0xd5, 0xd4, 0x89, 0xa8, 0x4c, 0x08, 0x53, 0x27, 0xf7, 0x21, 0x2f, 0x7f, 0xe3, 0x58, 0x9e, 0xed,
-	0xc9, 0x49, 0x18, 0x1b, 0x3e, 0x25, 0xd3, 0xe5, 0x59, 0x99, 0xfe, 0x83, 0x06, 0x25, 0x91, 0xb4,
-	0x3a, 0xaa, 0xbd, 0x89, 0xe3, 0xf2, 0x2d, 0xc8, 0x84, 0x19, 0xf9, 0xe4, 0xa2, 0xdc, 0x63, 0x88,
-	0x12, 0xcf, 0xc4, 0x2f, 0x7d, 0x51, 0x26, 0x7e, 0x79, 0x26, 0x13, 0xaf, 0xff, 0x01, 0xac, 0x2a,
-	0xeb, 0xbf, 0xc6, 0x16, 0x2e, 0x62, 0x60, 0x9b, 0x31, 0xc0, 0xe9, 0x88, 0x78, 0x87, 0xa8, 0x0c,
-	0xf0, 0x1e, 0x23, 0xc4, 0xd1, 0xff, 0x36, 0x01, 0x85, 0x18, 0x77, 0x98, 0x00, 0x72, 0xfb, 0xe3,
-	0x01, 0x35, 0x15, 0x37, 0x12, 0x38, 0xa8, 0xc9, 0xa6, 0x50, 0xdd, 0x29, 0x65, 0x05, 0xa1, 0x3b,
-	0x85, 0x48, 0x98, 0x46, 0x0a, 0xce, 0xdd, 0x3e, 0x47, 0x49, 0x0a, 0x2a, 0x08, 0x42, 0x84, 0x47,
-	0xb0, 0x64, 0x79, 0x67, 0xf2, 0xb9, 0x68, 0x63, 0x46, 0xca, 0xdb, 0x55, 0xef, 0x4c, 0x04, 0xcd,
-	0x88, 0x48, 0x1e, 0xc3, 0x4a, 0x98, 0x90, 0x1d, 0xd8, 0x43, 0x3b, 0xf0, 0x51, 0xa0, 0x62, 0x87,
-	0x64, 0x2a, 0xf6, 0x90, 0xf5, 0x18, 0x45, 0x4f, 0x6d, 0xfa, 0x53, 0x2f, 0x7f, 0x61, 0xed, 0x4e,
-	0xe5, 0x43, 0xc8, 0x86, 0xd3, 0x7c, 0x51, 0xdc, 0x9a, 0x57, 0xe3, 0xd6, 0xff, 0x4c, 0x40, 0x31,
-	0x2e, 0x53, 0x76, 0xa9, 0xc4, 0x63, 0x99, 0x36, 0xf7, 0xe5, 0x48, 0xf4, 0x92, 0x6f, 0x42, 0x5a,
-	0x3e, 0x95, 0x25, 0xe6, 0xbf, 0x16, 0xc9, 0x7e, 0x76, 0x7f, 0x94, 0xcd, 0x4c, 0xb2, 0x98, 0x44,
-	0xb6, 0xc9, 0x6d, 0x4c, 0xfc, 0x9a, 0x63, 0x9f, 0xf6, 0xc5, 0xdd, 0x49, 0x9f, 0x59, 0x7e, 0xd7,
-	0xa7, 0xfd, 0xd8, 0x21, 0x5e, 0xfe, 0xe2, 0x43, 0xbc, 0xc3, 0xe2, 0x36, 0x4e, 0xd5, 0x17, 0x65,
-	0x3c, 0xe8, 0xcc, 0xd4, 0xc2, 0x77, 0x27, 0x71, 0x6a, 0x22, 0x34, 0x16, 0x81, 0x8f, 0x65, 0x30,
-	0x27, 0xb3, 0xf4, 0xb1, 0xd7, 0x41, 0xa5, 0x9b, 0x6c, 0x43, 0x6e, 0x1c, 0x86, 0x48, 0x7e, 0x39,
-	0x33, 0xe7, 0x81, 0x50, 0x45, 0xd0, 0x47, 0x00, 0x91, 0xdc, 0xf0, 0xa4, 0x8f, 0x7b, 0x17, 0x34,
-	0x08, 0xdf, 0xc1, 0xb1, 0x25, 0xb7, 0x8b, 0x6f, 0x0d, 0x6e, 0x97, 0xfa, 0x6c, 0x9c, 0x7c, 0xd5,
-	0xb3, 0xf1, 0xd2, 0x74, 0x70, 0xfa, 0x0c, 0x72, 0xca, 0x06, 0x5c, 0x63, 0xca, 0xf0, 0x84, 0x24,
-	0x95, 0x13, 0xa2, 0x57, 0xa1, 0x10, 0x7b, 0x05, 0x63, 0x7a, 0xe2, 0x48, 0xbe, 0xda, 0x4a, 0x77,
-	0x25, 0x04, 0x30, 0xbd, 0x8a, 0x61, 0x27, 0xa7, 0x8b, 0xbf, 0xf5, 0x1f, 0xc3, 0xca, 0x11, 0xf5,
-	0x86, 0xb6, 0xcf, 0x22, 0xa8, 0x67, 0x6e, 0x9f, 0x0e, 0x58, 0x34, 0xe2, 0x8d, 0x07, 0xfc, 0x46,
-	0x16, 0xf9, 0xb5, 0x8e, 0x50, 0x8c, 0xf1, 0x80, 0x1a, 0xd8, 0xcf, 0xd4, 0xa6, 0xd5, 0xeb, 0xd1,
-	0x51, 0xf0, 0x5c, 0xc9, 0xb9, 0xa8, 0x20, 0xfd, 0x36, 0x2c, 0x57, 0x2f, 0xda, 0x9c, 0x21, 0xeb,
-	0x82, 0x1f, 0xd8, 0xac, 0xc1, 0x7e, 0xea, 0x7f, 0xa6, 0x41, 0x0a, 0xfb, 0x7c, 0xb2, 0x05, 0x4b,
-	0x3e, 0x0d, 0x8f, 0x33, 0x1e, 0x09, 0xde, 0xb3, 0xcd, 0xfe, 0x11, 0x57, 0x93, 0x61, 0x90, 0x3b,
-	0x00, 0x74, 0x32, 0x62, 0xce, 0x47, 0x14, 0x61, 0x2a, 0x90, 0xca, 0x2e, 0x64, 0xc3, 0x21, 0x73,
-	0xae, 0xd9, 0xdd, 0x78, 0xa6, 0x2a, 0x1b, 0xce, 0xa4, 0xde, 0xb8, 0x7f, 0xd2, 0x20, 0x59, 0xed,
-	0x0d, 0xc8, 0x3b, 0x90, 0x18, 0x0d, 0x85, 0x62, 0xbc, 0x11, 0x97, 0x01, 0x8a, 0xc9, 0x48, 0x8c,
-	0x86, 0xe4, 0x3b, 0x90, 0xb5, 0x2e, 0xfc, 0x17, 0xb2, 0x54, 0x26, 0xac, 0x3e, 0xa8, 0xf6, 0x06,
-	0xdb, 0x55, 0xd9, 0x21, 0x12, 0x79, 0x21, 0x22, 0xd3, 0xbb, 0x16, 0x32, 0xa8, 0x66, 0x8a, 0x38,
-	0xcb, 0x86, 0xe8, 0xa9, 0x7c, 0x1f, 0x8a, 0x71, 0x02, 0xd7, 0x4a, 0x77, 0xfd, 0x8f, 0x06, 0xd9,
-	0x6a, 0x6f, 0xf0, 0x06, 0xf2, 0xbf, 0x7c, 0x93, 0x99, 0x12, 0x6b, 0x46, 0xfa, 0x55, 0x05, 0x11,
-	0x1d, 0x62, 0x1a, 0x59, 0x98, 0xa7, 0xb8, 0x96, 0xbe, 0x03, 0x8a, 0x4a, 0x96, 0xc5, 0x7f, 0x8a,
-	0x92, 0x66, 0x6e, 0x36, 0x7f, 0xcd, 0xa3, 0x7d, 0x54, 0x9d, 0x19, 0x23, 0x02, 0x90, 0xdb, 0x90,
-	0xb4, 0x7a, 0x03, 0x51, 0xc7, 0x96, 0x16, 0xf2, 0x35, 0x18, 0x4c, 0xff, 0x23, 0x0d, 0xf2, 0x8d,
-	0x3e, 0x75, 0x02, 0x3b, 0xb8, 0xaa, 0x8e, 0x83, 0xf3, 0xf0, 0xa5, 0x44, 0x9b, 0xfb, 0x52, 0x92,
-	0x88, 0xbd, 0x94, 0x10, 0x58, 0x52, 0x8a, 0x19, 0xf1, 0x37, 0xe2, 0x52, 0xea, 0x35, 0xf6, 0x04,
-	0x1f, 0xa2, 0x15, 0x7f, 0x1c, 0x91, 0x49, 0x1d, 0x09, 0xd0, 0xbf, 0x0b, 0x05, 0x75, 0x15, 0x3e,
-	0x79, 0x00, 0x4b, 0xcc, 0xfc, 0x8a, 0x33, 0x5d, 0x42, 0xb5, 0xa8, 0x20, 0x18, 0xd8, 0xab, 0x1f,
-	0x40, 0x21, 0x66, 0x4f, 0xd8, 0x30, 0x4c, 0x1c, 0xf0, 0xab, 0x57, 0x52, 0x0d, 0x4e, 0xe7, 0x6a,
-	0x44, 0x0d, 0xec, 0xc5, 0x52, 0x55, 0x86, 0x2e, 0xfc, 0x20, 0xde, 0xd0, 0x6d, 0x58, 0xad, 0x1e,
-	0xec, 0x84, 0x2f, 0x86, 0x5f, 0xa5, 0xe7, 0xff, 0x53, 0x20, 0xea, 0x54, 0x6f, 0xc0, 0x9d, 0x28,
-	0x47, 0x05, 0x9e, 0xdc, 0xa5, 0x95, 0x4d, 0xdd, 0x83, 0xca, 0x13, 0x1a, 0x88, 0xb9, 0xc2, 0x47,
-	0xd8, 0x37, 0xc5, 0x5f, 0x38, 0xa7, 0xa6, 0xce, 0xf9, 0xb9, 0x06, 0x1b, 0x73, 0x27, 0xbd, 0x06,
-	0xa7, 0x3f, 0x80, 0xb0, 0xa0, 0x62, 0x2a, 0x83, 0x4c, 0x54, 0xa3, 0x27, 0x3c, 0xe1, 0x95, 0x10,
-	0x57, 0x64, 0x66, 0xff, 0x46, 0x83, 0x62, 0x1c, 0x67, 0xd6, 0x1f, 0xd2, 0xe6, 0xdc, 0xb4, 0x39,
-	0xf1, 0x56, 0x58, 0x0a, 0x93, 0x54, 0x4a, 0x61, 0x36, 0x20, 0x6b, 0xfb, 0xe6, 0x89, 0xe5, 0x38,
-	0xc2, 0xae, 0x63, 0xa5, 0xd8, 0x2e, 0xb6, 0x67, 0x0f, 0xfb, 0x74, 0xd5, 0x8b, 0xcc, 0xaa, 0xa5,
-	0x62, 0x59, 0x35, 0xfd, 0x8f, 0x13, 0xb0, 0x79, 0xe4, 0xd1, 0xfa, 0x84, 0xf6, 0x5e, 0xd8, 0xc1,
-	0x39, 0xcf, 0x1e, 0x76, 0x3b, 0xc7, 0xad, 0xaf, 0xf4, 0x38, 0x32, 0x1d, 0x85, 0xd9, 0x4a, 0x51,
-	0x20, 0x20, 0x3c, 0x7c, 0x05, 0xc4, 0x3c, 0x15, 0xa6, 0x09, 0x30, 0xdb, 0x94, 0x52, 0x72, 0xe3,
-	0xb1, 0x12, 0x92, 0x10, 0x25, 0x96, 0x87, 0x4d, 0xc7, 0xf3, 0xb0, 0x64, 0x1b, 0xd2, 0xc2, 0xa3,
-	0x11, 0x4f, 0x58, 0x37, 0x15, 0x9f, 0x27, 0x0c, 0x0e, 0x0c, 0x89, 0xa4, 0xff, 0xbd, 0x06, 0x6f,
-	0x2f, 0x90, 0xc9, 0xd7, 0xef, 0x86, 0x93, 0x6d, 0xee, 0x4f, 0x71, 0x17, 0x44, 0xbc, 0xd7, 0x15,
-	0x65, 0x56, 0x58, 0xf8, 0x48, 0x0a, 0x86, 0x7e, 0x0c, 0xa5, 0x69, 0xf7, 0x4c, 0xc9, 0x42, 0x6a,
-	0xd3, 0x59, 0xc8, 0x21, 0xf5, 0x7d, 0xeb, 0x2c, 0xac, 0xb0, 0x14, 0x4d, 0x76, 0x00, 0x4f, 0xdc,
-	0xbe, 0xcc, 0xf1, 0xe3, 0x6f, 0xfd, 0xaf, 0x34, 0xc8, 0x29, 0x55, 0x32, 0xe4, 0x1b, 0x50, 0xa4,
-	0xa7, 0xa7, 0xb4, 0x17, 0xd8, 0x97, 0x34, 0xaa, 0xc8, 0xcb, 0x1a, 0x85, 0x10, 0xda, 0x11, 0xd5,
-	0xe9, 0x43, 0xcb, 0xbb, 0xa0, 0x7d, 0xf1, 0x72, 0x27, 0x5a, 0xe4, 0x9b, 0x50, 0x8a, 0x86, 0xc7,
-	0x8a, 0x5c, 0x56, 0x42, 0xb8, 0x28, 0x82, 0x78, 0x1b, 0x20, 0xaa, 0x76, 0x8b, 0xa7, 0xef, 0x85,
-	0x97, 0x84, 0x16, 0x84, 0x2b, 0x79, 0xfc, 0xad, 0x7f, 0x02, 0xa2, 0x34, 0x87, 0xe8, 0x50, 0x38,
-	0xef, 0x9b, 0xca, 0x78, 0x51, 0x8d, 0x73, 0xde, 0x8f, 0xfc, 0xac, 0x77, 0xa0, 0xe0, 0x7a, 0xf6,
-	0x99, 0xed, 0x58, 0x03, 0xfe, 0xb6, 0xcb, 0xcd, 0x4e, 0x5e, 0x02, 0x9f, 0x5a, 0xfe, 0xb9, 0xfe,
-	0xcf, 0x09, 0x28, 0x61, 0x2a, 0x1e, 0xf3, 0x12, 0xa2, 0xb0, 0xf3, 0xab, 0xb5, 0xd4, 0xbf, 0x03,
-	0x45, 0x77, 0x44, 0x9d, 0x68, 0xd6, 0xe9, 0x03, 0x20, 0x92, 0x26, 0x53, 0x58, 0xe4, 0x31, 0x94,
-	0xd8, 0x16, 0xd1, 0xbe, 0x32, 0x72, 0x79, 0xee, 0xc8, 0x19, 0x3c, 0x36, 0x96, 0x17, 0x1f, 0x2a,
-	0x63, 0x53, 0xf3, 0xc7, 0x4e, 0xe3, 0x31, 0xcf, 0xa2, 0x6f, 0xfb, 0xa3, 0x81, 0x75, 0x85, 0x25,
-	0x03, 0xb2, 0x5c, 0x52, 0x85, 0xe9, 0x17, 0xfc, 0x59, 0x24, 0x4a, 0xd7, 0xb1, 0xc3, 0x5b, 0x0b,
-	0xdf, 0xa0, 0x44, 0xa9, 0x11, 0x2f, 0x39, 0xb8, 0xc3, 0x0f, 0x7f, 0x55, 0xfd, 0xba, 0x42, 0x81,
-	0x90, 0xbb, 0xb0, 0x64, 0x07, 0x74, 0xa8, 0x16, 0x21, 0x32, 0xda, 0x07, 0xf4, 0xca, 0xc0, 0x0e,
-	0xbd, 0x0d, 0x69, 0x01, 0x50, 0x9f, 0xa7, 0xe4, 0xd3, 0x82, 0x08, 0x01, 0xd6, 0x21, 0xa5, 0x54,
-	0x8d, 0x66, 0x0d, 0xd1, 0x52, 0x62, 0xc3, 0xa4, 0x1a, 0x1b, 0xea, 0x5d, 0xb8, 0xa5, 0x2a, 0xfa,
-	0x3d, 0x2b, 0xb0, 0xde, 0x44, 0xd6, 0xe6, 0x73, 0x0d, 0xca, 0xb3, 0x74, 0xdf, 0x80, 0xca, 0xd9,
-	0x82, 0xa5, 0xbe, 0x15, 0x56, 0x04, 0xdc, 0x9c, 0x36, 0x66, 0x38, 0x0f, 0x62, 0xe8, 0xbf, 0x17,
-	0x29, 0x0f, 0xd9, 0xc3, 0xf6, 0xd4, 0x92, 0x66, 0x55, 0x6e, 0x52, 0xd2, 0x88, 0xc1, 0xc8, 0x83,
-	0xc8, 0xd0, 0xd5, 0xc2, 0xad, 0x4a, 0x1a, 0x71, 0xa0, 0xfe, 0x27, 0x1a, 0xdc, 0x12, 0xb5, 0xc4,
-	0x6f, 0xdc, 0x2d, 0x98, 0x6f, 0x67, 0xa6, 0x6b, 0xf0, 0x97, 0x66, 0x6b, 0xf0, 0x0f, 0x20, 0x2f,
-	0x17, 0x83, 0xaf, 0x6b, 0xdf, 0x83, 0xd0, 0xb2, 0x9b, 0xa1, 0xd2, 0x5c, 0xe4, 0x04, 0x14, 0x7b,
-	0xb1, 0xb6, 0xfe, 0x1f, 0x1a, 0x94, 0x67, 0x39, 0xbc, 0xc6, 0x16, 0x36, 0xd0, 0xad, 0x16, 0x25,
-	0x6e, 0xdc, 0xf9, 0x78, 0x1f, 0xdd, 0xe7, 0x05, 0x44, 0xc3, 0x05, 0xc9, 0xe2, 0x83, 0x70, 0x74,
-	0xa5, 0x19, 0xb9, 0x23, 0x0b, 0xe3, 0xab, 0x77, 0xe3, 0xf1, 0x55, 0x49, 0x65, 0x91, 0x49, 0x43,
-	0x8d, 0x50, 0xfe, 0x4e, 0x83, 0xd5, 0x9a, 0xe7, 0xfa, 0xfe, 0x27, 0x63, 0xea, 0x5d, 0xc9, 0x7d,
-	0x5b, 0x54, 0x8b, 0x1e, 0x73, 0x48, 0x12, 0xd3, 0x0e, 0x49, 0x2c, 0x3b, 0x96, 0xfc, 0xa2, 0xec,
-	0xd8, 0xd2, 0x6c, 0x9d, 0xea, 0xfb, 0xd3, 0x36, 0x7d, 0x4e, 0x1e, 0x23, 0x34, 0xe8, 0xfb, 0x40,
-	0xd4, 0x85, 0x8b, 0xed, 0xf8, 0xff, 0x8a, 0x21, 0xd6, 0x66, 0x6f, 0xc6, 0x9c, 0x8c, 0x18, 0x93,
-	0x28, 0xa3, 0x83, 0x75, 0x26, 0x58, 0xf4, 0x42, 0x14, 0xef, 0x3f, 0x2b, 0x7c, 0xfd, 0x2d, 0x28,
-	0x0d, 0x6d, 0xc7, 0xa4, 0x4e, 0xdf, 0xf5, 0x7c, 0xd7, 0x53, 0xd2, 0x9f, 0xc5, 0xa1, 0xed, 0xd4,
-	0x05, 0xb8, 0x39, 0x1e, 0xea, 0xcf, 0xa1, 0x80, 0xf4, 0x24, 0xec, 0x15, 0x9f, 0x98, 0xdd, 0x82,
-	0xf4, 0x68, 0x7c, 0x62, 0xca, 0x88, 0x28, 0x8b, 0x11, 0x91, 0xb0, 0x7d, 0xe7, 0xae, 0x2f, 0x35,
-	0x14, 0xfe, 0xd6, 0x03, 0xb1, 0x4e, 0xe4, 0x17, 0xd7, 0xf9, 0x01, 0x00, 0xaf, 0xed, 0xc3, 0xca,
-	0x20, 0xe5, 0xd1, 0x32, 0xce, 0x8f, 0x91, 0xed, 0x85, 0xac, 0x3d, 0x82, 0xac, 0x64, 0x41, 0x9e,
-	0xc4, 0xd5, 0x70, 0x84, 0x5c, 0xb1, 0x11, 0xe1, 0xe8, 0xbf, 0xd6, 0xd4, 0x69, 0xd1, 0xf4, 0x3e,
-	0x8a, 0x76, 0x89, 0xcf, 0xb9, 0x16, 0x52, 0x50, 0x0f, 0x51, 0xb8, 0x53, 0x64, 0x47, 0xd9, 0x13,
-	0x7e, 0x24, 0xd7, 0xa7, 0x47, 0xcc, 0x38, 0x48, 0xef, 0xc1, 0x32, 0xaf, 0x34, 0x4e, 0x2e, 0xaa,
-	0x34, 0xe6, 0xfd, 0x7a, 0x1b, 0x0a, 0x72, 0x73, 0xeb, 0x97, 0xd4, 0x09, 0xf8, 0x93, 0xb2, 0xa8,
-	0x75, 0xd4, 0xe4, 0x93, 0xb2, 0x28, 0xf8, 0x94, 0x6f, 0xe5, 0x09, 0xe5, 0xad, 0x7c, 0x8e, 0x53,
-	0xf4, 0xf0, 0xaf, 0x53, 0xb0, 0x32, 0xf5, 0xe9, 0x04, 0xc9, 0x41, 0xba, 0xdd, 0xad, 0xd5, 0xea,
-	0xed, 0x76, 0xe9, 0x2d, 0x52, 0x82, 0x7c, 0xb7, 0x79, 0xd0, 0x6c, 0xbd, 0x30, 0xf9, 0xe7, 0x49,
-	0x1a, 0x21, 0x50, 0xac, 0xb5, 0x9a, 0xcd, 0x7a, 0xad, 0x63, 0x1a, 0xf5, 0xfd, 0x6e, 0xbb, 0x5e,
-	0x4a, 0x90, 0xdb, 0xb0, 0xd6, 0x6c, 0x75, 0xcc, 0x7a, 0xb3, 0xd5, 0x7d, 0xf2, 0xd4, 0x64, 0xce,
-	0xa6, 0x40, 0x4f, 0x12, 0x1d, 0xee, 0xb0, 0xf6, 0xf3, 0x67, 0x66, 0xf5, 0xd0, 0xa8, 0x57, 0xf7,
-	0x3e, 0x35, 0xbb, 0xcd, 0x5a, 0xab, 0xb9, 0xdf, 0x30, 0x9e, 0x09, 0x9c, 0x25, 0x52, 0x81, 0x75,
-	0x81, 0xc3, 0xa8, 0xec, 0xb7, 0xba, 0xcd, 0x3d, 0xd1, 0xb7, 0x4c, 0xee, 0xc1, 0x66, 0xa3, 0x79,
-	0xd4, 0xed, 0x98, 0xad, 0x6e, 0x87, 0xfd, 0xc1, 0x79, 0x3e, 0xe9, 0x56, 0x0f, 0x05, 0x46, 0x8a,
-	0xac, 0x03, 0xe9, 0x1c, 0xcf, 0x8c, 0x4c, 0x93, 0x55, 0x28, 0x74, 0x8e, 0xcd, 0x76, 0xe3, 0x49,
-	0x53, 0x80, 0x32, 0xe4, 0x16, 0xdc, 0xd8, 0x3d, 0x6c, 0xd5, 0x0e, 0x6a, 0x4f, 0xab, 0x8d, 0x26,
-	0x1b, 0xc2, 0xbf, 0xa7, 0xca, 0x32, 0xa6, 0x9e, 0x57, 0x0f, 0x1b, 0x7b, 0xd5, 0x4e, 0x5d, 0x20,
-	0x03, 0xd9, 0x80, 0x5b, 0xb5, 0x6a, 0x93, 0xd1, 0x6d, 0x7f, 0xda, 0xac, 0x99, 0x38, 0x50, 0x74,
-	0xe6, 0x18, 0x25, 0xc9, 0x85, 0xda, 0x91, 0x27, 0x6b, 0xb0, 0x2a, 0x78, 0x39, 0x3a, 0xac, 0x7e,
-	0x2a, 0xc0, 0x05, 0x52, 0x04, 0x78, 0x51, 0x3d, 0x94, 0x68, 0x45, 0x72, 0x03, 0x56, 0x18, 0x65,
-	0x2e, 0x11, 0x0e, 0x5c, 0x61, 0x63, 0x05, 0x31, 0xb6, 0x2c, 0x01, 0x2e, 0x31, 0xf1, 0x18, 0xad,
-	0x56, 0xc7, 0x9c, 0xed, 0x5b, 0x15, 0xcc, 0xef, 0x75, 0x8f, 0x0e, 0x1b, 0xb5, 0x68, 0xf1, 0x37,
-	0xd8, 0x8e, 0xb4, 0xeb, 0xc6, 0xf3, 0x46, 0xad, 0x2e, 0x76, 0x49, 0xca, 0xe5, 0x26, 0x9b, 0xa5,
-	0x73, 0xbc, 0x57, 0xed, 0x54, 0x55, 0xd9, 0xac, 0xb1, 0x9d, 0x66, 0xe2, 0x3a, 0x94, 0x34, 0x6e,
-	0x33, 0x01, 0x74, 0x8e, 0xcd, 0xfd, 0x7a, 0xdd, 0x54, 0x36, 0x97, 0x77, 0x56, 0x18, 0x03, 0xb8,
-	0xcf, 0x0a, 0x8d, 0x4d, 0x72, 0x13, 0x4a, 0x7b, 0x47, 0xad, 0xb6, 0xf9, 0x49, 0xb7, 0x6e, 0x48,
-	0xb6, 0xee, 0x32, 0x59, 0x19, 0x2f, 0xda, 0xf5, 0x8e, 0xd9, 0x68, 0xa2, 0x90, 0x45, 0xc7, 0x7d,
-	0xde, 0x51, 0xad, 0x1d, 0x4e, 0x75, 0xe8, 0xa4, 0x0c, 0x37, 0x9f, 0x54, 0xdb, 0xb3, 0xd3, 0xbe,
-	0x43, 0x36, 0xa1, 0xdc, 0x39, 0x36, 0x9f, 0xd7, 0x8d, 0x76, 0xa3, 0xd5, 0x9c, 0x1a, 0xf7, 0x80,
-	0xdc, 0x87, 0xb7, 0x6b, 0xad, 0x67, 0x47, 0x87, 0x8d, 0x6a, 0xb3, 0x56, 0x37, 0x6b, 0x4f, 0xeb,
-	0xb5, 0x03, 0x24, 0x52, 0x3d, 0x3a, 0x32, 0x5a, 0xcf, 0xeb, 0x7b, 0xa5, 0x6f, 0x30, 0x94, 0x6a,
-	0xad, 0xd6, 0xea, 0x36, 0x3b, 0x66, 0xad, 0xd5, 0xec, 0x18, 0xd5, 0x5a, 0xc7, 0x6c, 0x77, 0xaa,
-	0x9d, 0x6e, 0x5b, 0x50, 0x79, 0x97, 0xc9, 0x8e, 0xcf, 0xd1, 0xd8, 0x67, 0x42, 0x65, 0x13, 0xf1,
-	0xae, 0xad, 0x87, 0x14, 0x56, 0x67, 0xbe, 0x8c, 0x24, 0x79, 0xc8, 0x74, 0x9b, 0x7b, 0xf5, 0xfd,
-	0x46, 0xb3, 0x5e, 0x7a, 0x4b, 0xfd, 0x4e, 0x4f, 0x63, 0x0d, 0x71, 0x4c, 0x4a, 0x09, 0x52, 0x80,
-	0xec, 0x7e, 0xd7, 0xe0, 0x14, 0x4b, 0x49, 0xd6, 0x0c, 0xaf, 0x42, 0x69, 0x89, 0x00, 0xa4, 0xf6,
-	0xab, 0x8d, 0xc3, 0xfa, 0x5e, 0x69, 0xf9, 0xe1, 0x01, 0x40, 0xf4, 0xf1, 0x19, 0xc9, 0xc0, 0x52,
-	0xb3, 0x85, 0xb4, 0x01, 0x52, 0x87, 0xf5, 0xbd, 0x27, 0x75, 0x76, 0x0f, 0xd9, 0xac, 0x9d, 0xe3,
-	0x56, 0xa3, 0xb9, 0xdf, 0x2a, 0x25, 0xd8, 0xf9, 0xe2, 0x5f, 0x0a, 0x62, 0x3b, 0x49, 0xb2, 0xb0,
-	0x7c, 0x54, 0xaf, 0x1b, 0xed, 0xd2, 0xd2, 0xc3, 0xdf, 0x87, 0x62, 0x3c, 0x9d, 0x8a, 0x04, 0xbb,
-	0x87, 0x87, 0xa5, 0xb7, 0xd8, 0xb9, 0xc7, 0x0d, 0xec, 0x3c, 0x35, 0xea, 0xed, 0xa7, 0xad, 0xc3,
-	0xbd, 0x92, 0xc6, 0x48, 0x21, 0xac, 0x7a, 0xd0, 0xae, 0x77, 0xf8, 0xb2, 0xb1, 0x6d, 0x54, 0x3b,
-	0xf5, 0x52, 0x92, 0xcd, 0x8b, 0xcd, 0x76, 0x97, 0xad, 0xba, 0x00, 0xd9, 0x5a, 0xd5, 0x64, 0x47,
-	0xad, 0xce, 0x6e, 0x2b, 0x2a, 0x87, 0x67, 0xcf, 0xba, 0xcd, 0x46, 0xe7, 0x53, 0xf3, 0x79, 0xab,
-	0x53, 0x2f, 0xa5, 0x1e, 0x7e, 0x08, 0x79, 0x35, 0xa7, 0x44, 0xd2, 0x90, 0xac, 0x1d, 0x75, 0x39,
-	0x37, 0xcf, 0xea, 0xcf, 0x5a, 0xc6, 0xa7, 0x25, 0x8d, 0x2d, 0x69, 0xaf, 0xd1, 0x3e, 0x28, 0x25,
-	0xd8, 0xaf, 0xe3, 0xfd, 0x7a, 0xbd, 0x94, 0xdc, 0xf9, 0x2f, 0x02, 0xa9, 0x63, 0x54, 0xe9, 0xa4,
-	0x0b, 0xa5, 0x28, 0x90, 0xdd, 0xbd, 0xc2, 0xc2, 0xfa, 0x82, 0xf4, 0x97, 0x31, 0xa3, 0x5e, 0x99,
-	0x8a, 0x2a, 0x75, 0xfd, 0x97, 0xff, 0xf6, 0xdf, 0x7f, 0x9a, 0xd8, 0xd4, 0x6f, 0x3d, 0xba, 0xfc,
-	0xe0, 0x91, 0x8f, 0x83, 0x4d, 0xfc, 0x2e, 0xe0, 0xe4, 0x0a, 0x8b, 0xf5, 0x1f, 0x6b, 0x0f, 0xc9,
-	0x0f, 0x21, 0x75, 0xe4, 0xfa, 0x41, 0x67, 0x42, 0x62, 0xdf, 0x96, 0x56, 0x56, 0xb8, 0x29, 0x0d,
-	0x3f, 0x3c, 0xd4, 0xd7, 0x91, 0x58, 0x49, 0xcf, 0x31, 0x62, 0x23, 0xd7, 0x0f, 0xcc, 0x60, 0xc2,
-	0x08, 0xec, 0x42, 0x06, 0x15, 0x7b, 0xb5, 0x76, 0xc8, 0xd7, 0x13, 0x26, 0x41, 0x2b, 0xf1, 0xa6,
-	0x5e, 0x46, 0x0a, 0x44, 0x2f, 0x30, 0x0a, 0x3f, 0x63, 0x63, 0x4c, 0xab, 0x37, 0x60, 0x34, 0x4c,
-	0x58, 0x41, 0x1a, 0x4a, 0x58, 0x71, 0x33, 0x1e, 0xaa, 0xf0, 0x60, 0xad, 0x32, 0x17, 0xaa, 0xdf,
-	0x43, 0xc2, 0x15, 0x7d, 0x2d, 0x22, 0x8c, 0x6c, 0x7a, 0x88, 0xc4, 0x26, 0xf8, 0x39, 0xac, 0xe1,
-	0x04, 0x33, 0xbe, 0xf1, 0xc6, 0x5c, 0x5f, 0x9a, 0x1b, 0xb3, 0xca, 0xe6, 0xfc, 0x4e, 0xe1, 0x4c,
-	0xbc, 0x87, 0xb3, 0xde, 0xd7, 0x37, 0xa3, 0x59, 0x63, 0x7e, 0xa7, 0xc9, 0x1c, 0x72, 0x36, 0xf9,
-	0x2f, 0xe0, 0xc6, 0x9c, 0xcc, 0x16, 0xb9, 0x83, 0xc5, 0xfc, 0x0b, 0xf3, 0x6c, 0x95, 0xbb, 0x0b,
-	0xfb, 0xc5, 0x02, 0x1e, 0xe0, 0x02, 0xee, 0xe8, 0xb7, 0xd9, 0x02, 0xce, 0x68, 0x10, 0x7e, 0xdc,
-	0x10, 0xba, 0x90, 0x6c, 0xf6, 0x8f, 0x21, 0x8d, 0xac, 0xcf, 0xec, 0x70, 0xac, 0xa5, 0xdf, 0x42,
-	0x62, 0xab, 0x7a, 0x3e, 0xe2, 0x86, 0xef, 0x6f, 0x13, 0xe0, 0x09, 0x0d, 0xc4, 0xa7, 0x83, 0x64,
-	0x55, 0xf1, 0x65, 0x05, 0x9d, 0x59, 0x90, 0x5e, 0x41, 0x62, 0x37, 0xf5, 0x15, 0xb9, 0x32, 0xf1,
-	0xad, 0x24, 0xa3, 0x67, 0x43, 0x29, 0xa2, 0x27, 0x3f, 0xae, 0x54, 0x48, 0xc4, 0x3e, 0x52, 0xac,
-	0x2c, 0xec, 0xd1, 0xef, 0xe3, 0x1c, 0x1b, 0xfa, 0xfa, 0xd4, 0x1c, 0x66, 0x1f, 0x69, 0xb2, 0xa9,
-	0x7e, 0x8c, 0x53, 0xf1, 0x2f, 0x12, 0xaf, 0xc7, 0xc0, 0x0c, 0x71, 0xf1, 0x89, 0x9f, 0xc2, 0xc7,
-	0xf7, 0x21, 0xc3, 0xf8, 0xc0, 0x44, 0x4a, 0x2e, 0xfc, 0x26, 0xba, 0xb1, 0x57, 0xc9, 0x86, 0x8d,
-	0xf8, 0x89, 0xc7, 0x35, 0x32, 0x30, 0x1b, 0x6d, 0x70, 0x29, 0xb0, 0xe6, 0xee, 0x95, 0x48, 0x92,
-	0xac, 0x84, 0x03, 0x39, 0x40, 0xa5, 0x14, 0xbb, 0xca, 0x21, 0x25, 0x76, 0x91, 0x79, 0xe2, 0x85,
-	0xef, 0xd4, 0x0d, 0x49, 0x13, 0xfd, 0x19, 0xa9, 0x9b, 0xd5, 0xea, 0xd9, 0x4a, 0xac, 0xa5, 0x6f,
-	0x20, 0xd9, 0x35, 0xbd, 0x14, 0x92, 0xed, 0xf1, 0x90, 0x89, 0xd1, 0x6b, 0x40, 0x31, 0x46, 0x4f,
-	0x90, 0x92, 0x9f, 0x16, 0x57, 0xa2, 0xf5, 0xf2, 0x6e, 0xc9, 0x2e, 0x51, 0xa8, 0xf1, 0x5a, 0x6c,
-	0xd2, 0x85, 0x95, 0x27, 0x34, 0xe0, 0x75, 0xb1, 0xea, 0xb2, 0x42, 0x5a, 0xeb, 0xb3, 0x75, 0xb3,
-	0xa8, 0x75, 0x36, 0x91, 0xe4, 0xba, 0xbe, 0x2a, 0x49, 0xfa, 0x57, 0x7e, 0xb4, 0xc2, 0xf7, 0x20,
-	0xfb, 0x84, 0x06, 0x4d, 0x1a, 0x74, 0x8d, 0xc3, 0x29, 0x82, 0x18, 0x9b, 0xf1, 0x42, 0x5b, 0xfd,
-	0x2d, 0x72, 0x00, 0x10, 0x29, 0xcf, 0x2f, 0x52, 0x9b, 0x77, 0x70, 0xce, 0xb2, 0x7e, 0x63, 0x4a,
-	0x6d, 0xfa, 0xe6, 0xe5, 0x0e, 0x9b, 0xf5, 0x73, 0x0d, 0xd6, 0xe6, 0xa6, 0x17, 0x09, 0x7e, 0xef,
-	0xf0, 0xaa, 0x6c, 0x6c, 0xe5, 0xfe, 0x2b, 0x30, 0xc4, 0xb5, 0x8e, 0x6d, 0xf5, 0xc8, 0xa3, 0x74,
-	0x42, 0x7b, 0xa6, 0xb2, 0x0c, 0xb6, 0x84, 0x27, 0x50, 0x8c, 0x97, 0x03, 0x92, 0xdb, 0xb2, 0xce,
-	0x63, 0xa6, 0xee, 0xb0, 0x52, 0x99, 0xd7, 0x25, 0x7c, 0xef, 0xe7, 0x70, 0x63, 0x4e, 0xd9, 0x1c,
-	0xd7, 0x4d, 0x8b, 0x4b, 0x01, 0xb9, 0x6e, 0x7a, 0x55, 0xbd, 0x5d, 0x9b, 0x17, 0xb0, 0xc4, 0x0b,
-	0xd3, 0xc8, 0xdb, 0xb1, 0x61, 0xd3, 0x35, 0x72, 0x95, 0x3b, 0x8b, 0xba, 0x05, 0xd1, 0x1f, 0xc1,
-	0xca, 0x54, 0x9d, 0x17, 0x09, 0x79, 0x9b, 0x2d, 0x56, 0xab, 0x6c, 0xcc, 0xed, 0x13, 0xb4, 0x9e,
-	0xf1, 0xb2, 0x43, 0xb5, 0x20, 0x8a, 0xc4, 0x06, 0x4c, 0x15, 0x74, 0x71, 0x63, 0xb0, 0xb0, 0x86,
-	0x4a, 0x90, 0x53, 0xeb, 0x8e, 0x22, 0x72, 0x73, 0x0a, 0x9f, 0x22, 0x72, 0x73, 0x4b, 0x95, 0xbe,
-	0x17, 0x2b, 0xce, 0x59, 0x9b, 0xaa, 0xe1, 0x11, 0x24, 0xd6, 0xa7, 0xc1, 0x62, 0xb0, 0x85, 0xf7,
-	0x56, 0x98, 0x8d, 0xdd, 0xab, 0xea, 0x01, 0x27, 0x30, 0xf3, 0x52, 0xc5, 0x09, 0xcc, 0xbe, 0x2a,
-	0xc5, 0xed, 0xa9, 0x6a, 0x58, 0x4e, 0xae, 0x4c, 0x0b, 0xd5, 0xd7, 0x25, 0x37, 0x69, 0x53, 0x39,
-	0x0d, 0xce, 0xf1, 0x82, 0x04, 0x11, 0xe7, 0x78, 0x51, 0x1a, 0x64, 0x8e, 0x31, 0xe3, 0x98, 0x71,
-	0x63, 0xd6, 0x84, 0xb4, 0xb8, 0x3c, 0x64, 0xee, 0x1b, 0x40, 0x65, 0x6d, 0x0a, 0x2a, 0xa8, 0xc7,
-	0x9d, 0x17, 0x7e, 0xa7, 0x1e, 0x6b, 0x0f, 0x4f, 0x52, 0xf8, 0x5f, 0xd2, 0x7c, 0xfb, 0xff, 0x02,
-	0x00, 0x00, 0xff, 0xff, 0xd8, 0xc2, 0xbd, 0x8e, 0xd6, 0x46, 0x00, 0x00,
+	// 7187 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x7c, 0x4b, 0x8f, 0x1b, 0x49,
+	0x72, 0xf0, 0x14, 0xdf, 0x0c, 0x3e, 0x9a, 0x9d, 0x52, 0xb7, 0x28, 0xaa, 0x47, 0x8f, 0x9a, 0xd9,
+	0x19, 0x8d, 0xe6, 0x1b, 0x69, 0x47, 0xbb, 0xfb, 0xcd, 0x60, 0x76, 0x77, 0xf6, 0x63, 0xb3, 0x29,
+	0x89, 0xab, 0x16, 0xd9, 0x53, 0x24, 0x25, 0x0d, 0xf6, 0xc3, 0x57, 0x5b, 0x4d, 0x66, 0x77, 0xd7,
+	0x36, 0x59, 0xc5, 0xad, 0x2a, 0xb6, 0xd8, 0xb3, 0x8b, 0xef, 0x9b, 0x6f, 0x61, 0x5f, 0xf6, 0x66,
+	0x1b, 0xb0, 0x7d, 0x58, 0x3f, 0x60, 0xfb, 0x64, 0xf8, 0x01, 0x18, 0x86, 0x0d, 0xc3, 0x80, 0x5f,
+	0x30, 0x7c, 0x31, 0xe0, 0x8b, 0xe1, 0x83, 0x0d, 0xf8, 0xb4, 0xf6, 0x4f, 0xf0, 0x61, 0x6f, 0x46,
+	0xe4, 0xa3, 0x2a, 0x8b, 0x0f, 0x49, 0xbd, 0xd3, 0x33, 0x97, 0x6e, 0x66, 0x44, 0x64, 0x64, 0x46,
+	0x64, 0x66, 0x64, 0x64, 0x64, 0x64, 0x41, 0x71, 0x36, 0x38, 0xb2, 0x6c, 0xe7, 0xf6, 0xc4, 0x73,
+	0x03, 0x97, 0x24, 0x26, 0xfb, 0xb5, 0xad, 0x43, 0xd7, 0x3d, 0x1c, 0xd1, 0x3b, 0xd6, 0xc4, 0xbe,
+	0x63, 0x39, 0x8e, 0x1b, 0x58, 0x81, 0xed, 0x3a, 0x3e, 0xa7, 0xa8, 0x55, 0x18, 0x39, 0x1d, 0xee,
+	0x1f, 0x04, 0x1c, 0xa2, 0x1f, 0x40, 0xe6, 0x01, 0xb5, 0x86, 0xd4, 0x23, 0x17, 0x21, 0x3d, 0x72,
+	0x0f, 0xed, 0x61, 0x55, 0xbb, 0xae, 0xdd, 0xcc, 0x1b, 0xbc, 0x40, 0xae, 0x40, 0xfe, 0xc0, 0x73,
+	0xc7, 0xa6, 0xe3, 0x0e, 0x69, 0x35, 0xc1, 0x30, 0x39, 0x04, 0xb4, 0xdd, 0x21, 0x25, 0x6f, 0x41,
+	0x9a, 0x7a, 0x9e, 0xeb, 0x55, 0x93, 0xd7, 0xb5, 0x9b, 0xe5, 0xbb, 0x17, 0x6e, 0x4f, 0xf6, 0x6f,
+	0x3f, 0x6d, 0x60, 0x13, 0x4d, 0x04, 0x37, 0x9d, 0xe9, 0xd8, 0xe0, 0x14, 0xfa, 0x01, 0x94, 0x7a,
+	0xb3, 0x1d, 0x2b, 0xb0, 0xea, 0x83, 0x81, 0x3b, 0x75, 0x02, 0x52, 0x85, 0xac, 0x35, 0x1c, 0x7a,
+	0xd4, 0xf7, 0x45, 0x83, 0xb2, 0x48, 0x36, 0x21, 0x63, 0x8d, 0x91, 0x46, 0xb4, 0x27, 0x4a, 0xe4,
+	0x35, 0x28, 0x1d, 0x78, 0xee, 0x27, 0xd4, 0x31, 0x8f, 0xa8, 0x7d, 0x78, 0x14, 0xb0, 0x56, 0x93,
+	0x46, 0x91, 0x03, 0x1f, 0x30, 0x98, 0xfe, 0xd3, 0x04, 0x64, 0x78, 0x43, 0x44, 0x87, 0xcc, 0x11,
+	0x13, 0xad, 0x5a, 0xba, 0xae, 0xdd, 0x2c, 0xdc, 0x05, 0xec, 0x1e, 0x17, 0xd6, 0x10, 0x18, 0x42,
+	0x20, 0x15, 0xcc, 0x84, 0xcc, 0x45, 0x83, 0xfd, 0xc6, 0xf6, 0xf7, 0x07, 0x8e, 0x35, 0x96, 0xf2,
+	0x8a, 0x52, 0xa8, 0x0a, 0xec, 0x67, 0x35, 0x19, 0xa9, 0xa2, 0x3e, 0x1c, 0x7a, 0xe4, 0x1a, 0x14,
+	0x18, 0x72, 0x32, 0xdd, 0x3f, 0xa6, 0xa7, 0xd5, 0x14, 0x43, 0x03, 0x82, 0xf6, 0x18, 0x24, 0x24,
+	0xf0, 0x07, 0x1e, 0x12, 0xa4, 0x23, 0x82, 0x2e, 0x83, 0x20, 0xfb, 0xa9, 0x4f, 0x3d, 0xd3, 0xb7,
+	0x0f, 0x9d, 0x6a, 0x99, 0xf5, 0x27, 0x87, 0x80, 0xae, 0x7d, 0xe8, 0x90, 0xb7, 0x21, 0x6b, 0x71,
+	0xc5, 0x55, 0x33, 0xd7, 0x93, 0x37, 0x0b, 0x77, 0xd7, 0x51, 0x98, 0x98, 0x46, 0x0d, 0x49, 0x81,
+	0x23, 0xe9, 0xb8, 0xce, 0x80, 0x56, 0x73, 0x7c, 0x24, 0x59, 0x81, 0x6c, 0x41, 0x3e, 0xb0, 0xc7,
+	0xd4, 0x0f, 0xac, 0xf1, 0xa4, 0x9a, 0x67, 0xaa, 0x8b, 0x00, 0xa8, 0x88, 0x21, 0xf5, 0x07, 0xd5,
+	0x22, 0x57, 0x04, 0xfe, 0xc6, 0x21, 0x3a, 0xa1, 0x9e, 0x6f, 0xbb, 0x4e, 0x75, 0xed, 0xba, 0x76,
+	0x33, 0x6d, 0xc8, 0xa2, 0xfe, 0x0f, 0x1a, 0xe4, 0x7a, 0xb3, 0x6e, 0x60, 0x05, 0x53, 0x5f, 0xd1,
+	0xb3, 0xb6, 0x52, 0xcf, 0xab, 0x74, 0x2a, 0xf5, 0x9f, 0x54, 0xf4, 0xff, 0x0e, 0x64, 0x7c, 0xc6,
+	0x99, 0x69, 0xb1, 0x7c, 0x77, 0x83, 0x89, 0xea, 0x59, 0x8e, 0x6f, 0x0d, 0x70, 0x32, 0xf3, 0x66,
+	0x0d, 0x41, 0x44, 0x6a, 0x90, 0x1b, 0xda, 0x7e, 0x60, 0xa1, 0xc0, 0x69, 0x26, 0x56, 0x58, 0x26,
+	0xd7, 0x20, 0x11, 0xcc, 0xaa, 0x59, 0xd6, 0xad, 0xb5, 0x39, 0x36, 0x46, 0x22, 0x98, 0xe9, 0x6d,
+	0xc8, 0x6d, 0x5b, 0xc1, 0xe0, 0xa8, 0x37, 0x7b, 0x39, 0x39, 0xae, 0x42, 0xb2, 0x37, 0xf3, 0xab,
+	0x09, 0x36, 0x06, 0x45, 0x3e, 0x06, 0xa2, 0x3f, 0x88, 0xd0, 0xff, 0x4b, 0x83, 0xf4, 0xf6, 0xc8,
+	0x1d, 0x1c, 0x7f, 0x26, 0xad, 0x54, 0x21, 0xbb, 0x8f, 0x4c, 0x42, 0xc5, 0xc8, 0x22, 0xb9, 0x3d,
+	0xa7, 0x9b, 0x4d, 0xe4, 0xca, 0x1a, 0xbc, 0xdd, 0x64, 0xff, 0xe6, 0x94, 0xf3, 0x26, 0xa4, 0x59,
+	0x55, 0xa6, 0x19, 0x31, 0x6b, 0x5a, 0x4e, 0x40, 0x3d, 0xc7, 0x1a, 0x31, 0x7a, 0x83, 0xe3, 0xf5,
+	0x6f, 0x42, 0x51, 0x65, 0x40, 0xf2, 0x90, 0x6e, 0x1a, 0x46, 0xc7, 0xa8, 0xbc, 0x82, 0x3f, 0x7b,
+	0x46, 0xbf, 0xfd, 0xb0, 0xa2, 0x11, 0x80, 0xcc, 0xb6, 0x51, 0x6f, 0x37, 0x1e, 0x54, 0x12, 0xa4,
+	0x00, 0xd9, 0x76, 0xa7, 0xf9, 0xb4, 0xd5, 0xed, 0x55, 0x92, 0xfa, 0x8f, 0x34, 0xc8, 0xb2, 0xea,
+	0xad, 0x1d, 0x45, 0xf2, 0xd4, 0x4b, 0x48, 0xae, 0xad, 0x92, 0x3c, 0x11, 0x97, 0xfc, 0x06, 0x14,
+	0x1d, 0x4a, 0x87, 0xe6, 0xc0, 0x75, 0x02, 0xea, 0xf0, 0xc5, 0x9f, 0x33, 0x0a, 0x08, 0x6b, 0x70,
+	0x90, 0x6e, 0x41, 0x81, 0xf5, 0x81, 0x9b, 0x02, 0xa5, 0x1f, 0xc9, 0x33, 0xf7, 0x63, 0x13, 0xeb,
+	0x32, 0x23, 0x93, 0x60, 0x53, 0x4a, 0x94, 0xf4, 0x77, 0xa1, 0xd0, 0x70, 0xc7, 0x63, 0xd7, 0x31,
+	0xe8, 0x64, 0x74, 0xfa, 0x32, 0x83, 0xac, 0x9b, 0x90, 0xe3, 0x55, 0x5a, 0xce, 0x4b, 0x4d, 0x8a,
+	0x3b, 0x50, 0x38, 0xb1, 0xe9, 0x33, 0xd3, 0x9d, 0xe0, 0x2c, 0x65, 0xed, 0x97, 0xef, 0x96, 0x91,
+	0xf0, 0xb1, 0x4d, 0x9f, 0x75, 0x18, 0xd4, 0x80, 0x93, 0xf0, 0xb7, 0xfe, 0x3d, 0x28, 0xf4, 0xdc,
+	0x63, 0xea, 0xec, 0xd0, 0xc0, 0xb2, 0x47, 0xcf, 0x55, 0xad, 0x35, 0x62, 0xcb, 0x84, 0xcf, 0x36,
+	0x59, 0x3c, 0x8b, 0x19, 0xff, 0x1b, 0x0d, 0x4a, 0x75, 0x6e, 0xa7, 0xcf, 0xb0, 0xfa, 0x15, 0x5b,
+	0x9f, 0x88, 0xdb, 0xfa, 0x1b, 0x90, 0xdc, 0x1f, 0xf8, 0xd5, 0xe4, 0xf5, 0x64, 0xb8, 0x42, 0x23,
+	0x51, 0x0c, 0xc4, 0x29, 0x43, 0x91, 0x52, 0x87, 0x42, 0x9d, 0x2a, 0xe9, 0xf8, 0x54, 0xd9, 0x82,
+	0xfc, 0x91, 0xe5, 0xf3, 0x59, 0x50, 0xcd, 0xb0, 0x79, 0x12, 0x01, 0xf4, 0x16, 0xac, 0xb3, 0x36,
+	0xee, 0xb1, 0x6d, 0x43, 0x28, 0x4d, 0x51, 0x8e, 0x16, 0x57, 0x4e, 0x0d, 0x72, 0xb6, 0xcf, 0x69,
+	0x59, 0xe7, 0x73, 0x46, 0x58, 0xd6, 0x3f, 0xd5, 0x80, 0x2c, 0xf0, 0xf2, 0x57, 0x8e, 0xc0, 0x9b,
+	0x90, 0x0c, 0x0e, 0x86, 0xc2, 0x78, 0x6c, 0x84, 0xc2, 0xaa, 0x95, 0x0d, 0xa4, 0x38, 0xcb, 0x80,
+	0x7c, 0xaa, 0xc1, 0x45, 0x31, 0x20, 0xdb, 0xbc, 0xc7, 0xe7, 0x32, 0x2e, 0xb7, 0x20, 0x15, 0x1c,
+	0x0c, 0xe5, 0xc0, 0x6c, 0x2e, 0xed, 0xab, 0x6f, 0x30, 0x1a, 0xfd, 0x37, 0x34, 0xc8, 0xf6, 0x66,
+	0x2d, 0x67, 0x32, 0x0d, 0xc8, 0x65, 0xc8, 0x79, 0xf4, 0xc0, 0x54, 0xf6, 0xd4, 0xac, 0x47, 0x0f,
+	0x7a, 0x68, 0xd6, 0x5f, 0x05, 0x40, 0x94, 0x7b, 0x70, 0xe0, 0x53, 0xbe, 0xac, 0xd2, 0x46, 0xde,
+	0xa3, 0x07, 0x1d, 0x06, 0x88, 0xef, 0xae, 0x7c, 0x40, 0xa3, 0xdd, 0x35, 0x72, 0x09, 0x32, 0x0c,
+	0xb3, 0xd2, 0x25, 0xc8, 0x2e, 0x71, 0x09, 0xbe, 0x8b, 0x7b, 0x55, 0x67, 0x1a, 0x60, 0xff, 0x22,
+	0x46, 0x5a, 0x8c, 0xd1, 0x25, 0xc8, 0x06, 0x2e, 0x6f, 0x9b, 0xdb, 0x9d, 0x4c, 0xe0, 0xb2, 0x96,
+	0x17, 0x5a, 0x48, 0x2d, 0x69, 0xa1, 0x03, 0xe5, 0xa7, 0xd3, 0x09, 0xdf, 0xaa, 0xad, 0x60, 0xea,
+	0xe1, 0xc6, 0x53, 0x98, 0x4c, 0xf7, 0x47, 0xf6, 0xc0, 0x3c, 0xa6, 0xa7, 0xe8, 0xe1, 0x24, 0x6f,
+	0x16, 0x0d, 0xe0, 0xa0, 0x87, 0xf4, 0xd4, 0xc7, 0x39, 0xea, 0x4b, 0x6a, 0xd1, 0x64, 0x04, 0xd0,
+	0xff, 0x29, 0x03, 0x05, 0x65, 0xab, 0x5a, 0xea, 0xa6, 0xac, 0x36, 0x95, 0x37, 0x21, 0x1f, 0xcc,
+	0x4c, 0x1b, 0x07, 0x44, 0x8e, 0x60, 0x81, 0x6f, 0x55, 0x6c, 0x90, 0x8c, 0x5c, 0xc0, 0x7f, 0xf8,
+	0xe4, 0x6d, 0x80, 0x60, 0x66, 0xba, 0x4c, 0x37, 0xb8, 0xa5, 0x28, 0xbb, 0x1a, 0x57, 0x98, 0x91,
+	0x0f, 0xc4, 0x2f, 0x3f, 0x74, 0x11, 0x32, 0x8a, 0x8b, 0x50, 0x83, 0xdc, 0xc0, 0xb5, 0x9d, 0x7d,
+	0xcb, 0xa7, 0x4c, 0xf7, 0x39, 0x23, 0x2c, 0xff, 0x5c, 0x6e, 0x88, 0xe2, 0x72, 0x40, 0xcc, 0xe5,
+	0x40, 0x8c, 0x35, 0x0d, 0xdc, 0x43, 0xea, 0x54, 0x0b, 0xac, 0x21, 0x59, 0x24, 0x77, 0xa1, 0x14,
+	0x8a, 0x6b, 0xd2, 0x59, 0x50, 0xbd, 0xc4, 0xe4, 0x28, 0x2b, 0x22, 0x37, 0x67, 0x81, 0x51, 0x90,
+	0x52, 0x37, 0x67, 0x01, 0xf9, 0x1a, 0x94, 0x23, 0xc1, 0x59, 0xa5, 0xaa, 0x62, 0x82, 0x84, 0xc8,
+	0x58, 0xab, 0x18, 0xca, 0x8f, 0xd5, 0x3e, 0x84, 0x75, 0xdc, 0x7f, 0x3c, 0x6b, 0x10, 0x98, 0x1e,
+	0xfd, 0xfe, 0x94, 0xfa, 0x81, 0x5f, 0xbd, 0x1c, 0x39, 0x64, 0x2d, 0xe7, 0xc4, 0x3d, 0xa6, 0x06,
+	0xc7, 0x18, 0x15, 0x49, 0x2b, 0x00, 0x6c, 0xd4, 0x6d, 0xc7, 0x0e, 0x6c, 0x2b, 0x70, 0xbd, 0x6a,
+	0x8d, 0xa9, 0x25, 0x02, 0xe0, 0x16, 0x67, 0x4d, 0x83, 0x23, 0xc6, 0xd9, 0xf6, 0x68, 0xf5, 0xca,
+	0xf5, 0xe4, 0xcd, 0xbc, 0x51, 0x40, 0x98, 0xc1, 0x41, 0xe4, 0x03, 0x58, 0x0b, 0xe9, 0x99, 0xa7,
+	0xe8, 0x57, 0xb7, 0xa2, 0xe6, 0xc3, 0xf9, 0xd7, 0x72, 0x0e, 0x5c, 0xa3, 0x1c, 0x52, 0x22, 0xdc,
+	0x27, 0xdf, 0x02, 0xa2, 0xb2, 0x17, 0xd5, 0x5f, 0x5d, 0x55, 0xbd, 0xa2, 0xb4, 0xcb, 0x19, 0xbc,
+	0x03, 0xc4, 0xa3, 0x03, 0x6a, 0x9f, 0xd0, 0xa1, 0x19, 0x8d, 0xe1, 0x55, 0x36, 0x86, 0xeb, 0x12,
+	0xd3, 0x0b, 0xc7, 0xf2, 0x5d, 0x80, 0x19, 0xae, 0x0a, 0xd6, 0x50, 0xf5, 0x1a, 0xb3, 0x42, 0x84,
+	0x99, 0xb2, 0xd8, 0x5a, 0x31, 0xf2, 0x33, 0x59, 0x26, 0x77, 0xa1, 0x38, 0x76, 0x87, 0xf6, 0xc1,
+	0xa9, 0xc9, 0xbd, 0x96, 0xeb, 0x91, 0xe7, 0xf6, 0x88, 0xc1, 0xb9, 0xcf, 0x52, 0x18, 0x47, 0x05,
+	0xf2, 0x1a, 0x64, 0x1f, 0xec, 0x98, 0xb6, 0x73, 0xe0, 0x56, 0x6f, 0x28, 0x96, 0x6e, 0x87, 0x09,
+	0x91, 0xe1, 0xff, 0x75, 0x1f, 0x60, 0x97, 0x0e, 0x0f, 0xa9, 0xf7, 0x88, 0x06, 0x16, 0x2a, 0xda,
+	0x73, 0xdd, 0xc0, 0x94, 0xeb, 0x87, 0x2f, 0xab, 0x02, 0xc2, 0xb6, 0x39, 0x08, 0x17, 0x70, 0x60,
+	0x4f, 0xcc, 0xf8, 0x0a, 0x83, 0xc0, 0x9e, 0x6c, 0x47, 0xfe, 0x48, 0xe0, 0x4d, 0x9d, 0xe3, 0xf8,
+	0x61, 0xa4, 0xc0, 0x60, 0xc2, 0x2c, 0xfc, 0x38, 0x0d, 0xb9, 0x7e, 0x30, 0x73, 0x59, 0x9b, 0x5f,
+	0x82, 0xf2, 0xc8, 0x0a, 0xa8, 0x3f, 0xdf, 0x6a, 0x89, 0x43, 0x25, 0x5b, 0x1d, 0x4a, 0xf8, 0x0b,
+	0xcd, 0x86, 0x39, 0xb2, 0xfd, 0x80, 0xed, 0x16, 0x79, 0xa3, 0x80, 0xc0, 0x87, 0xf4, 0x74, 0xd7,
+	0xf6, 0x03, 0xb4, 0xa4, 0xd3, 0x60, 0xe6, 0x9a, 0x81, 0x1b, 0x58, 0x23, 0x71, 0x12, 0xc9, 0x23,
+	0xa4, 0x87, 0x00, 0x5c, 0x93, 0xd6, 0xc9, 0xe1, 0x0e, 0x1d, 0x59, 0xa7, 0xc2, 0x5a, 0x85, 0x65,
+	0xf2, 0x3f, 0x60, 0x7d, 0xea, 0x0c, 0x5c, 0xe7, 0xc0, 0xf6, 0xc6, 0xbd, 0x59, 0x9d, 0x9b, 0x42,
+	0xee, 0x35, 0x2f, 0x22, 0xc8, 0xeb, 0x50, 0x1e, 0x5b, 0x33, 0xde, 0x61, 0xd3, 0xb7, 0x3f, 0xa1,
+	0x6c, 0xed, 0x27, 0x8d, 0xe2, 0xd8, 0x9a, 0x71, 0x67, 0xd1, 0xfe, 0x84, 0x92, 0xff, 0x85, 0xd3,
+	0xc2, 0xa7, 0xde, 0x89, 0xf0, 0xce, 0x70, 0xc6, 0xfb, 0xd5, 0xec, 0xaa, 0x55, 0xb1, 0x2e, 0x89,
+	0x1b, 0x92, 0x16, 0x39, 0x1c, 0xb8, 0xde, 0xbe, 0x3d, 0x1c, 0x52, 0x27, 0x64, 0xc1, 0xcc, 0xc6,
+	0x72, 0x0e, 0x21, 0xb1, 0x64, 0x41, 0xbe, 0x09, 0x57, 0x1c, 0xfa, 0xcc, 0x14, 0x27, 0x20, 0xd3,
+	0xa3, 0xbe, 0x3b, 0xf5, 0x06, 0xd4, 0x14, 0xc6, 0x9e, 0xdb, 0x99, 0xaa, 0x43, 0x9f, 0xc9, 0xc3,
+	0x92, 0x20, 0x10, 0x82, 0xbe, 0x0f, 0x97, 0x6c, 0xcf, 0xa3, 0xcc, 0xd6, 0xec, 0x8f, 0xa8, 0xe2,
+	0x45, 0x32, 0x33, 0x94, 0x34, 0x56, 0xa1, 0xe7, 0x6b, 0x76, 0x47, 0xf6, 0x90, 0x3e, 0xb1, 0x9d,
+	0xa1, 0xfb, 0xac, 0x5a, 0x58, 0xac, 0xa9, 0xa0, 0xc9, 0x4d, 0xc8, 0x1d, 0x5a, 0xfe, 0x9e, 0x67,
+	0x0f, 0x28, 0x3b, 0x75, 0x09, 0xcb, 0x7b, 0x5f, 0xc0, 0x8c, 0x10, 0x4b, 0x1a, 0x70, 0xf1, 0xd0,
+	0x73, 0xa7, 0x13, 0x93, 0x9d, 0xde, 0x23, 0x05, 0x95, 0x56, 0x29, 0x88, 0x30, 0x72, 0xe6, 0x30,
+	0x48, 0x0d, 0xe9, 0x9f, 0x40, 0x4e, 0xb2, 0xc6, 0x5d, 0x7a, 0x30, 0x99, 0x9a, 0x9e, 0x15, 0x70,
+	0x17, 0x25, 0x69, 0x64, 0x07, 0x93, 0xa9, 0x61, 0x05, 0x0c, 0x35, 0xa6, 0x63, 0x8e, 0xe2, 0xae,
+	0x6f, 0x76, 0x4c, 0xc7, 0x0c, 0x75, 0x05, 0xf2, 0x43, 0xdb, 0x3f, 0xe6, 0xb8, 0x64, 0x78, 0xd2,
+	0x3a, 0x96, 0xc8, 0xd9, 0x01, 0xa5, 0x1c, 0x29, 0x66, 0x1d, 0x02, 0x10, 0xa9, 0xff, 0x6d, 0x1a,
+	0x4a, 0xb1, 0x53, 0x87, 0x6a, 0xe7, 0xb5, 0xb8, 0x9d, 0x0f, 0x77, 0x0d, 0xee, 0x21, 0xf0, 0xc2,
+	0x73, 0x4e, 0x44, 0x97, 0x21, 0x37, 0xf1, 0xa8, 0x79, 0x64, 0xf9, 0x47, 0xac, 0xdd, 0xa2, 0x91,
+	0x9d, 0x78, 0xf4, 0x81, 0xe5, 0x1f, 0xe1, 0x42, 0x98, 0x78, 0xee, 0xc4, 0xf5, 0x69, 0xe8, 0x51,
+	0xc8, 0x32, 0x6e, 0x66, 0xcc, 0x2c, 0x89, 0xcd, 0x0c, 0x7f, 0xa3, 0x73, 0x20, 0x8e, 0xef, 0x59,
+	0x06, 0x15, 0x25, 0xb4, 0x05, 0x63, 0xea, 0x1d, 0x8f, 0xa8, 0x89, 0x16, 0x82, 0xcd, 0xcb, 0xa2,
+	0x01, 0x1c, 0x64, 0xb8, 0x6e, 0xa0, 0xb8, 0xa8, 0xf9, 0x98, 0x8b, 0x1a, 0xdb, 0xeb, 0x60, 0x7e,
+	0xaf, 0xfb, 0x0a, 0x5a, 0x90, 0x70, 0x8f, 0xf7, 0xab, 0x05, 0x65, 0x07, 0x8a, 0xe0, 0x46, 0x8c,
+	0x08, 0xc5, 0x0d, 0x66, 0x26, 0x8f, 0x04, 0x14, 0xb9, 0xe6, 0x82, 0x59, 0x03, 0x8b, 0x4a, 0x37,
+	0x03, 0x8f, 0xd2, 0x6a, 0x89, 0xfb, 0x1c, 0x1c, 0xd4, 0xf3, 0x28, 0x53, 0xe2, 0x60, 0xea, 0xf5,
+	0xa8, 0x37, 0xae, 0x56, 0xc4, 0xa8, 0xf3, 0x22, 0xb9, 0x0e, 0x85, 0xc1, 0xd4, 0x63, 0x43, 0xd3,
+	0x9e, 0x8e, 0xab, 0xeb, 0xdc, 0x96, 0x29, 0x20, 0xf2, 0x2d, 0x80, 0x03, 0xcb, 0x1e, 0xa1, 0xe5,
+	0x9f, 0xf9, 0x55, 0xc2, 0xba, 0x7a, 0x7d, 0xe1, 0x34, 0x79, 0xfb, 0x1e, 0xa3, 0xe9, 0xcd, 0xfc,
+	0xa6, 0x13, 0x78, 0xa7, 0x46, 0xfe, 0x40, 0x96, 0xc9, 0x55, 0x80, 0xc0, 0xf2, 0x0e, 0x69, 0xb0,
+	0x6d, 0x07, 0x7e, 0xf5, 0x02, 0xeb, 0xba, 0x02, 0x21, 0x37, 0x21, 0xfb, 0xed, 0xa9, 0x1f, 0xd8,
+	0x07, 0xa7, 0xd5, 0x8b, 0xd7, 0x35, 0xb9, 0x7f, 0x7f, 0x34, 0x75, 0xbd, 0xe9, 0xb8, 0x41, 0xbd,
+	0xc0, 0x90, 0x68, 0x54, 0x81, 0xed, 0x98, 0xcc, 0xd0, 0xb2, 0x38, 0x49, 0xce, 0xc8, 0xda, 0x4e,
+	0x0f, 0x8b, 0x38, 0x0b, 0x1d, 0x3a, 0x0b, 0xf8, 0x6c, 0x58, 0xe3, 0x43, 0x8e, 0x00, 0x9c, 0x0e,
+	0xb5, 0x6f, 0x40, 0x39, 0xde, 0x3d, 0x52, 0x81, 0x24, 0x8e, 0x36, 0xf7, 0xd2, 0xf1, 0x27, 0xce,
+	0xbe, 0x13, 0x6b, 0x34, 0x95, 0x47, 0x24, 0x5e, 0xf8, 0x20, 0xf1, 0xbe, 0xa6, 0xff, 0x54, 0x83,
+	0xdc, 0x76, 0xe3, 0x1c, 0x42, 0x1e, 0x3a, 0xa4, 0xc6, 0x34, 0xb0, 0xaa, 0xc9, 0x48, 0xca, 0x68,
+	0x6b, 0x32, 0x18, 0x2e, 0x3a, 0xb6, 0xa7, 0x9e, 0x7f, 0x6c, 0x47, 0x23, 0x32, 0x15, 0x3b, 0x4c,
+	0x35, 0x1d, 0x19, 0x11, 0xb9, 0xeb, 0x18, 0x21, 0x96, 0xbc, 0x0e, 0xa5, 0x7d, 0xcf, 0x72, 0x06,
+	0x47, 0x62, 0xa7, 0x61, 0x71, 0xa4, 0xbc, 0x11, 0x07, 0xea, 0x5d, 0x28, 0x6c, 0x37, 0x7a, 0xf6,
+	0xe4, 0x0c, 0x72, 0x5e, 0x87, 0xa2, 0xed, 0xf3, 0xe1, 0x30, 0x03, 0x7b, 0x22, 0x0e, 0x49, 0x60,
+	0xfb, 0x6c, 0x48, 0x7a, 0xf6, 0x84, 0x31, 0x45, 0xfe, 0xcc, 0x20, 0xbd, 0x2c, 0xd3, 0x02, 0x13,
+	0x90, 0x59, 0x3c, 0x5f, 0x6e, 0x82, 0x0a, 0x48, 0xff, 0x34, 0x01, 0x99, 0xee, 0x84, 0xd2, 0xa1,
+	0x4f, 0xde, 0x83, 0x7c, 0x77, 0x3a, 0xe6, 0x05, 0xe6, 0x6a, 0x17, 0xee, 0x5e, 0x66, 0xfe, 0x0c,
+	0x83, 0xdc, 0x0e, 0x71, 0x62, 0x4e, 0x86, 0x65, 0xf2, 0x55, 0xc8, 0x6d, 0x0f, 0x44, 0x3d, 0x7e,
+	0x2a, 0xab, 0x2a, 0xf5, 0xb6, 0x07, 0x6a, 0xb5, 0x90, 0x12, 0xe7, 0x51, 0x9c, 0xe5, 0x8b, 0xe6,
+	0x91, 0xa6, 0xcc, 0xa3, 0x5a, 0x0b, 0x4a, 0xdb, 0x83, 0xe7, 0x57, 0xd6, 0xd5, 0xca, 0x62, 0x44,
+	0xb7, 0x1b, 0xbc, 0x8e, 0x3a, 0x25, 0x7f, 0x00, 0x39, 0x09, 0x26, 0x5f, 0x81, 0xac, 0x60, 0xab,
+	0x6a, 0x60, 0xbb, 0x11, 0x97, 0x85, 0x8b, 0x22, 0x29, 0x6b, 0x1f, 0x40, 0x51, 0x45, 0x9c, 0x45,
+	0x0e, 0xfd, 0xb7, 0x35, 0x28, 0x75, 0x4f, 0xfd, 0x80, 0x8e, 0xcf, 0x12, 0x09, 0x78, 0x1b, 0x60,
+	0x7f, 0xe0, 0x9b, 0x22, 0x86, 0xa5, 0x84, 0xd1, 0xe4, 0xd2, 0x32, 0xf2, 0xfb, 0x03, 0x85, 0xa1,
+	0xcf, 0x07, 0x47, 0x09, 0xe0, 0x08, 0x35, 0x08, 0x0c, 0xb3, 0xf1, 0x94, 0x7a, 0x7d, 0x6f, 0xc4,
+	0xcf, 0x2f, 0x79, 0x23, 0x2c, 0xeb, 0x1e, 0x90, 0x58, 0x0f, 0x5f, 0x3a, 0x66, 0x43, 0xde, 0x87,
+	0xb2, 0xcf, 0x6b, 0x46, 0x5d, 0x0d, 0x17, 0x62, 0x9c, 0x67, 0xc9, 0x57, 0x8b, 0xfa, 0x0e, 0x64,
+	0x0c, 0xeb, 0x59, 0xdf, 0x1b, 0xbd, 0xac, 0x8d, 0xf0, 0x18, 0xb5, 0xb4, 0x11, 0xbc, 0xa4, 0xff,
+	0x58, 0x83, 0x14, 0xae, 0xe1, 0x95, 0xe7, 0xd5, 0x4d, 0x10, 0x07, 0xd4, 0xb9, 0xe3, 0x6a, 0x0d,
+	0x72, 0x81, 0xcb, 0x23, 0xce, 0x62, 0xa3, 0x0c, 0xcb, 0x68, 0xfe, 0xc5, 0x59, 0x5c, 0x6e, 0x94,
+	0xa2, 0x88, 0xfb, 0x54, 0x78, 0x10, 0xaf, 0xa6, 0xe7, 0x4e, 0xe6, 0xfa, 0xbf, 0x68, 0x90, 0xc7,
+	0xce, 0xf0, 0x13, 0xfe, 0x67, 0x8c, 0x6b, 0xca, 0x78, 0x43, 0x32, 0x1e, 0x6f, 0xd8, 0x82, 0x3c,
+	0x3f, 0x1c, 0x47, 0xc1, 0xf3, 0x08, 0x80, 0x58, 0xe6, 0xeb, 0xb6, 0x71, 0x7a, 0xf3, 0xc8, 0x79,
+	0x04, 0x40, 0x99, 0x65, 0x9c, 0x5c, 0x6c, 0xdc, 0x61, 0x19, 0x71, 0x0e, 0xa5, 0xc3, 0x5d, 0xb4,
+	0xa5, 0x39, 0x7e, 0x3e, 0x95, 0x65, 0xfd, 0x87, 0x00, 0x28, 0x96, 0x88, 0x0c, 0xbc, 0x8c, 0x5c,
+	0xaf, 0x73, 0x6b, 0xbb, 0x2b, 0xfd, 0xf2, 0xc2, 0xdd, 0x9c, 0xb4, 0xb6, 0x46, 0x88, 0x41, 0x4b,
+	0xcb, 0x3a, 0xd7, 0xa5, 0x23, 0x3a, 0x08, 0xe8, 0x50, 0xc8, 0x1a, 0x07, 0xea, 0xbf, 0xa3, 0x41,
+	0xb9, 0x6d, 0x05, 0xf6, 0x09, 0x6d, 0xb8, 0x43, 0xba, 0x83, 0x87, 0x69, 0x02, 0x29, 0x25, 0x6a,
+	0x94, 0x92, 0x2a, 0x93, 0x8e, 0x92, 0x08, 0xd1, 0x88, 0x22, 0x2a, 0x79, 0x68, 0x1f, 0x52, 0x3f,
+	0x10, 0x03, 0x2d, 0x4a, 0x68, 0x3a, 0x27, 0x1e, 0x3d, 0x79, 0x2c, 0x6a, 0x71, 0x65, 0xaa, 0x20,
+	0x72, 0x13, 0xd6, 0xd8, 0x91, 0xab, 0x3e, 0xb1, 0x25, 0x15, 0x1f, 0xf4, 0x79, 0x30, 0x76, 0xb2,
+	0xf8, 0xc4, 0xf2, 0xc7, 0x61, 0x17, 0x71, 0x0e, 0x4d, 0x9d, 0xc0, 0x0e, 0x7b, 0x29, 0x8b, 0x3c,
+	0x12, 0x30, 0x9e, 0xd8, 0x23, 0xea, 0xc9, 0x7b, 0x22, 0x59, 0x5e, 0xd9, 0xd5, 0x6b, 0x50, 0x38,
+	0x19, 0x9b, 0x61, 0x35, 0xde, 0x55, 0x38, 0x19, 0x37, 0x64, 0xc5, 0xd7, 0xa0, 0x14, 0x9e, 0xb7,
+	0x83, 0xd3, 0x09, 0x15, 0x83, 0x5f, 0x94, 0xc0, 0xde, 0xe9, 0x84, 0xea, 0x23, 0xa8, 0x44, 0x8a,
+	0x14, 0xa6, 0xe3, 0x0d, 0x11, 0xab, 0xd0, 0xa2, 0x53, 0x67, 0x5c, 0xd9, 0x22, 0x7e, 0xb1, 0x19,
+	0xc6, 0xd3, 0xb9, 0xbb, 0x29, 0x4a, 0x28, 0xe7, 0x11, 0xb5, 0x46, 0xc1, 0xd1, 0xa9, 0x08, 0x34,
+	0xcb, 0xa2, 0xde, 0x85, 0x8d, 0x9d, 0x89, 0xeb, 0x37, 0x2c, 0x67, 0x68, 0x0f, 0xf1, 0xe8, 0x26,
+	0x9c, 0xee, 0xcf, 0xb2, 0x30, 0xf4, 0x21, 0x6c, 0xce, 0x33, 0xf5, 0x27, 0xae, 0xe3, 0xd3, 0x97,
+	0xe2, 0xfa, 0x06, 0x94, 0x07, 0x61, 0x4d, 0x3c, 0xee, 0x8a, 0xfd, 0x72, 0x0e, 0xaa, 0x7b, 0x50,
+	0xc3, 0x56, 0xda, 0xee, 0xd8, 0x76, 0xac, 0x80, 0x1a, 0x74, 0xe0, 0x7a, 0xc3, 0xf3, 0xe8, 0xff,
+	0xea, 0x85, 0xad, 0xef, 0x40, 0x45, 0x6d, 0x13, 0xfb, 0x81, 0xcb, 0x39, 0xec, 0x99, 0x98, 0x46,
+	0x11, 0x20, 0x8c, 0x75, 0xf1, 0x16, 0xd8, 0x6f, 0xfd, 0xff, 0x6b, 0x70, 0x65, 0x69, 0xd7, 0xcf,
+	0xa0, 0xa5, 0x0f, 0x61, 0xcd, 0x89, 0x57, 0x17, 0x6b, 0xf8, 0x22, 0x12, 0xcf, 0x77, 0xd2, 0x98,
+	0x27, 0xd6, 0xbf, 0x0f, 0x97, 0x43, 0x22, 0xfa, 0xc5, 0x28, 0xaf, 0x07, 0xb5, 0x65, 0x4d, 0x9e,
+	0x41, 0xe8, 0x65, 0xca, 0x74, 0xf8, 0x64, 0x7b, 0xec, 0x7e, 0x41, 0x53, 0xe0, 0x43, 0x80, 0x93,
+	0xb0, 0xad, 0x9f, 0x63, 0xf0, 0x9f, 0xc1, 0xa5, 0x85, 0xfe, 0x9e, 0x41, 0x05, 0xef, 0xc3, 0x1a,
+	0x36, 0x8f, 0x1b, 0x5d, 0x7c, 0xdc, 0x99, 0xeb, 0x1d, 0xf5, 0xcc, 0x98, 0x27, 0xd3, 0xdd, 0xa8,
+	0xe1, 0xe1, 0x17, 0xa2, 0xa9, 0xf7, 0xa0, 0x70, 0x12, 0x35, 0xc6, 0x9c, 0x2f, 0x37, 0x10, 0x6d,
+	0xe4, 0x0d, 0x5e, 0x58, 0xaa, 0xa2, 0x1f, 0x40, 0x75, 0xb1, 0xa7, 0x67, 0xd0, 0xd1, 0xd7, 0xa1,
+	0xc2, 0x1a, 0x5e, 0x54, 0xd2, 0x9a, 0x54, 0x92, 0x80, 0x1b, 0x0b, 0x84, 0xba, 0xcd, 0xd5, 0xd4,
+	0x38, 0xa2, 0x83, 0x63, 0x83, 0xfa, 0xd3, 0x51, 0x70, 0x2e, 0x6a, 0x42, 0x39, 0xf1, 0xa8, 0xca,
+	0x23, 0x0d, 0xec, 0xb7, 0x1e, 0x40, 0x75, 0xb1, 0xa9, 0x33, 0x2e, 0x07, 0xe4, 0x99, 0x88, 0x78,
+	0xb2, 0xb3, 0x6f, 0xc4, 0x8f, 0xc5, 0xcb, 0xf3, 0x86, 0x0a, 0xd2, 0x3b, 0xb0, 0x8e, 0xad, 0x4a,
+	0x27, 0xf2, 0xb3, 0x9b, 0xfb, 0xef, 0x02, 0x51, 0x19, 0x9e, 0xc9, 0xd4, 0x67, 0x62, 0x0e, 0x69,
+	0x59, 0xda, 0xae, 0xf8, 0xbd, 0xaf, 0xfe, 0x9b, 0x1a, 0x40, 0x04, 0x0e, 0xe5, 0xd6, 0x14, 0xb9,
+	0xaf, 0x40, 0x9e, 0x07, 0xf6, 0x9c, 0xa9, 0x54, 0x48, 0x6e, 0x5f, 0x1e, 0xf7, 0xd5, 0xd0, 0x89,
+	0x48, 0x75, 0x90, 0x65, 0x8c, 0x7c, 0xca, 0xdf, 0xac, 0x2e, 0x8f, 0xf6, 0x14, 0x24, 0xac, 0x3d,
+	0x5d, 0xd0, 0x69, 0x7a, 0x51, 0xa7, 0x7f, 0xa5, 0x41, 0x45, 0x04, 0xad, 0xf6, 0x1a, 0xe7, 0x31,
+	0x5d, 0xde, 0xc1, 0x9b, 0x27, 0x11, 0x91, 0x4f, 0xae, 0x8a, 0x3d, 0x86, 0x24, 0xf1, 0x48, 0x7c,
+	0xea, 0x45, 0x91, 0xf8, 0xf4, 0x42, 0x24, 0x5e, 0xff, 0x7f, 0xb0, 0xae, 0xf4, 0xff, 0x0c, 0x43,
+	0xb8, 0x4a, 0x80, 0xdb, 0x28, 0x00, 0xe7, 0x53, 0x4d, 0x46, 0x6e, 0x8b, 0x14, 0x80, 0x63, 0x8c,
+	0x90, 0x46, 0xff, 0xd3, 0x04, 0x94, 0x24, 0x92, 0xab, 0x0f, 0x03, 0x40, 0xee, 0x70, 0x3a, 0xa2,
+	0xa6, 0xe2, 0x46, 0x02, 0x07, 0xb5, 0xb1, 0x09, 0xd5, 0x9d, 0x52, 0x7a, 0x10, 0xba, 0x53, 0x8c,
+	0x08, 0xb9, 0xd0, 0xe0, 0xc8, 0x1d, 0x72, 0x92, 0xa4, 0xe0, 0xc2, 0x40, 0x8c, 0xe0, 0x0e, 0xa4,
+	0x2c, 0xef, 0x50, 0x5e, 0x17, 0x5d, 0x59, 0xd0, 0xf2, 0xed, 0xba, 0x77, 0x28, 0x0e, 0xcd, 0x8c,
+	0x10, 0x2f, 0x2d, 0xc2, 0x80, 0xec, 0xc8, 0x1e, 0x63, 0xfc, 0x27, 0x1d, 0x8d, 0x90, 0x0c, 0xc5,
+	0xee, 0x22, 0xc6, 0x28, 0x7b, 0x6a, 0xd1, 0x9f, 0xbb, 0xf9, 0x0b, 0x93, 0x81, 0x6a, 0xef, 0x41,
+	0x3e, 0x6c, 0xe6, 0x45, 0xe7, 0xd6, 0xa2, 0x7a, 0x6e, 0xfd, 0xb7, 0x04, 0x94, 0xe3, 0x3a, 0xc5,
+	0x45, 0x25, 0x2e, 0xcb, 0xb4, 0xa5, 0x37, 0x47, 0x02, 0x4b, 0xde, 0x82, 0xac, 0xbc, 0x2a, 0x4b,
+	0x2c, 0xbf, 0x2d, 0x92, 0x78, 0x5c, 0x3f, 0xca, 0x60, 0x62, 0x20, 0x2e, 0x2c, 0x63, 0xfc, 0xea,
+	0xd0, 0xf2, 0xcd, 0xa9, 0x4f, 0x87, 0x62, 0xed, 0x64, 0x0f, 0x2d, 0xbf, 0xef, 0xd3, 0x61, 0x6c,
+	0x12, 0xa7, 0x5f, 0x3c, 0x89, 0xef, 0x42, 0x5e, 0x72, 0xf5, 0xab, 0x99, 0xc8, 0x99, 0x69, 0x84,
+	0xf7, 0x4e, 0x1c, 0x69, 0x44, 0x64, 0x78, 0x02, 0x9f, 0xca, 0xc3, 0x9c, 0x8c, 0xd2, 0xc7, 0x6e,
+	0x07, 0x15, 0x34, 0xb9, 0x0d, 0x85, 0x69, 0x78, 0x44, 0xf2, 0xab, 0xb9, 0x25, 0x17, 0x84, 0x2a,
+	0x81, 0x3e, 0x01, 0x88, 0xf4, 0xc6, 0x66, 0xfa, 0x74, 0x70, 0x4c, 0x83, 0xf0, 0x1e, 0x9c, 0x95,
+	0xe4, 0x70, 0xf1, 0xa1, 0xc1, 0x9f, 0xb1, 0x6b, 0xe3, 0xe4, 0xf3, 0xae, 0x8d, 0x53, 0xf3, 0x87,
+	0xd3, 0x47, 0x50, 0x50, 0x06, 0xe0, 0x0c, 0x4d, 0x86, 0x33, 0x24, 0xa9, 0xcc, 0x10, 0xbd, 0x0e,
+	0xa5, 0xd8, 0x2d, 0x18, 0xda, 0x89, 0x3d, 0x79, 0x6b, 0x2b, 0xdd, 0x95, 0x10, 0x80, 0x76, 0x15,
+	0xc9, 0x05, 0x5f, 0xf6, 0x5b, 0xff, 0x0e, 0xac, 0xed, 0x51, 0x6f, 0x6c, 0xfb, 0x78, 0x82, 0x7a,
+	0xe4, 0x0e, 0xe9, 0x08, 0x4f, 0x23, 0xde, 0x74, 0xc4, 0x57, 0x64, 0x99, 0x2f, 0xeb, 0x88, 0xc4,
+	0x98, 0x8e, 0xa8, 0xc1, 0xf0, 0x68, 0x36, 0xad, 0xc1, 0x80, 0x4e, 0x82, 0xc7, 0x4a, 0xcc, 0x45,
+	0x05, 0xe9, 0x97, 0x21, 0x5d, 0x3f, 0xee, 0x72, 0x81, 0xac, 0x63, 0x3e, 0x61, 0xf3, 0x06, 0xfe,
+	0xd4, 0x7f, 0x55, 0x83, 0x0c, 0xc3, 0x61, 0x2c, 0x35, 0xe5, 0xd3, 0x70, 0x3a, 0xb3, 0x29, 0xc1,
+	0x31, 0xb7, 0xf1, 0x8f, 0x58, 0x9a, 0x48, 0x81, 0x51, 0x59, 0x3a, 0x9b, 0xa0, 0xf3, 0x11, 0x9d,
+	0x30, 0x15, 0x48, 0x6d, 0x1b, 0xf2, 0x61, 0x95, 0x25, 0xcb, 0xec, 0x5a, 0x3c, 0x52, 0x95, 0x0f,
+	0x5b, 0x52, 0x57, 0xdc, 0xdf, 0x69, 0x90, 0xac, 0x0f, 0x46, 0xe4, 0x35, 0x48, 0x4c, 0xc6, 0xc2,
+	0x30, 0x5e, 0x88, 0xeb, 0x80, 0xa9, 0xc9, 0x48, 0x4c, 0xc6, 0xe4, 0xab, 0x90, 0xb7, 0x8e, 0xfd,
+	0x27, 0x32, 0xf7, 0x26, 0xcc, 0x3e, 0xa8, 0x0f, 0x46, 0xb7, 0xeb, 0x12, 0x21, 0x02, 0x79, 0x21,
+	0x21, 0xda, 0x5d, 0x8b, 0x09, 0xa8, 0x46, 0x8a, 0xb8, 0xc8, 0x86, 0xc0, 0x60, 0xd8, 0x2e, 0xce,
+	0xe0, 0x4c, 0xe1, 0xae, 0x3f, 0x4b, 0x40, 0xbe, 0x3e, 0x18, 0x9d, 0x43, 0xfc, 0x97, 0x0f, 0x32,
+	0x1a, 0xb1, 0x76, 0x64, 0x5f, 0x55, 0x10, 0xd1, 0x21, 0x66, 0x91, 0xc5, 0xf6, 0x14, 0x83, 0xe1,
+	0xc0, 0x45, 0x26, 0x59, 0x66, 0x13, 0x46, 0x10, 0xe6, 0x66, 0xf3, 0xdb, 0x3c, 0x3a, 0x94, 0x39,
+	0x30, 0x21, 0x80, 0x5c, 0x86, 0xa4, 0x35, 0x18, 0x89, 0xc4, 0xb8, 0xac, 0xd0, 0xaf, 0x81, 0x30,
+	0xe5, 0x2e, 0x23, 0xb7, 0x2a, 0xdd, 0x26, 0xff, 0x9c, 0x74, 0x1b, 0x98, 0x4f, 0xb7, 0xf9, 0x05,
+	0x0d, 0x8a, 0xad, 0x21, 0x75, 0x02, 0x3b, 0x38, 0xad, 0x4f, 0x83, 0xa3, 0xf0, 0xe6, 0x45, 0x5b,
+	0x7a, 0xf3, 0x92, 0x88, 0xdd, 0xbc, 0x10, 0x48, 0x29, 0xd9, 0x96, 0xec, 0x37, 0xa3, 0xa5, 0xd4,
+	0x6b, 0xed, 0x08, 0xbd, 0x88, 0x52, 0xfc, 0xb2, 0x45, 0x06, 0x89, 0x24, 0x40, 0xff, 0x1a, 0x94,
+	0xd4, 0x5e, 0xf8, 0xe4, 0x75, 0x48, 0xe1, 0x76, 0x2e, 0xd6, 0x48, 0x85, 0x99, 0x59, 0x85, 0xc0,
+	0x60, 0x58, 0xfd, 0x21, 0x94, 0x62, 0xfb, 0x13, 0x56, 0x63, 0x81, 0x08, 0xbe, 0x94, 0x2b, 0xea,
+	0x06, 0x86, 0xc1, 0x08, 0x83, 0x61, 0x59, 0x2e, 0x2d, 0x92, 0x0b, 0xbf, 0x8a, 0x17, 0x74, 0x1b,
+	0xd6, 0xeb, 0x0f, 0xef, 0x86, 0x37, 0x90, 0x9f, 0xe7, 0x49, 0xe2, 0x7b, 0x40, 0xd4, 0xa6, 0xce,
+	0xc1, 0x3d, 0xa9, 0x46, 0x19, 0xa8, 0xdc, 0x45, 0x96, 0x45, 0x0c, 0x2b, 0xdc, 0xa7, 0x81, 0x68,
+	0x2b, 0xbc, 0xd4, 0x3d, 0x2f, 0xf9, 0xc2, 0x36, 0x35, 0xb5, 0xcd, 0x4f, 0x35, 0xb8, 0xb2, 0xb4,
+	0xd1, 0x33, 0x48, 0xfa, 0x4d, 0x08, 0x13, 0x34, 0xe6, 0x22, 0xd2, 0x44, 0xdd, 0x44, 0x85, 0x67,
+	0xbd, 0x16, 0xd2, 0x72, 0x80, 0xfe, 0x27, 0x1a, 0x94, 0xe3, 0x34, 0x8b, 0xfe, 0x95, 0xb6, 0x64,
+	0xe5, 0x2e, 0x39, 0xbf, 0x85, 0xa9, 0x35, 0x49, 0x25, 0xb5, 0xe6, 0x0a, 0xe4, 0x6d, 0xdf, 0xdc,
+	0xb7, 0x1c, 0x47, 0xf8, 0x09, 0x2c, 0xf3, 0x6c, 0x9b, 0x95, 0x17, 0x27, 0xfb, 0x7c, 0x16, 0x8d,
+	0x8c, 0xd2, 0x65, 0x62, 0x51, 0x3a, 0xfd, 0x97, 0x12, 0xb0, 0xb5, 0xe7, 0xd1, 0xe6, 0x8c, 0x0e,
+	0x9e, 0xd8, 0xc1, 0x11, 0x8f, 0x46, 0xf6, 0x7b, 0x4f, 0x3b, 0x9f, 0xeb, 0x74, 0x44, 0x9b, 0xc7,
+	0xa2, 0x9f, 0x22, 0xe1, 0x40, 0x9c, 0x18, 0x14, 0x10, 0x7a, 0x3e, 0x68, 0x09, 0x58, 0xf4, 0x2a,
+	0xa3, 0xc4, 0xda, 0x63, 0x29, 0x29, 0x21, 0x49, 0x2c, 0xae, 0x9b, 0x8d, 0xc7, 0x75, 0xc9, 0x6d,
+	0x8c, 0x73, 0x33, 0x69, 0xc4, 0x95, 0xd8, 0x45, 0xc5, 0x87, 0x0a, 0x0f, 0x1b, 0x86, 0x24, 0xd2,
+	0xff, 0x52, 0x83, 0x57, 0x57, 0xe8, 0xe4, 0x8b, 0x77, 0xeb, 0xc9, 0x6d, 0xee, 0x9f, 0x71, 0x97,
+	0x46, 0xdc, 0xff, 0x95, 0x65, 0x94, 0x99, 0x43, 0x0d, 0x85, 0x42, 0x7f, 0x0a, 0x95, 0x79, 0x77,
+	0x4f, 0x89, 0x6a, 0x6a, 0xf3, 0x51, 0xcd, 0x31, 0xf5, 0x7d, 0xeb, 0x30, 0x4c, 0x01, 0x15, 0x45,
+	0x9c, 0x80, 0xfb, 0xee, 0x50, 0xde, 0x19, 0xb0, 0xdf, 0xfa, 0xef, 0x6b, 0x50, 0x50, 0xb2, 0x6e,
+	0x30, 0x83, 0x85, 0x1e, 0x1c, 0xd0, 0x01, 0x86, 0x51, 0xa3, 0x0c, 0xbf, 0xbc, 0x51, 0x0a, 0xa1,
+	0x3d, 0x91, 0x3e, 0x3f, 0xb6, 0xbc, 0x63, 0x3a, 0x14, 0x37, 0x81, 0xa2, 0x44, 0xde, 0x82, 0x4a,
+	0x54, 0x3d, 0x96, 0x34, 0xb3, 0x16, 0xc2, 0x45, 0x52, 0xc5, 0xab, 0x00, 0x51, 0xf6, 0x5c, 0xfc,
+	0x3a, 0x40, 0x78, 0x5d, 0x6c, 0x07, 0xe1, 0x46, 0x9e, 0xfd, 0xd6, 0x3f, 0x02, 0x91, 0xea, 0x83,
+	0x19, 0x34, 0x47, 0x43, 0x53, 0xa9, 0x2f, 0xb2, 0x7b, 0x8e, 0x86, 0x91, 0xdf, 0xf6, 0x1a, 0x94,
+	0x5c, 0xcf, 0x3e, 0xb4, 0x1d, 0x6b, 0xc4, 0xef, 0x8a, 0xf9, 0xb6, 0x53, 0x94, 0x40, 0xbc, 0x2f,
+	0xd6, 0xff, 0x3e, 0x01, 0x15, 0x16, 0xda, 0x67, 0x71, 0x0e, 0x91, 0x28, 0xfa, 0xf9, 0xee, 0xfc,
+	0xff, 0x13, 0xca, 0xee, 0x84, 0x3a, 0x51, 0xab, 0xf3, 0x13, 0x80, 0x43, 0x8d, 0x39, 0x2a, 0xf2,
+	0x01, 0x54, 0x70, 0x88, 0xe8, 0x50, 0xa9, 0x99, 0x5e, 0x5a, 0x73, 0x81, 0x0e, 0xeb, 0xf2, 0x64,
+	0x46, 0xa5, 0x6e, 0x66, 0x79, 0xdd, 0x79, 0x3a, 0xf4, 0x54, 0x86, 0xb6, 0x3f, 0x19, 0x59, 0xa7,
+	0x2c, 0x05, 0x41, 0xa6, 0x5f, 0xaa, 0x30, 0xfd, 0x18, 0x40, 0xa9, 0xb1, 0x05, 0x2c, 0x53, 0xa9,
+	0x11, 0xde, 0x69, 0xe5, 0x8d, 0x08, 0x80, 0x5e, 0x0d, 0x16, 0xea, 0xea, 0xf3, 0x0f, 0x05, 0x42,
+	0xae, 0x41, 0xca, 0x0e, 0xe8, 0x58, 0x4d, 0x6a, 0x44, 0xde, 0x0f, 0xe9, 0xa9, 0xc1, 0x10, 0x7a,
+	0x17, 0xb2, 0x02, 0xa0, 0x5e, 0x77, 0xc9, 0xab, 0x0a, 0x5e, 0xc4, 0xf1, 0x51, 0xb2, 0x50, 0xf3,
+	0x86, 0x28, 0x29, 0x67, 0xcd, 0xa4, 0x7a, 0xd6, 0xd4, 0xfb, 0x70, 0x49, 0x35, 0xf4, 0xf8, 0xe6,
+	0xe2, 0x3c, 0xa2, 0x40, 0x9f, 0x6a, 0x50, 0x5d, 0xe4, 0x7b, 0x0e, 0x26, 0xe7, 0x26, 0xa4, 0x86,
+	0x56, 0x98, 0x61, 0x70, 0x71, 0x7e, 0x33, 0x63, 0xed, 0x30, 0x0a, 0xfd, 0x7f, 0x43, 0x65, 0x1e,
+	0x83, 0x63, 0x6a, 0xc9, 0x6d, 0x55, 0x0e, 0x52, 0xd2, 0x88, 0xc1, 0xf0, 0x8a, 0x4b, 0xee, 0x69,
+	0x8d, 0x70, 0xa8, 0x92, 0x46, 0x1c, 0xa8, 0xff, 0xb2, 0x06, 0x97, 0x44, 0x6e, 0xf2, 0xb9, 0xbb,
+	0x05, 0xcb, 0xf7, 0x99, 0xf9, 0x47, 0x02, 0xa9, 0xc5, 0x47, 0x02, 0x0f, 0xa1, 0x28, 0x3b, 0xc3,
+	0x6e, 0xeb, 0xbe, 0x0e, 0xe1, 0xce, 0x6e, 0x86, 0x46, 0x73, 0x95, 0x13, 0x50, 0x1e, 0xc4, 0xca,
+	0xfa, 0xbf, 0x6a, 0x50, 0x5d, 0x94, 0xf0, 0x0c, 0x43, 0xd8, 0x62, 0x6e, 0x3a, 0xaf, 0x28, 0x9c,
+	0x8f, 0xb7, 0x99, 0x3b, 0xbe, 0x82, 0x69, 0xd8, 0x21, 0x99, 0xcc, 0x10, 0xd6, 0xae, 0xb5, 0xa1,
+	0x1c, 0x47, 0x2e, 0x39, 0xdf, 0xbc, 0x11, 0x3f, 0xaf, 0x55, 0x54, 0x11, 0x51, 0x1b, 0xea, 0x89,
+	0xe7, 0x27, 0x1a, 0xe4, 0x45, 0x37, 0x7a, 0x33, 0xe5, 0x58, 0xa0, 0xc5, 0x8e, 0x05, 0xaa, 0x37,
+	0x13, 0x3d, 0xe0, 0xc9, 0x0f, 0x6d, 0x8f, 0xb2, 0x8c, 0x25, 0x91, 0xc2, 0x2e, 0x22, 0x25, 0x3b,
+	0x12, 0x6c, 0x44, 0x14, 0xca, 0xb2, 0x4b, 0xc5, 0xde, 0x7b, 0x3d, 0xd7, 0xc7, 0xd1, 0x7f, 0x4d,
+	0x83, 0xf5, 0xb0, 0x7b, 0x9f, 0xf3, 0xb4, 0xda, 0x84, 0xcc, 0x60, 0xea, 0xf9, 0x61, 0xa4, 0x50,
+	0x94, 0x22, 0x37, 0x9f, 0x5f, 0x9f, 0xf2, 0x82, 0xfe, 0x07, 0x1a, 0x10, 0xb5, 0x67, 0xe7, 0xe4,
+	0x7c, 0x2f, 0xef, 0xda, 0x35, 0x48, 0x06, 0x33, 0x19, 0x8b, 0x2b, 0x29, 0x53, 0xa7, 0x37, 0x33,
+	0x10, 0x83, 0xe1, 0x3c, 0x96, 0x12, 0x25, 0x04, 0x10, 0x27, 0x45, 0x04, 0x35, 0x18, 0x44, 0xff,
+	0x73, 0x0d, 0xd6, 0x1b, 0x9e, 0xeb, 0xfb, 0x1f, 0x4d, 0xa9, 0x77, 0x2a, 0x15, 0xb9, 0xea, 0x0d,
+	0x43, 0x6c, 0x50, 0x12, 0xf3, 0x8e, 0x67, 0x2c, 0xaa, 0x9a, 0x7c, 0x51, 0x54, 0x35, 0xb5, 0x98,
+	0xdf, 0xfc, 0xf6, 0xbc, 0xef, 0xb6, 0x24, 0xfe, 0x15, 0x3a, 0x6e, 0xf7, 0x80, 0xa8, 0x1d, 0x17,
+	0x7a, 0xfe, 0xb2, 0xe2, 0x70, 0x69, 0x8b, 0x16, 0x70, 0x49, 0x24, 0x15, 0x57, 0x0e, 0xf2, 0x61,
+	0xf9, 0x49, 0x2c, 0x59, 0x8a, 0x28, 0xa7, 0xbc, 0xbc, 0x38, 0xd3, 0xdd, 0x84, 0xca, 0xd8, 0x76,
+	0x4c, 0xea, 0x0c, 0x5d, 0xd4, 0x9b, 0x12, 0x36, 0x2f, 0x8f, 0x6d, 0xa7, 0x29, 0xc0, 0xed, 0xe9,
+	0x58, 0x7f, 0x0c, 0x25, 0xc6, 0x4f, 0xc2, 0x9e, 0xf3, 0xd6, 0xf1, 0x12, 0x64, 0x27, 0xd3, 0x7d,
+	0x53, 0x9e, 0x7c, 0xf3, 0xec, 0xe4, 0x2b, 0x7c, 0x9c, 0x23, 0xd7, 0x97, 0x3b, 0x11, 0xfb, 0xad,
+	0x07, 0x50, 0x8e, 0xe4, 0x65, 0xfd, 0x7c, 0x17, 0x80, 0xe7, 0x84, 0xb2, 0x8c, 0x32, 0xe5, 0xb2,
+	0x3b, 0x2e, 0x8f, 0x91, 0x1f, 0x84, 0xa2, 0xdd, 0x81, 0xbc, 0x14, 0x41, 0x5a, 0x9c, 0xf5, 0xb0,
+	0x86, 0xec, 0xb1, 0x11, 0xd1, 0xe0, 0x55, 0x82, 0xd2, 0x2c, 0x73, 0xb1, 0xee, 0x44, 0xa3, 0xc4,
+	0xdb, 0xdc, 0x08, 0x39, 0xa8, 0x93, 0x28, 0x1c, 0x29, 0x72, 0x57, 0x19, 0x13, 0x6e, 0x7a, 0x36,
+	0xe7, 0x6b, 0x2c, 0x38, 0xc2, 0x6f, 0x42, 0x9a, 0x67, 0xa8, 0x27, 0x57, 0x65, 0xa8, 0x73, 0xbc,
+	0xde, 0x85, 0x92, 0x1c, 0xdc, 0xe6, 0x09, 0x75, 0x02, 0x9e, 0x8a, 0xc0, 0x01, 0x42, 0xdf, 0x61,
+	0x39, 0xcc, 0xb1, 0x48, 0x28, 0x39, 0x16, 0xcb, 0x9c, 0xdf, 0x7f, 0xd4, 0x60, 0x9d, 0x67, 0xda,
+	0x59, 0xce, 0x21, 0x3d, 0xa7, 0xfb, 0x2c, 0x7c, 0xcf, 0x22, 0xef, 0xb3, 0xf0, 0x37, 0x29, 0x43,
+	0x22, 0x70, 0xc5, 0x71, 0x28, 0x11, 0xb8, 0x0b, 0xfb, 0x57, 0x7a, 0x61, 0xff, 0x42, 0xdf, 0x98,
+	0xce, 0x06, 0xa3, 0xe9, 0x10, 0x7d, 0x70, 0x19, 0xd9, 0x11, 0x90, 0xde, 0x2c, 0x32, 0x49, 0x59,
+	0xd5, 0x24, 0xfd, 0xb1, 0x06, 0x44, 0x95, 0xe6, 0x1c, 0x4c, 0xd2, 0x0d, 0xc8, 0xb0, 0x00, 0x90,
+	0x1c, 0x9f, 0x7c, 0xf8, 0x12, 0xd1, 0x10, 0x88, 0xd0, 0xf4, 0xc4, 0x5e, 0xce, 0x30, 0xd3, 0x23,
+	0xfc, 0xfc, 0xcb, 0x90, 0x3b, 0xb2, 0x7c, 0x73, 0xec, 0x7a, 0x54, 0x88, 0x9a, 0x3d, 0xb2, 0xfc,
+	0x47, 0xae, 0x47, 0xf5, 0x3f, 0xd2, 0xa0, 0xf4, 0xc4, 0xb2, 0x83, 0xde, 0xec, 0x9c, 0x74, 0xbf,
+	0xf0, 0xcc, 0x94, 0xfb, 0x30, 0x18, 0x10, 0xe3, 0x4f, 0xa4, 0x45, 0xff, 0xe2, 0x40, 0xf2, 0x26,
+	0xac, 0xa1, 0x79, 0x73, 0xa7, 0x81, 0xe9, 0xd3, 0x81, 0xeb, 0x0c, 0x7d, 0xb1, 0x15, 0x95, 0x05,
+	0xb8, 0xcb, 0xa1, 0xfa, 0xcf, 0x34, 0x28, 0xcb, 0x0e, 0x9f, 0x83, 0x7a, 0x97, 0xf5, 0xf8, 0x26,
+	0x64, 0x3c, 0x7e, 0xa1, 0x96, 0x8a, 0xa2, 0x4f, 0x61, 0x9b, 0xd3, 0x51, 0x60, 0x08, 0xbc, 0xf2,
+	0x84, 0x36, 0xfd, 0x32, 0x4f, 0x68, 0x17, 0x54, 0x91, 0x59, 0xa6, 0x0a, 0x25, 0x02, 0x98, 0x8d,
+	0x45, 0x00, 0xf5, 0xdf, 0xd2, 0x60, 0xa3, 0xef, 0x84, 0xe1, 0xc5, 0x73, 0xda, 0x8f, 0x9f, 0xbf,
+	0x99, 0x9c, 0x6d, 0x4f, 0xfe, 0x5d, 0x0d, 0xaa, 0xb1, 0x1e, 0xda, 0xc3, 0xf3, 0xd9, 0x99, 0x2f,
+	0x42, 0x1a, 0xc7, 0xc6, 0x17, 0xb7, 0x3c, 0xbc, 0x30, 0xbf, 0xe9, 0xa6, 0xe6, 0x37, 0x5d, 0x56,
+	0x8d, 0xbd, 0xde, 0xe0, 0x93, 0x89, 0x17, 0xf4, 0x5f, 0xd7, 0x60, 0x73, 0x5e, 0x8f, 0xe7, 0xb2,
+	0x54, 0x99, 0x8f, 0x90, 0x5c, 0x9e, 0x5f, 0xbe, 0xcc, 0x4b, 0x58, 0xe8, 0xb0, 0xfe, 0x11, 0x5c,
+	0xe8, 0xcd, 0xf6, 0x5c, 0x77, 0x74, 0x7e, 0xb7, 0xe0, 0x6d, 0xc9, 0xb2, 0x15, 0xbe, 0x53, 0x0a,
+	0xac, 0x20, 0x3e, 0xec, 0xda, 0xfc, 0xb0, 0xab, 0xf9, 0xef, 0xe2, 0x7d, 0x82, 0xc8, 0x7f, 0xd7,
+	0xbf, 0x0b, 0x65, 0xce, 0xaf, 0xe1, 0x3a, 0x07, 0x23, 0x7b, 0xf0, 0x59, 0x5e, 0x23, 0x2e, 0x1d,
+	0x56, 0xfd, 0xdf, 0x13, 0x70, 0x31, 0xae, 0x85, 0x73, 0x18, 0x1d, 0x55, 0xa2, 0x64, 0x4c, 0x22,
+	0x0c, 0x99, 0xb8, 0xa3, 0x21, 0xf5, 0x03, 0xe5, 0xb9, 0x15, 0xb7, 0x52, 0x6b, 0x1c, 0x1e, 0x3d,
+	0xb6, 0x7a, 0x0b, 0x2a, 0x0e, 0x7d, 0x16, 0x27, 0xe5, 0x73, 0x6b, 0x8d, 0xc3, 0x23, 0xd2, 0x37,
+	0x60, 0x0d, 0x5f, 0xf5, 0x58, 0x87, 0x34, 0x34, 0x69, 0x62, 0xbd, 0x8f, 0xad, 0x59, 0xfd, 0x90,
+	0x0a, 0x8b, 0x46, 0x3e, 0x84, 0x72, 0xe0, 0x4e, 0xcc, 0x50, 0xf7, 0xf2, 0xb6, 0xf0, 0x12, 0xf7,
+	0xe5, 0x17, 0x46, 0x0e, 0x33, 0x1c, 0x27, 0x21, 0xc4, 0x27, 0x5f, 0xe6, 0x57, 0x10, 0x38, 0x12,
+	0xf2, 0xea, 0x90, 0x44, 0x55, 0xe5, 0x20, 0x19, 0x11, 0x91, 0xfe, 0xd7, 0xe8, 0x8a, 0xaa, 0x5b,
+	0xb9, 0x8c, 0xf5, 0x7d, 0xd6, 0xed, 0x3c, 0x34, 0xa7, 0xa9, 0xf8, 0x77, 0x1e, 0xc4, 0xce, 0x94,
+	0x9e, 0x7f, 0xb5, 0x11, 0x4d, 0xc0, 0xcc, 0xfc, 0x04, 0x8c, 0x39, 0xc0, 0xd9, 0xf9, 0x53, 0xc9,
+	0x4f, 0x12, 0xb0, 0x11, 0x93, 0xe0, 0x5c, 0x2c, 0xa1, 0xaa, 0x81, 0xe4, 0x9c, 0x06, 0xd0, 0x1f,
+	0xc0, 0x86, 0x78, 0xc0, 0x59, 0xc4, 0xca, 0x18, 0xa4, 0xbd, 0x60, 0x44, 0xd3, 0x4b, 0x3c, 0x72,
+	0x3f, 0xb0, 0xbc, 0x70, 0x8b, 0xe6, 0xf3, 0xa0, 0xc0, 0x60, 0x51, 0x2c, 0x8e, 0x3a, 0xc3, 0xf8,
+	0xfb, 0x5a, 0xf4, 0x0e, 0x05, 0x3a, 0x32, 0xc3, 0xb9, 0xe5, 0x66, 0x38, 0xaf, 0x9a, 0xe1, 0xdf,
+	0xd3, 0x60, 0x73, 0x5e, 0x3d, 0xe7, 0xb0, 0x84, 0xde, 0x81, 0x0c, 0x93, 0x58, 0xda, 0xb8, 0x0d,
+	0xd5, 0xe1, 0x0f, 0x27, 0x92, 0x21, 0x88, 0x5e, 0x6c, 0xec, 0x7e, 0xa6, 0x01, 0x69, 0xfa, 0x81,
+	0x3d, 0xb6, 0x02, 0x7a, 0x8f, 0x9e, 0x8b, 0xf7, 0xc7, 0xbf, 0x44, 0x91, 0x5c, 0xf9, 0x25, 0x8a,
+	0xd8, 0xd5, 0x7f, 0xea, 0x8c, 0xf9, 0x2b, 0xe9, 0x17, 0x9d, 0xb4, 0x32, 0x8b, 0x27, 0xad, 0xe8,
+	0xd4, 0x9d, 0x8d, 0x05, 0xbb, 0xfe, 0x22, 0x01, 0x17, 0x62, 0xb2, 0x9f, 0x8f, 0x89, 0x0b, 0x33,
+	0x1e, 0x92, 0xf1, 0x8c, 0x87, 0x3b, 0x2c, 0x85, 0x81, 0xdd, 0x9f, 0xc5, 0xe4, 0x8e, 0x67, 0x85,
+	0x44, 0x34, 0xe4, 0x2d, 0xc8, 0x23, 0xaf, 0x09, 0x7b, 0x37, 0x97, 0x7e, 0xee, 0xbb, 0xb9, 0x0a,
+	0x24, 0x0f, 0xa8, 0x7c, 0xb3, 0x88, 0x3f, 0x71, 0x32, 0xb3, 0x9d, 0xd6, 0x44, 0x8f, 0xba, 0x9a,
+	0x9d, 0xcf, 0x24, 0x97, 0x0f, 0x2b, 0xb9, 0x31, 0xe6, 0xf7, 0x9f, 0x4a, 0x74, 0xf2, 0x1a, 0xcf,
+	0x86, 0x30, 0xa9, 0xe3, 0x4e, 0x0f, 0x8f, 0xd8, 0xcc, 0xce, 0xf1, 0xf0, 0x64, 0x93, 0x41, 0xf4,
+	0xa1, 0xf8, 0xb4, 0x00, 0x5a, 0xc3, 0x73, 0x59, 0xf8, 0x17, 0xd0, 0x4d, 0x98, 0x98, 0x3c, 0x8a,
+	0x92, 0x36, 0x52, 0x81, 0x3b, 0x69, 0xeb, 0x75, 0xf1, 0xbd, 0x87, 0x07, 0xb8, 0x07, 0x3c, 0xef,
+	0x70, 0xb9, 0xf2, 0x8b, 0x0f, 0xfa, 0x2f, 0x26, 0x80, 0xa8, 0x3d, 0x3d, 0x87, 0x31, 0x8e, 0xac,
+	0x69, 0x32, 0x66, 0x4d, 0xf1, 0x9d, 0x2c, 0x53, 0xb9, 0x3f, 0x9d, 0x4c, 0x46, 0x32, 0x9a, 0xcf,
+	0xef, 0x7e, 0xba, 0x0c, 0xa4, 0xbc, 0xb1, 0xb7, 0xa2, 0x07, 0xa9, 0x79, 0xf9, 0xc6, 0x5e, 0x84,
+	0x7e, 0x6f, 0x40, 0xf1, 0x88, 0x09, 0x6c, 0x0e, 0xc2, 0x74, 0xa0, 0xa4, 0x51, 0xe0, 0x30, 0x3e,
+	0x3e, 0x5f, 0xc6, 0x5b, 0xa6, 0x89, 0xc9, 0x41, 0x72, 0xb7, 0x8a, 0x3e, 0x2a, 0xc1, 0xf5, 0x65,
+	0x40, 0xe0, 0x4e, 0xf8, 0x4f, 0xff, 0xd6, 0x1f, 0x66, 0x60, 0x6d, 0xee, 0xc3, 0x0a, 0xf8, 0x5d,
+	0x93, 0x6e, 0xbf, 0xd1, 0x68, 0x76, 0xbb, 0x95, 0x57, 0x48, 0x05, 0x8a, 0xfd, 0xf6, 0xc3, 0x76,
+	0xe7, 0x89, 0xc9, 0xbf, 0x86, 0xa2, 0x11, 0x02, 0xe5, 0x46, 0xa7, 0xdd, 0x6e, 0x36, 0x7a, 0xa6,
+	0xd1, 0xbc, 0xd7, 0xef, 0x36, 0x2b, 0x09, 0x72, 0x19, 0x36, 0xda, 0x9d, 0x9e, 0xd9, 0x6c, 0x77,
+	0xfa, 0xf7, 0x1f, 0x98, 0x78, 0x75, 0x24, 0xc8, 0x93, 0x44, 0x87, 0xab, 0x58, 0x7e, 0xfc, 0xc8,
+	0xac, 0xef, 0x1a, 0xcd, 0xfa, 0xce, 0xc7, 0x66, 0xbf, 0xdd, 0xe8, 0xb4, 0xef, 0xb5, 0x8c, 0x47,
+	0x82, 0x26, 0x45, 0x6a, 0xb0, 0x29, 0x68, 0x90, 0xcb, 0xbd, 0x4e, 0xbf, 0xbd, 0x23, 0x70, 0x69,
+	0x72, 0x1d, 0xb6, 0x5a, 0xed, 0xbd, 0x7e, 0xcf, 0xec, 0xf4, 0x7b, 0xf8, 0x8f, 0xb5, 0xf3, 0x51,
+	0xbf, 0xbe, 0x2b, 0x28, 0x32, 0x64, 0x13, 0x48, 0xef, 0xe9, 0x42, 0xcd, 0x2c, 0x59, 0x87, 0x52,
+	0xef, 0xa9, 0xd9, 0x6d, 0xdd, 0x6f, 0x0b, 0x50, 0x8e, 0x5c, 0x82, 0x0b, 0xdb, 0xbb, 0x9d, 0xc6,
+	0xc3, 0xc6, 0x83, 0x7a, 0xab, 0x8d, 0x55, 0xf8, 0xe7, 0x5b, 0xf2, 0x28, 0xd4, 0xe3, 0xfa, 0x6e,
+	0x6b, 0xa7, 0xde, 0x6b, 0x0a, 0x62, 0x20, 0x57, 0xe0, 0x52, 0xa3, 0xde, 0x46, 0xbe, 0xdd, 0x8f,
+	0xdb, 0x0d, 0x93, 0x55, 0x14, 0xc8, 0x02, 0x72, 0x92, 0x52, 0xa8, 0x88, 0x22, 0xd9, 0x80, 0x75,
+	0x21, 0xcb, 0xde, 0x6e, 0xfd, 0x63, 0x01, 0x2e, 0x91, 0x32, 0xc0, 0x93, 0xfa, 0xae, 0x24, 0x2b,
+	0x93, 0x0b, 0xb0, 0x86, 0x9c, 0xb9, 0x46, 0x38, 0x70, 0x0d, 0xeb, 0x0a, 0x66, 0xd8, 0x2d, 0x01,
+	0xae, 0xa0, 0x7a, 0x8c, 0x4e, 0xa7, 0x67, 0x2e, 0xe2, 0xd6, 0x85, 0xf0, 0x3b, 0xfd, 0xbd, 0xdd,
+	0x56, 0x23, 0xea, 0xfc, 0x05, 0x1c, 0x91, 0x6e, 0xd3, 0x78, 0xdc, 0x6a, 0x34, 0xc5, 0x28, 0x49,
+	0xbd, 0x5c, 0xc4, 0x56, 0x7a, 0x4f, 0x77, 0xea, 0xbd, 0xba, 0xaa, 0x9b, 0x0d, 0x1c, 0x69, 0x54,
+	0xd7, 0xae, 0xe4, 0x71, 0x19, 0x15, 0xd0, 0x7b, 0x6a, 0xde, 0x6b, 0x36, 0x4d, 0x65, 0x70, 0x39,
+	0xb2, 0x86, 0x02, 0xb0, 0x71, 0x56, 0x78, 0x6c, 0x91, 0x8b, 0x50, 0xd9, 0xd9, 0xeb, 0x74, 0xcd,
+	0x8f, 0xfa, 0x4d, 0x43, 0x8a, 0x75, 0x0d, 0x75, 0x65, 0x3c, 0xe9, 0x36, 0x7b, 0x66, 0xab, 0xcd,
+	0x94, 0x2c, 0x10, 0x37, 0x38, 0xa2, 0xde, 0xd8, 0x9d, 0x43, 0xe8, 0xa4, 0x0a, 0x17, 0xef, 0xd7,
+	0xbb, 0x8b, 0xcd, 0xbe, 0x46, 0xb6, 0xa0, 0xda, 0x7b, 0x6a, 0x3e, 0x6e, 0x1a, 0xdd, 0x56, 0xa7,
+	0x3d, 0x57, 0xef, 0x75, 0x72, 0x03, 0x5e, 0x6d, 0x74, 0x1e, 0xed, 0xed, 0xb6, 0xea, 0xed, 0x46,
+	0xd3, 0x6c, 0x3c, 0x68, 0x36, 0x1e, 0x32, 0x26, 0xf5, 0xbd, 0x3d, 0xa3, 0xf3, 0xb8, 0xb9, 0x53,
+	0xf9, 0x12, 0x92, 0xd4, 0x1b, 0x8d, 0x4e, 0xbf, 0xdd, 0x33, 0x1b, 0x9d, 0x76, 0xcf, 0xa8, 0x37,
+	0x7a, 0x66, 0xb7, 0x57, 0xef, 0xf5, 0xbb, 0x82, 0xcb, 0x1b, 0xa8, 0x3b, 0xde, 0x46, 0xeb, 0x1e,
+	0x2a, 0x15, 0x1b, 0xe2, 0xa8, 0x9b, 0xb7, 0x28, 0xac, 0x2f, 0x9c, 0x22, 0x49, 0x11, 0x72, 0xfd,
+	0xf6, 0x4e, 0xf3, 0x5e, 0xab, 0xdd, 0xac, 0xbc, 0xa2, 0x7e, 0x16, 0x48, 0xc3, 0x82, 0x98, 0x26,
+	0x95, 0x04, 0x29, 0x41, 0xfe, 0x5e, 0xdf, 0xe0, 0x1c, 0x2b, 0x49, 0x2c, 0x86, 0x4b, 0xa1, 0x92,
+	0xc2, 0x4f, 0x0b, 0xdd, 0xab, 0xb7, 0x76, 0x9b, 0x3b, 0x95, 0xf4, 0xad, 0x87, 0x00, 0xd1, 0xb7,
+	0x6e, 0x48, 0x0e, 0x52, 0xed, 0x0e, 0xe3, 0x0d, 0x90, 0xd9, 0x6d, 0xee, 0xdc, 0x6f, 0xe2, 0x3a,
+	0xc4, 0x56, 0x7b, 0x4f, 0x3b, 0xad, 0xf6, 0xbd, 0x4e, 0x25, 0x81, 0xf3, 0x8b, 0x7f, 0x98, 0x88,
+	0x95, 0x93, 0xf8, 0xcd, 0xa2, 0xbd, 0x66, 0xd3, 0xe8, 0x56, 0x52, 0xb7, 0xfe, 0x2f, 0x94, 0xe3,
+	0xc9, 0x56, 0x8c, 0x61, 0x7f, 0x77, 0xb7, 0xf2, 0x0a, 0xce, 0x7b, 0x36, 0x80, 0xbd, 0x07, 0x46,
+	0xb3, 0xfb, 0xa0, 0xb3, 0xbb, 0x53, 0xd1, 0x90, 0x15, 0x83, 0xd5, 0x1f, 0x76, 0x9b, 0x3d, 0xde,
+	0x6d, 0x56, 0x36, 0xea, 0xbd, 0x66, 0x25, 0x89, 0xed, 0xb2, 0x62, 0xb7, 0x8f, 0xbd, 0x2e, 0x41,
+	0xbe, 0x51, 0x37, 0x71, 0xaa, 0x35, 0x71, 0xb5, 0x32, 0xe3, 0xf0, 0xe8, 0x51, 0xbf, 0xdd, 0xea,
+	0x7d, 0x6c, 0x3e, 0xee, 0xf4, 0x9a, 0x95, 0xcc, 0xad, 0xf7, 0xa0, 0xa8, 0x66, 0x88, 0x90, 0x2c,
+	0x24, 0x1b, 0x7b, 0x7d, 0x2e, 0xcd, 0xa3, 0xe6, 0xa3, 0x8e, 0xf1, 0x71, 0x45, 0xc3, 0x2e, 0xed,
+	0xb4, 0xba, 0x0f, 0x2b, 0x09, 0xfc, 0xf5, 0xf4, 0x5e, 0xb3, 0x59, 0x49, 0xde, 0xba, 0x07, 0x05,
+	0x25, 0x62, 0x8e, 0xbc, 0x77, 0x5a, 0x46, 0xb3, 0xc1, 0x06, 0x44, 0x28, 0xa4, 0x02, 0xc5, 0x08,
+	0xd6, 0x6a, 0x57, 0x34, 0x5c, 0xf5, 0x11, 0xa4, 0xd3, 0xef, 0x55, 0x12, 0xb7, 0x3e, 0x86, 0xa2,
+	0x1a, 0x24, 0x40, 0x92, 0x27, 0xf5, 0x56, 0xcf, 0x54, 0x06, 0x8d, 0x40, 0x99, 0x81, 0xc4, 0x70,
+	0x34, 0x51, 0x0f, 0x15, 0x28, 0x32, 0xd8, 0x8e, 0xd1, 0xd9, 0xdb, 0x6b, 0xee, 0x54, 0x12, 0x21,
+	0xa4, 0xd7, 0x7a, 0xd4, 0x44, 0xd6, 0xc9, 0xbb, 0xff, 0x79, 0x19, 0x32, 0x4f, 0x59, 0x6c, 0x91,
+	0xf4, 0xa1, 0x12, 0xdd, 0x9c, 0x6f, 0x9f, 0xb2, 0x2f, 0x03, 0x94, 0xe4, 0x05, 0x1d, 0x4b, 0x09,
+	0xac, 0xcd, 0x5d, 0x63, 0xeb, 0xfa, 0x8f, 0xfe, 0xf9, 0x3f, 0x7e, 0x25, 0xb1, 0xa5, 0x5f, 0xba,
+	0x73, 0xf2, 0xee, 0x1d, 0x9f, 0x55, 0x36, 0xd9, 0x06, 0xbb, 0x7f, 0xca, 0xbe, 0x36, 0xf0, 0x81,
+	0x76, 0x8b, 0x7c, 0x0b, 0x32, 0x7b, 0xae, 0x1f, 0xf4, 0x66, 0x24, 0xf6, 0xb5, 0xad, 0xda, 0x1a,
+	0x77, 0xf1, 0xc2, 0x4f, 0x31, 0xe9, 0x9b, 0x8c, 0x59, 0x45, 0x2f, 0x20, 0xb3, 0x89, 0x8b, 0x07,
+	0xa1, 0x19, 0x32, 0xd8, 0x86, 0x1c, 0x8b, 0x30, 0xd6, 0x1b, 0xbb, 0xbc, 0x3f, 0x61, 0x16, 0x57,
+	0x2d, 0x5e, 0xd4, 0xab, 0x8c, 0x03, 0xd1, 0x4b, 0xc8, 0xe1, 0xfb, 0x58, 0xc7, 0xb4, 0x06, 0x23,
+	0xe4, 0x61, 0xc2, 0x1a, 0xe3, 0xa1, 0xdc, 0x63, 0x5e, 0x8c, 0xdf, 0x8d, 0xf2, 0xdb, 0xe1, 0xda,
+	0x52, 0xa8, 0x7e, 0x9d, 0x31, 0xae, 0xe9, 0x1b, 0x11, 0x63, 0x26, 0xa6, 0xc7, 0x88, 0xb0, 0x81,
+	0x1f, 0xc0, 0x06, 0x6b, 0x60, 0xe1, 0x32, 0xee, 0xca, 0xd2, 0xcb, 0x3b, 0xee, 0x59, 0xd4, 0xb6,
+	0x96, 0x23, 0x45, 0x54, 0xfb, 0x4d, 0xd6, 0xea, 0x0d, 0x7d, 0x2b, 0x6a, 0x35, 0x76, 0xd1, 0x65,
+	0xe2, 0x0d, 0x20, 0x36, 0xfe, 0x43, 0xb8, 0xb0, 0x24, 0x95, 0x86, 0x5c, 0x65, 0x5e, 0xd5, 0xca,
+	0xc4, 0x9e, 0xda, 0xb5, 0x95, 0x78, 0xd1, 0x81, 0xd7, 0x59, 0x07, 0xae, 0xea, 0x97, 0xb1, 0x03,
+	0x87, 0x34, 0x08, 0xbf, 0xce, 0x20, 0xbb, 0xe1, 0x63, 0xeb, 0x1f, 0x42, 0x96, 0x89, 0xbe, 0x30,
+	0xc2, 0xb1, 0x92, 0x7e, 0x89, 0x31, 0x5b, 0xd7, 0x8b, 0x91, 0x34, 0x7c, 0x7c, 0xdb, 0x00, 0xf7,
+	0x69, 0x20, 0xbe, 0x7d, 0x44, 0xd6, 0x95, 0x1b, 0x10, 0xc1, 0x67, 0x11, 0xa4, 0xd7, 0x18, 0xb3,
+	0x8b, 0xfa, 0x9a, 0xec, 0x99, 0xf0, 0x8b, 0x90, 0x9f, 0x0d, 0x95, 0x88, 0x9f, 0xfc, 0x3a, 0x94,
+	0xc2, 0x22, 0xf6, 0x95, 0xa5, 0xda, 0x4a, 0x8c, 0x7e, 0x83, 0xb5, 0x71, 0x45, 0xdf, 0x9c, 0x6b,
+	0xc3, 0x1c, 0x32, 0x9e, 0xd8, 0xd4, 0x77, 0x58, 0x53, 0xfc, 0x93, 0x4a, 0x67, 0x13, 0x60, 0x81,
+	0xb9, 0xf0, 0x9f, 0x14, 0x39, 0xbe, 0x01, 0x39, 0x94, 0x83, 0x65, 0x6e, 0x14, 0xc2, 0xd8, 0x6c,
+	0x6b, 0xa7, 0x16, 0x05, 0x6a, 0xe3, 0x33, 0x9e, 0xf5, 0x11, 0xc1, 0x58, 0xdb, 0xe0, 0x5a, 0xc0,
+	0xe2, 0xf6, 0xa9, 0x38, 0xea, 0xad, 0x85, 0x15, 0x39, 0x40, 0xe5, 0x14, 0x5b, 0xca, 0x21, 0x27,
+	0x5c, 0xc8, 0xdc, 0x0d, 0xe4, 0x23, 0x75, 0x41, 0xf2, 0x64, 0x2e, 0x97, 0xdc, 0x3e, 0xd4, 0xe7,
+	0xbf, 0xb5, 0x58, 0x49, 0xbf, 0xc2, 0xd8, 0x6e, 0xe8, 0x95, 0x90, 0xed, 0x80, 0x87, 0x2c, 0x91,
+	0x5f, 0x0b, 0xca, 0x31, 0x7e, 0x82, 0x95, 0xfc, 0xd8, 0x5a, 0x2d, 0xea, 0x2f, 0x47, 0x4b, 0x71,
+	0x89, 0xc2, 0x8d, 0x3f, 0x26, 0x27, 0x7d, 0x58, 0xbb, 0x4f, 0x03, 0xfe, 0xb0, 0x57, 0xed, 0x56,
+	0xc8, 0x6b, 0x73, 0xf1, 0xe1, 0x2f, 0xb3, 0x3a, 0x5b, 0x8c, 0xe5, 0xa6, 0xbe, 0x2e, 0x59, 0xfa,
+	0xa7, 0x7e, 0xd4, 0xc3, 0x37, 0x21, 0x7f, 0x9f, 0x06, 0x6d, 0x1a, 0xf4, 0x8d, 0xdd, 0x39, 0x86,
+	0xcc, 0xb5, 0xe6, 0x2f, 0x85, 0xf5, 0x57, 0xc8, 0x43, 0x80, 0xc8, 0x78, 0xbe, 0xc8, 0x6c, 0x5e,
+	0x65, 0x6d, 0x56, 0xf5, 0x0b, 0x73, 0x66, 0xd3, 0x37, 0x4f, 0xee, 0x62, 0xab, 0x9f, 0x6a, 0xb0,
+	0xb1, 0x34, 0x9f, 0x89, 0xb0, 0x0f, 0x36, 0x3c, 0x2f, 0xfd, 0xab, 0x76, 0xe3, 0x39, 0x14, 0x62,
+	0x59, 0xc7, 0x86, 0x7a, 0xe2, 0x51, 0x3a, 0xa3, 0x03, 0x53, 0xe9, 0x06, 0x76, 0xe1, 0x3e, 0x94,
+	0xe3, 0xef, 0x19, 0xc9, 0x65, 0xf9, 0x50, 0x65, 0xe1, 0xe1, 0x64, 0xad, 0xb6, 0x0c, 0xc5, 0x1b,
+	0x23, 0x8f, 0xe1, 0xc2, 0x92, 0x77, 0x7f, 0xdc, 0x36, 0xad, 0x7e, 0xcb, 0x58, 0xbb, 0xb6, 0x12,
+	0x2f, 0xf8, 0x76, 0x81, 0x84, 0xe8, 0xf0, 0x65, 0x1d, 0x79, 0x35, 0x56, 0x6d, 0xfe, 0x91, 0x5f,
+	0xed, 0xea, 0x2a, 0xb4, 0x60, 0xfa, 0x6d, 0x58, 0x9b, 0x7b, 0xa8, 0x46, 0x42, 0xd9, 0x16, 0x5f,
+	0xdb, 0xd5, 0xae, 0x2c, 0xc5, 0x09, 0x5e, 0x8f, 0xa0, 0x22, 0x51, 0xf2, 0xa1, 0x15, 0x89, 0x55,
+	0x98, 0x7b, 0x91, 0x56, 0xdb, 0x5a, 0x8e, 0x8c, 0xb3, 0x53, 0x1f, 0x4e, 0x45, 0xec, 0x96, 0xbc,
+	0xdc, 0xaa, 0x6d, 0x2d, 0x47, 0x0a, 0x76, 0x5f, 0x8f, 0xbd, 0x2e, 0xda, 0x98, 0x7b, 0x84, 0x24,
+	0x58, 0x6c, 0xce, 0x83, 0x45, 0x65, 0x0b, 0xca, 0xd1, 0xb6, 0xb1, 0x7d, 0x5a, 0x7f, 0xc8, 0x19,
+	0x2c, 0xa4, 0xc6, 0xd6, 0x36, 0xe7, 0xc1, 0x62, 0x06, 0xc6, 0xf6, 0x53, 0x75, 0x63, 0xd9, 0x3f,
+	0x35, 0x2d, 0x66, 0xbe, 0x4e, 0xf8, 0x96, 0x36, 0x97, 0x44, 0xc1, 0x25, 0x5e, 0x91, 0x91, 0x52,
+	0xdb, 0x5a, 0x8e, 0x5c, 0xb9, 0x99, 0x71, 0xca, 0xf8, 0x66, 0xd6, 0x86, 0xac, 0x58, 0x3c, 0x64,
+	0x69, 0xd2, 0x61, 0x6d, 0x63, 0x0e, 0x2a, 0xb8, 0xc7, 0x9d, 0x17, 0xbe, 0xa6, 0x90, 0xdf, 0xff,
+	0x81, 0x52, 0x24, 0x07, 0x7e, 0x45, 0x65, 0x23, 0x76, 0xc3, 0x1f, 0x57, 0xf5, 0x62, 0xce, 0x41,
+	0xdc, 0x54, 0xa8, 0xbd, 0x0e, 0x66, 0xac, 0xbf, 0x1e, 0x5c, 0x88, 0xf9, 0x1d, 0x3c, 0x26, 0xc7,
+	0x17, 0xeb, 0xd2, 0x30, 0x66, 0xad, 0xb6, 0x0c, 0xb5, 0x4c, 0x47, 0x73, 0x1e, 0x07, 0x0f, 0xbd,
+	0x45, 0x32, 0x45, 0xb7, 0x91, 0x5c, 0xa6, 0x85, 0xbb, 0xd6, 0xda, 0xe6, 0x3c, 0x78, 0x95, 0x4c,
+	0x7c, 0xab, 0xf1, 0x90, 0x08, 0xf9, 0x07, 0x6c, 0xec, 0xe7, 0x2f, 0x7b, 0xb8, 0x4c, 0x4b, 0x2f,
+	0xa9, 0x6a, 0x5b, 0x0b, 0x28, 0x7b, 0xb8, 0x42, 0x2a, 0x6c, 0x6f, 0x1a, 0x51, 0xb2, 0xab, 0x05,
+	0x26, 0x95, 0x03, 0xeb, 0xf3, 0xad, 0x3e, 0xb7, 0xcd, 0xda, 0x32, 0xd4, 0x32, 0x0b, 0xbb, 0xd8,
+	0x22, 0x6b, 0xef, 0x80, 0xed, 0x58, 0xea, 0x65, 0x04, 0x51, 0xe2, 0xf2, 0xf1, 0x85, 0x58, 0x5d,
+	0x44, 0xac, 0x5a, 0x49, 0xc1, 0x6c, 0xe2, 0xba, 0x23, 0x33, 0xda, 0xc2, 0xee, 0x43, 0x86, 0x1f,
+	0x1e, 0xb8, 0x67, 0x12, 0xbb, 0x92, 0xad, 0x11, 0x15, 0xb4, 0x6c, 0x2a, 0x3f, 0xb3, 0x6c, 0xe9,
+	0x87, 0x7f, 0x07, 0x0a, 0x4a, 0x58, 0x91, 0xb0, 0xd1, 0x5d, 0x8c, 0xb1, 0xd6, 0x2e, 0x2d, 0xc0,
+	0x05, 0xdf, 0x98, 0x2b, 0x40, 0x05, 0x81, 0x79, 0x40, 0x69, 0x34, 0xa7, 0xa2, 0x88, 0x16, 0x89,
+	0xbe, 0xae, 0xa9, 0xc6, 0xe2, 0x6a, 0x9b, 0xf3, 0xe0, 0x55, 0x73, 0x2a, 0x40, 0x1a, 0xa6, 0x06,
+	0xd4, 0xc2, 0x7e, 0x86, 0x7d, 0x2c, 0xfb, 0x2b, 0xff, 0x3d, 0x00, 0x1e, 0x97, 0x19, 0xc9, 0x70,
+	0x5b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -6867,6 +8697,29 @@ type XchainClient interface {
 	GetAddressContracts(ctx context.Context, in *AddressContractsRequest, opts ...grpc.CallOption) (*AddressContractsResponse, error)
 	//预执行合约
 	PreExec(ctx context.Context, in *InvokeRPCRequest, opts ...grpc.CallOption) (*InvokeRPCResponse, error)
+	// GetAddressTxs get transactions of an address or account page by page,
+	// address tx index must be enabled
+	GetAddressTxs(ctx context.Context, in *AddressTxsRequest, opts ...grpc.CallOption) (*AddressTxsResponse, error)
+	// QueryContractEvents query historical contract events page by page,
+	// contract event index must be enabled
+	QueryContractEvents(ctx context.Context, in *ContractEventsRequest, opts ...grpc.CallOption) (*ContractEventsResponse, error)
+	// GetBlockRange get trunk blocks by height range page by page
+	GetBlockRange(ctx context.Context, in *BlockRangeRequest, opts ...grpc.CallOption) (*BlockRangeResponse, error)
+	// GetUnconfirmedTxids list unconfirmed txids page by page
+	GetUnconfirmedTxids(ctx context.Context, in *UnconfirmedTxsRequest, opts ...grpc.CallOption) (*UnconfirmedTxidsResponse, error)
+	// GetUnconfirmedTxs get unconfirmed txs page by page, can be filtered by initiator
+	GetUnconfirmedTxs(ctx context.Context, in *UnconfirmedTxsRequest, opts ...grpc.CallOption) (*UnconfirmedTxsResponse, error)
+	// GetTxPoolStatus summarize size, age and conflicts of unconfirmed tx pool
+	GetTxPoolStatus(ctx context.Context, in *TxPoolStatusRequest, opts ...grpc.CallOption) (*TxPoolStatusResponse, error)
+	// WaitTx block until tx is confirmed with enough blocks on top,
+	// dropped from the node, or timeout
+	WaitTx(ctx context.Context, in *WaitTxRequest, opts ...grpc.CallOption) (*WaitTxResponse, error)
+	// EstimateFee pre-execute a draft tx or invoke requests and estimate
+	// gas, fee and utxos needed by the initiator
+	EstimateFee(ctx context.Context, in *EstimateFeeRequest, opts ...grpc.CallOption) (*EstimateFeeResponse, error)
+	// GetTokenStats query total supply, frozen amount and top holders,
+	// token index must be enabled
+	GetTokenStats(ctx context.Context, in *TokenStatsRequest, opts ...grpc.CallOption) (*TokenStatsResponse, error)
 }
 
 type xchainClient struct {
@@ -7129,6 +8982,87 @@ func (c *xchainClient) PreExec(ctx context.Context, in *InvokeRPCRequest, opts .
 	return out, nil
 }
 
+func (c *xchainClient) GetAddressTxs(ctx context.Context, in *AddressTxsRequest, opts ...grpc.CallOption) (*AddressTxsResponse, error) {
+	out := new(AddressTxsResponse)
+	err := c.cc.Invoke(ctx, "/pb.Xchain/GetAddressTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xchainClient) QueryContractEvents(ctx context.Context, in *ContractEventsRequest, opts ...grpc.CallOption) (*ContractEventsResponse, error) {
+	out := new(ContractEventsResponse)
+	err := c.cc.Invoke(ctx, "/pb.Xchain/QueryContractEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xchainClient) GetBlockRange(ctx context.Context, in *BlockRangeRequest, opts ...grpc.CallOption) (*BlockRangeResponse, error) {
+	out := new(BlockRangeResponse)
+	err := c.cc.Invoke(ctx, "/pb.Xchain/GetBlockRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xchainClient) GetUnconfirmedTxids(ctx context.Context, in *UnconfirmedTxsRequest, opts ...grpc.CallOption) (*UnconfirmedTxidsResponse, error) {
+	out := new(UnconfirmedTxidsResponse)
+	err := c.cc.Invoke(ctx, "/pb.Xchain/GetUnconfirmedTxids", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xchainClient) GetUnconfirmedTxs(ctx context.Context, in *UnconfirmedTxsRequest, opts ...grpc.CallOption) (*UnconfirmedTxsResponse, error) {
+	out := new(UnconfirmedTxsResponse)
+	err := c.cc.Invoke(ctx, "/pb.Xchain/GetUnconfirmedTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xchainClient) GetTxPoolStatus(ctx context.Context, in *TxPoolStatusRequest, opts ...grpc.CallOption) (*TxPoolStatusResponse, error) {
+	out := new(TxPoolStatusResponse)
+	err := c.cc.Invoke(ctx, "/pb.Xchain/GetTxPoolStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xchainClient) WaitTx(ctx context.Context, in *WaitTxRequest, opts ...grpc.CallOption) (*WaitTxResponse, error) {
+	out := new(WaitTxResponse)
+	err := c.cc.Invoke(ctx, "/pb.Xchain/WaitTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xchainClient) EstimateFee(ctx context.Context, in *EstimateFeeRequest, opts ...grpc.CallOption) (*EstimateFeeResponse, error) {
+	out := new(EstimateFeeResponse)
+	err := c.cc.Invoke(ctx, "/pb.Xchain/EstimateFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xchainClient) GetTokenStats(ctx context.Context, in *TokenStatsRequest, opts ...grpc.CallOption) (*TokenStatsResponse, error) {
+	out := new(TokenStatsResponse)
+	err := c.cc.Invoke(ctx, "/pb.Xchain/GetTokenStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // XchainServer is the server API for Xchain service.
 type XchainServer interface {
 	// SelectUTXOBySize merge many utxos into a few of utxos
@@ -7189,6 +9123,29 @@ type XchainServer interface {
 	GetAddressContracts(context.Context, *AddressContractsRequest) (*AddressContractsResponse, error)
 	//预执行合约
 	PreExec(context.Context, *InvokeRPCRequest) (*InvokeRPCResponse, error)
+	// GetAddressTxs get transactions of an address or account page by page,
+	// address tx index must be enabled
+	GetAddressTxs(context.Context, *AddressTxsRequest) (*AddressTxsResponse, error)
+	// QueryContractEvents query historical contract events page by page,
+	// contract event index must be enabled
+	QueryContractEvents(context.Context, *ContractEventsRequest) (*ContractEventsResponse, error)
+	// GetBlockRange get trunk blocks by height range page by page
+	GetBlockRange(context.Context, *BlockRangeRequest) (*BlockRangeResponse, error)
+	// GetUnconfirmedTxids list unconfirmed txids page by page
+	GetUnconfirmedTxids(context.Context, *UnconfirmedTxsRequest) (*UnconfirmedTxidsResponse, error)
+	// GetUnconfirmedTxs get unconfirmed txs page by page, can be filtered by initiator
+	GetUnconfirmedTxs(context.Context, *UnconfirmedTxsRequest) (*UnconfirmedTxsResponse, error)
+	// GetTxPoolStatus summarize size, age and conflicts of unconfirmed tx pool
+	GetTxPoolStatus(context.Context, *TxPoolStatusRequest) (*TxPoolStatusResponse, error)
+	// WaitTx block until tx is confirmed with enough blocks on top,
+	// dropped from the node, or timeout
+	WaitTx(context.Context, *WaitTxRequest) (*WaitTxResponse, error)
+	// EstimateFee pre-execute a draft tx or invoke requests and estimate
+	// gas, fee and utxos needed by the initiator
+	EstimateFee(context.Context, *EstimateFeeRequest) (*EstimateFeeResponse, error)
+	// GetTokenStats query total supply, frozen amount and top holders,
+	// token index must be enabled
+	GetTokenStats(context.Context, *TokenStatsRequest) (*TokenStatsResponse, error)
 }
 
 // UnimplementedXchainServer can be embedded to have forward compatible implementations.
@@ -7279,6 +9236,33 @@ func (*UnimplementedXchainServer) GetAddressContracts(ctx context.Context, req *
 func (*UnimplementedXchainServer) PreExec(ctx context.Context, req *InvokeRPCRequest) (*InvokeRPCResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PreExec not implemented")
 }
+func (*UnimplementedXchainServer) GetAddressTxs(ctx context.Context, req *AddressTxsRequest) (*AddressTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAddressTxs not implemented")
+}
+func (*UnimplementedXchainServer) QueryContractEvents(ctx context.Context, req *ContractEventsRequest) (*ContractEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryContractEvents not implemented")
+}
+func (*UnimplementedXchainServer) GetBlockRange(ctx context.Context, req *BlockRangeRequest) (*BlockRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockRange not implemented")
+}
+func (*UnimplementedXchainServer) GetUnconfirmedTxids(ctx context.Context, req *UnconfirmedTxsRequest) (*UnconfirmedTxidsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUnconfirmedTxids not implemented")
+}
+func (*UnimplementedXchainServer) GetUnconfirmedTxs(ctx context.Context, req *UnconfirmedTxsRequest) (*UnconfirmedTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUnconfirmedTxs not implemented")
+}
+func (*UnimplementedXchainServer) GetTxPoolStatus(ctx context.Context, req *TxPoolStatusRequest) (*TxPoolStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTxPoolStatus not implemented")
+}
+func (*UnimplementedXchainServer) WaitTx(ctx context.Context, req *WaitTxRequest) (*WaitTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WaitTx not implemented")
+}
+func (*UnimplementedXchainServer) EstimateFee(ctx context.Context, req *EstimateFeeRequest) (*EstimateFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateFee not implemented")
+}
+func (*UnimplementedXchainServer) GetTokenStats(ctx context.Context, req *TokenStatsRequest) (*TokenStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTokenStats not implemented")
+}
 
 func RegisterXchainServer(s *grpc.Server, srv XchainServer) {
 	s.RegisterService(&_Xchain_serviceDesc, srv)
@@ -7788,6 +9772,168 @@ func _Xchain_PreExec_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Xchain_GetAddressTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddressTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XchainServer).GetAddressTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Xchain/GetAddressTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XchainServer).GetAddressTxs(ctx, req.(*AddressTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Xchain_QueryContractEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContractEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XchainServer).QueryContractEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Xchain/QueryContractEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XchainServer).QueryContractEvents(ctx, req.(*ContractEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Xchain_GetBlockRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XchainServer).GetBlockRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Xchain/GetBlockRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XchainServer).GetBlockRange(ctx, req.(*BlockRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Xchain_GetUnconfirmedTxids_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnconfirmedTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XchainServer).GetUnconfirmedTxids(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Xchain/GetUnconfirmedTxids",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XchainServer).GetUnconfirmedTxids(ctx, req.(*UnconfirmedTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Xchain_GetUnconfirmedTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnconfirmedTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XchainServer).GetUnconfirmedTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Xchain/GetUnconfirmedTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XchainServer).GetUnconfirmedTxs(ctx, req.(*UnconfirmedTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Xchain_GetTxPoolStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxPoolStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XchainServer).GetTxPoolStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Xchain/GetTxPoolStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XchainServer).GetTxPoolStatus(ctx, req.(*TxPoolStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Xchain_WaitTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaitTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XchainServer).WaitTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Xchain/WaitTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XchainServer).WaitTx(ctx, req.(*WaitTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Xchain_EstimateFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XchainServer).EstimateFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Xchain/EstimateFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XchainServer).EstimateFee(ctx, req.(*EstimateFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Xchain_GetTokenStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TokenStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XchainServer).GetTokenStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Xchain/GetTokenStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XchainServer).GetTokenStats(ctx, req.(*TokenStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Xchain_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "pb.Xchain",
 	HandlerType: (*XchainServer)(nil),
@@ -7904,6 +10050,42 @@ var _Xchain_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PreExec",
 			Handler:    _Xchain_PreExec_Handler,
 		},
+		{
+			MethodName: "GetAddressTxs",
+			Handler:    _Xchain_GetAddressTxs_Handler,
+		},
+		{
+			MethodName: "QueryContractEvents",
+			Handler:    _Xchain_QueryContractEvents_Handler,
+		},
+		{
+			MethodName: "GetBlockRange",
+			Handler:    _Xchain_GetBlockRange_Handler,
+		},
+		{
+			MethodName: "GetUnconfirmedTxids",
+			Handler:    _Xchain_GetUnconfirmedTxids_Handler,
+		},
+		{
+			MethodName: "GetUnconfirmedTxs",
+			Handler:    _Xchain_GetUnconfirmedTxs_Handler,
+		},
+		{
+			MethodName: "GetTxPoolStatus",
+			Handler:    _Xchain_GetTxPoolStatus_Handler,
+		},
+		{
+			MethodName: "WaitTx",
+			Handler:    _Xchain_WaitTx_Handler,
+		},
+		{
+			MethodName: "EstimateFee",
+			Handler:    _Xchain_EstimateFee_Handler,
+		},
+		{
+			MethodName: "GetTokenStats",
+			Handler:    _Xchain_GetTokenStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "xchain.proto",
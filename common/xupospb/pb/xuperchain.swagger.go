@@ -0,0 +1,3449 @@
+// Code generated by build.sh. DO NOT EDIT.
+// source: xuperchain.swagger.json
+
+package pb
+
+// OpenAPISpec is the openapi v2 spec generated from google.api.http annotations
+const OpenAPISpec = `{
+  "swagger": "2.0",
+  "info": {
+    "title": "xchain.proto",
+    "version": "version not set"
+  },
+  "consumes": [
+    "application/json"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "paths": {
+    "/v1/endorsercall": {
+      "post": {
+        "operationId": "xendorser_EndorserCall",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/pbEndorserResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/pbEndorserRequest"
+            }
+          }
+        ],
+        "tags": [
+          "xendorser"
+        ]
+      }
+    },
+    "/v1/estimate_fee": {
+      "post": {
+        "summary": "EstimateFee pre-execute a draft tx or invoke requests and estimate\ngas, fee and utxos needed by the initiator",
+        "operationId": "Xchain_EstimateFee",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/pbEstimateFeeResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/pbEstimateFeeRequest"
+            }
+          }
+        ],
+        "tags": [
+          "Xchain"
+        ]
+      }
+    },
+    "/v1/get_account_by_ak": {
+      "post": {
+        "summary": "GetAccountByAK get account sets contain a specific address",
+        "operationId": "Xchain_GetAccountByAK",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/pbAK2AccountResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/pbAK2AccountRequest"
+            }
+          }
+        ],
+        "tags": [
+          "Xchain"
+        ]
+      }
+    },
+    "/v1/get_account_contracts": {
+      "post": {
+        "operationId": "Xchain_GetAccountContracts",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/pbGetAccountContractsResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/pbGetAccountContractsRequest"
+            }
+          }
+        ],
+        "tags": [
+          "Xchain"
+        ]
+      }
+    },
+    "/v1/get_address_contracts": {
+      "post": {
+        "summary": "GetAddressContracts get contracts of accounts contain a specific address",
+        "operationId": "Xchain_GetAddressContracts",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/pbAddressContractsResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/pbAddressContractsRequest"
+            }
+          }
+        ],
+        "tags": [
+          "Xchain"
+        ]
+      }
+    },
+    "/v1/get_address_txs": {
+      "post": {
+        "summary": "GetAddressTxs get transactions of an address or account page by page,\naddress tx index must be enabled",
+        "operationId": "Xchain_GetAddressTxs",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/pbAddressTxsResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/pbAddressTxsRequest"
+            }
+          }
+        ],
+        "tags": [
+          "Xchain"
+        ]
+      }
+    },
+    "/v1/get_balance": {
+      "post": {
+        "summary": "GetBalance get balance of an address,\nAddress is required for this",
+        "operationId": "Xchain_GetBalance",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/pbAddressStatus"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/pbAddressStatus"
+            }
+          }
+        ],
+        "tags": [
+          "Xchain"
+        ]
+      }
+    },
+    "/v1/get_balance_detail": {
+      "post": {
+        "summary": "GetFrozenBalance get two kinds of balance\n1. Still be frozen of an address\n2. Available now of an address\nAddress is required for this",
+        "operationId": "Xchain_GetBalanceDetail",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/pbAddressBalanceStatus"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/pbAddressBalanceStatus"
+            }
+          }
+        ],
+        "tags": [
+          "Xchain"
+        ]
+      }
+    },
+    "/v1/get_bcchains": {
+      "get": {
+        "summary": "Get blockchains query blockchains",
+        "operationId": "Xchain_GetBlockChains",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/pbBlockChains"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "header.logid",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "header.from_node",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "header.error",
+            "in": "query",
+            "required": false,
+            "type": "string",
+            "enum": [
+              "SUCCESS",
+              "UNKNOW_ERROR",
+              "CONNECT_REFUSE",
+              "NOT_ENOUGH_UTXO_ERROR",
+              "UTXOVM_ALREADY_UNCONFIRM_ERROR",
+              "UTXOVM_NOT_FOUND_ERROR",
+              "INPUT_OUTPUT_NOT_EQUAL_ERROR",
+              "TX_NOT_FOUND_ERROR",
+              "TX_SIGN_ERROR",
+              "BLOCKCHAIN_NOTEXIST",
+              "VALIDATE_ERROR",
+              "CANNOT_SYNC_BLOCK_ERROR",
+              "CONFIRM_BLOCK_ERROR",
+              "UTXOVM_PLAY_ERROR",
+              "WALK_ERROR",
+              "NOT_READY_ERROR",
+              "BLOCK_EXIST_ERROR",
+              "ROOT_BLOCK_EXIST_ERROR",
+              "TX_DUPLICATE_ERROR",
+              "SERVICE_REFUSED_ERROR",
+              "TXDATA_SIGN_ERROR",
+              "TX_SLE_ERROR",
+              "TX_FEE_NOT_ENOUGH_ERROR",
+              "UTXO_SIGN_ERROR",
+              "DPOS_QUERY_ERROR",
+              "RWSET_INVALID_ERROR",
+              "RWACL_INVALID_ERROR",
+              "GAS_NOT_ENOUGH_ERROR",
+              "TX_VERSION_INVALID_ERROR",
+              "COMPLIANCE_CHECK_NOT_APPROVED",
+              "ACCOUNT_CONTRACT_STATUS_ERROR",
+              "TX_VERIFICATION_ERROR"
+            ],
+            "default": "SUCCESS"
+          },
+          {
+            "name": "view_option",
+            "description": " - NONE: Without any flag: Default\n - LEDGER: Ledger flag: Get Ledger Info\n - UTXOINFO: Utxo flag: Get UTXO Info\n - BRANCHINFO: Branch flag: Get BranchId Info\n - PEERS: Peers flag: Get Peers Info",
+            "in": "query",
+            "required": false,
+            "type": "string",
+            "enum": [
+              "NONE",
+              "LEDGER",
+              "UTXOINFO",
+              "BRANCHINFO",
+              "PEERS"
+            ],
+            "default": "NONE"
+          }
+        ],
+        "tags": [
+          "Xchain"
+        ]
+      }
+    },
+    "/v1/get_bcstatus": {
+      "post": {
+        "operationId": "Xchain_GetBlockChainStatus",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/pbBCStatus"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/pbBCStatus"
+            }
+          }
+        ],
+        "tags": [
+          "Xchain"
+        ]
+      }
+    },
+    "/v1/get_block": {
+      "post": {
+        "summary": "GetBlock get block by blockid and return if the block in trunk or in branch",
+        "operationId": "Xchain_GetBlock",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/pbBlock"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/pbBlockID"
+            }
+          }
+        ],
+        "tags": [
+          "Xchain"
+        ]
+      }
+    },
+    "/v1/get_block_by_height": {
+      "post": {
+        "summary": "GetBlockByHeight get block by height and return if the block in trunk or in\nbranch",
+        "operationId": "Xchain_GetBlockByHeight",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/pbBlock"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/pbBlockHeight"
+            }
+          }
+        ],
+        "tags": [
+          "Xchain"
+        ]
+      }
+    },
+    "/v1/get_block_range": {
+      "post": {
+        "summary": "GetBlockRange get trunk blocks by height range page by page",
+        "operationId": "Xchain_GetBlockRange",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/pbBlockRangeResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/pbBlockRangeRequest"
+            }
+          }
+        ],
+        "tags": [
+          "Xchain"
+        ]
+      }
+    },
+    "/v1/get_frozen_balance": {
+      "post": {
+        "summary": "GetFrozenBalance get balance that still be frozen of an address,\nAddress is required for this",
+        "operationId": "Xchain_GetFrozenBalance",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/pbAddressStatus"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/pbAddressStatus"
+            }
+          }
+        ],
+        "tags": [
+          "Xchain"
+        ]
+      }
+    },
+    "/v1/get_sysstatus": {
+      "post": {
+        "summary": "GetSystemStatus query system status",
+        "operationId": "Xchain_GetSystemStatus",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/pbSystemsStatusReply"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/pbCommonIn"
+            }
+          }
+        ],
+        "tags": [
+          "Xchain"
+        ]
+      }
+    },
+    "/v1/get_token_stats": {
+      "post": {
+        "summary": "GetTokenStats query total supply, frozen amount and top holders,\ntoken index must be enabled",
+        "operationId": "Xchain_GetTokenStats",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/pbTokenStatsResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/pbTokenStatsRequest"
+            }
+          }
+        ],
+        "tags": [
+          "Xchain"
+        ]
+      }
+    },
+    "/v1/get_txpool_status": {
+      "post": {
+        "summary": "GetTxPoolStatus summarize size, age and conflicts of unconfirmed tx pool",
+        "operationId": "Xchain_GetTxPoolStatus",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/pbTxPoolStatusResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/pbTxPoolStatusRequest"
+            }
+          }
+        ],
+        "tags": [
+          "Xchain"
+        ]
+      }
+    },
+    "/v1/get_unconfirmed_txids": {
+      "post": {
+        "summary": "GetUnconfirmedTxids list unconfirmed txids page by page",
+        "operationId": "Xchain_GetUnconfirmedTxids",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/pbUnconfirmedTxidsResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/pbUnconfirmedTxsRequest"
+            }
+          }
+        ],
+        "tags": [
+          "Xchain"
+        ]
+      }
+    },
+    "/v1/get_unconfirmed_txs": {
+      "post": {
+        "summary": "GetUnconfirmedTxs get unconfirmed txs page by page, can be filtered by initiator",
+        "operationId": "Xchain_GetUnconfirmedTxs",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/pbUnconfirmedTxsResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/pbUnconfirmedTxsRequest"
+            }
+          }
+        ],
+        "tags": [
+          "Xchain"
+        ]
+      }
+    },
+    "/v1/post_tx": {
+      "post": {
+        "summary": "PostTx post Transaction to a node",
+        "operationId": "Xchain_PostTx",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/pbCommonReply"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/pbTxStatus"
+            }
+          }
+        ],
+        "tags": [
+          "Xchain"
+        ]
+      }
+    },
+    "/v1/preexec": {
+      "post": {
+        "summary": "预执行合约",
+        "operationId": "Xchain_PreExec",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/pbInvokeRPCResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/pbInvokeRPCRequest"
+            }
+          }
+        ],
+        "tags": [
+          "Xchain"
+        ]
+      }
+    },
+    "/v1/preexec_select_utxo": {
+      "post": {
+        "summary": "PreExecWithSelectUTXO preExec \u0026 selectUtxo",
+        "operationId": "Xchain_PreExecWithSelectUTXO",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/pbPreExecWithSelectUTXOResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/pbPreExecWithSelectUTXORequest"
+            }
+          }
+        ],
+        "tags": [
+          "Xchain"
+        ]
+      }
+    },
+    "/v1/query_acl": {
+      "post": {
+        "operationId": "Xchain_QueryACL",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/pbAclStatus"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/pbAclStatus"
+            }
+          }
+        ],
+        "tags": [
+          "Xchain"
+        ]
+      }
+    },
+    "/v1/query_contract_events": {
+      "post": {
+        "summary": "QueryContractEvents query historical contract events page by page,\ncontract event index must be enabled",
+        "operationId": "Xchain_QueryContractEvents",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/pbContractEventsResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/pbContractEventsRequest"
+            }
+          }
+        ],
+        "tags": [
+          "Xchain"
+        ]
+      }
+    },
+    "/v1/query_contract_stat_data": {
+      "post": {
+        "operationId": "Xchain_QueryContractStatData",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/pbContractStatDataResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/pbContractStatDataRequest"
+            }
+          }
+        ],
+        "tags": [
+          "Xchain"
+        ]
+      }
+    },
+    "/v1/query_tx": {
+      "post": {
+        "summary": "QueryTx query Transaction by TxStatus,\nBcname and Txid are required for this",
+        "operationId": "Xchain_QueryTx",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/pbTxStatus"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/pbTxStatus"
+            }
+          }
+        ],
+        "tags": [
+          "Xchain"
+        ]
+      }
+    },
+    "/v1/query_utxo_record": {
+      "post": {
+        "operationId": "Xchain_QueryUtxoRecord",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/pbUtxoRecordDetail"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/pbUtxoRecordDetail"
+            }
+          }
+        ],
+        "tags": [
+          "Xchain"
+        ]
+      }
+    },
+    "/v1/select_utxo_by_size": {
+      "post": {
+        "summary": "SelectUTXOBySize merge many utxos into a few of utxos",
+        "operationId": "Xchain_SelectUTXOBySize",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/pbUtxoOutput"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/pbUtxoInput"
+            }
+          }
+        ],
+        "tags": [
+          "Xchain"
+        ]
+      }
+    },
+    "/v1/select_utxos_v2": {
+      "post": {
+        "summary": "新的Select utxos接口, 不需要签名，可以支持选择账户的utxo",
+        "operationId": "Xchain_SelectUTXO",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/pbUtxoOutput"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/pbUtxoInput"
+            }
+          }
+        ],
+        "tags": [
+          "Xchain"
+        ]
+      }
+    },
+    "/v1/wait_tx": {
+      "post": {
+        "summary": "WaitTx block until tx is confirmed with enough blocks on top,\ndropped from the node, or timeout",
+        "operationId": "Xchain_WaitTx",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/pbWaitTxResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/pbWaitTxRequest"
+            }
+          }
+        ],
+        "tags": [
+          "Xchain"
+        ]
+      }
+    }
+  },
+  "definitions": {
+    "BlockEBlockStatus": {
+      "type": "string",
+      "enum": [
+        "ERROR",
+        "TRUNK",
+        "BRANCH",
+        "NOEXIST"
+      ],
+      "default": "ERROR"
+    },
+    "pbAK2AccountRequest": {
+      "type": "object",
+      "properties": {
+        "header": {
+          "$ref": "#/definitions/pbHeader"
+        },
+        "bcname": {
+          "type": "string"
+        },
+        "address": {
+          "type": "string"
+        }
+      }
+    },
+    "pbAK2AccountResponse": {
+      "type": "object",
+      "properties": {
+        "header": {
+          "$ref": "#/definitions/pbHeader"
+        },
+        "bcname": {
+          "type": "string"
+        },
+        "account": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "pbAcl": {
+      "type": "object",
+      "properties": {
+        "pm": {
+          "$ref": "#/definitions/pbPermissionModel"
+        },
+        "aksWeight": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "number",
+            "format": "double"
+          }
+        },
+        "akSets": {
+          "$ref": "#/definitions/pbAkSets"
+        }
+      },
+      "title": "Acl实际使用的结构"
+    },
+    "pbAclStatus": {
+      "type": "object",
+      "properties": {
+        "header": {
+          "$ref": "#/definitions/pbHeader"
+        },
+        "bcname": {
+          "type": "string"
+        },
+        "accountName": {
+          "type": "string"
+        },
+        "contractName": {
+          "type": "string"
+        },
+        "methodName": {
+          "type": "string"
+        },
+        "confirmed": {
+          "type": "boolean"
+        },
+        "acl": {
+          "$ref": "#/definitions/pbAcl"
+        },
+        "height": {
+          "type": "string",
+          "format": "int64",
+          "title": "查询指定高度的历史ACL，blockid优先\nheight大于0或hasHeight为true时按高度查询，查询创世块需设置hasHeight，都未设置表示当前状态"
+        },
+        "blockid": {
+          "type": "string",
+          "format": "byte"
+        },
+        "hasHeight": {
+          "type": "boolean"
+        }
+      },
+      "title": "查询Acl"
+    },
+    "pbAddressBalanceStatus": {
+      "type": "object",
+      "properties": {
+        "header": {
+          "$ref": "#/definitions/pbHeader"
+        },
+        "address": {
+          "type": "string"
+        },
+        "tfds": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/pbTokenFrozenDetails"
+          }
+        }
+      }
+    },
+    "pbAddressContractsRequest": {
+      "type": "object",
+      "properties": {
+        "header": {
+          "$ref": "#/definitions/pbHeader"
+        },
+        "bcname": {
+          "type": "string"
+        },
+        "address": {
+          "type": "string"
+        },
+        "need_content": {
+          "type": "boolean"
+        }
+      },
+      "title": "Query address contracts request"
+    },
+    "pbAddressContractsResponse": {
+      "type": "object",
+      "properties": {
+        "header": {
+          "$ref": "#/definitions/pbHeader"
+        },
+        "contracts": {
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/pbContractList"
+          }
+        }
+      },
+      "title": "Query address contracts response"
+    },
+    "pbAddressStatus": {
+      "type": "object",
+      "properties": {
+        "header": {
+          "$ref": "#/definitions/pbHeader"
+        },
+        "address": {
+          "type": "string"
+        },
+        "bcs": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/pbTokenDetail"
+          }
+        },
+        "height": {
+          "type": "string",
+          "format": "int64",
+          "title": "查询指定高度的历史余额，blockid优先\nheight大于0或hasHeight为true时按高度查询，查询创世块需设置hasHeight，都未设置表示当前状态"
+        },
+        "blockid": {
+          "type": "string",
+          "format": "byte"
+        },
+        "hasHeight": {
+          "type": "boolean"
+        }
+      }
+    },
+    "pbAddressTx": {
+      "type": "object",
+      "properties": {
+        "height": {
+          "type": "string",
+          "format": "int64"
+        },
+        "txid": {
+          "type": "string",
+          "format": "byte"
+        },
+        "direction": {
+          "$ref": "#/definitions/pbTxDirection"
+        },
+        "amount": {
+          "type": "string"
+        },
+        "timestamp": {
+          "type": "string",
+          "format": "int64"
+        }
+      }
+    },
+    "pbAddressTxsRequest": {
+      "type": "object",
+      "properties": {
+        "header": {
+          "$ref": "#/definitions/pbHeader"
+        },
+        "bcname": {
+          "type": "string"
+        },
+        "address": {
+          "type": "string"
+        },
+        "cursor": {
+          "type": "string"
+        },
+        "limit": {
+          "type": "integer",
+          "format": "int32"
+        }
+      }
+    },
+    "pbAddressTxsResponse": {
+      "type": "object",
+      "properties": {
+        "header": {
+          "$ref": "#/definitions/pbHeader"
+        },
+        "bcname": {
+          "type": "string"
+        },
+        "address": {
+          "type": "string"
+        },
+        "txs": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/pbAddressTx"
+          }
+        },
+        "next_cursor": {
+          "type": "string"
+        }
+      },
+      "title": "Query address txs response, txs are ordered from newest to oldest"
+    },
+    "pbAkSet": {
+      "type": "object",
+      "properties": {
+        "aks": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      },
+      "title": "AK集的表示方法"
+    },
+    "pbAkSets": {
+      "type": "object",
+      "properties": {
+        "sets": {
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/pbAkSet"
+          }
+        },
+        "expression": {
+          "type": "string"
+        }
+      }
+    },
+    "pbBCSpeeds": {
+      "type": "object",
+      "properties": {
+        "BcSpeed": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "number",
+            "format": "double"
+          }
+        }
+      }
+    },
+    "pbBCStatus": {
+      "type": "object",
+      "properties": {
+        "header": {
+          "$ref": "#/definitions/pbHeader"
+        },
+        "bcname": {
+          "type": "string",
+          "title": "block name"
+        },
+        "meta": {
+          "$ref": "#/definitions/pbLedgerMeta",
+          "title": "ledger metadata"
+        },
+        "block": {
+          "$ref": "#/definitions/pbInternalBlock",
+          "title": "The information of the longest block"
+        },
+        "utxoMeta": {
+          "$ref": "#/definitions/pbUtxoMeta",
+          "title": "Utox information"
+        },
+        "branchBlockid": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "Branch info"
+        }
+      },
+      "title": "BlockChain status"
+    },
+    "pbBlock": {
+      "type": "object",
+      "properties": {
+        "header": {
+          "$ref": "#/definitions/pbHeader"
+        },
+        "bcname": {
+          "type": "string"
+        },
+        "blockid": {
+          "type": "string",
+          "format": "byte"
+        },
+        "status": {
+          "$ref": "#/definitions/BlockEBlockStatus"
+        },
+        "block": {
+          "$ref": "#/definitions/pbInternalBlock"
+        }
+      }
+    },
+    "pbBlockChains": {
+      "type": "object",
+      "properties": {
+        "header": {
+          "$ref": "#/definitions/pbHeader"
+        },
+        "blockchains": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "pbBlockHeight": {
+      "type": "object",
+      "properties": {
+        "header": {
+          "$ref": "#/definitions/pbHeader"
+        },
+        "bcname": {
+          "type": "string"
+        },
+        "height": {
+          "type": "string",
+          "format": "int64"
+        }
+      }
+    },
+    "pbBlockID": {
+      "type": "object",
+      "properties": {
+        "header": {
+          "$ref": "#/definitions/pbHeader"
+        },
+        "bcname": {
+          "type": "string"
+        },
+        "blockid": {
+          "type": "string",
+          "format": "byte"
+        },
+        "need_content": {
+          "type": "boolean",
+          "title": "if need content"
+        }
+      }
+    },
+    "pbBlockRangeRequest": {
+      "type": "object",
+      "properties": {
+        "header": {
+          "$ref": "#/definitions/pbHeader"
+        },
+        "bcname": {
+          "type": "string"
+        },
+        "from": {
+          "type": "string",
+          "format": "int64"
+        },
+        "to": {
+          "type": "string",
+          "format": "int64"
+        },
+        "need_content": {
+          "type": "boolean"
+        },
+        "exclude_tx": {
+          "type": "boolean"
+        },
+        "limit": {
+          "type": "integer",
+          "format": "int32"
+        }
+      }
+    },
+    "pbBlockRangeResponse": {
+      "type": "object",
+      "properties": {
+        "header": {
+          "$ref": "#/definitions/pbHeader"
+        },
+        "bcname": {
+          "type": "string"
+        },
+        "blocks": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/pbBlock"
+          }
+        },
+        "next_height": {
+          "type": "string",
+          "format": "int64"
+        },
+        "has_more": {
+          "type": "boolean"
+        }
+      },
+      "title": "Get block range response, blocks are ordered by height"
+    },
+    "pbCommonIn": {
+      "type": "object",
+      "properties": {
+        "header": {
+          "$ref": "#/definitions/pbHeader"
+        },
+        "view_option": {
+          "$ref": "#/definitions/pbViewOption"
+        }
+      }
+    },
+    "pbCommonReply": {
+      "type": "object",
+      "properties": {
+        "header": {
+          "$ref": "#/definitions/pbHeader"
+        }
+      }
+    },
+    "pbContractEventInfo": {
+      "type": "object",
+      "properties": {
+        "contract": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "body": {
+          "type": "string",
+          "format": "byte"
+        },
+        "txid": {
+          "type": "string",
+          "format": "byte"
+        },
+        "height": {
+          "type": "string",
+          "format": "int64"
+        },
+        "initiator": {
+          "type": "string"
+        },
+        "timestamp": {
+          "type": "string",
+          "format": "int64"
+        }
+      }
+    },
+    "pbContractEventsRequest": {
+      "type": "object",
+      "properties": {
+        "header": {
+          "$ref": "#/definitions/pbHeader"
+        },
+        "bcname": {
+          "type": "string"
+        },
+        "contract": {
+          "type": "string"
+        },
+        "event_name": {
+          "type": "string"
+        },
+        "initiator": {
+          "type": "string"
+        },
+        "start_height": {
+          "type": "string",
+          "format": "int64"
+        },
+        "end_height": {
+          "type": "string",
+          "format": "int64"
+        },
+        "cursor": {
+          "type": "string"
+        },
+        "limit": {
+          "type": "integer",
+          "format": "int32"
+        }
+      }
+    },
+    "pbContractEventsResponse": {
+      "type": "object",
+      "properties": {
+        "header": {
+          "$ref": "#/definitions/pbHeader"
+        },
+        "bcname": {
+          "type": "string"
+        },
+        "events": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/pbContractEventInfo"
+          }
+        },
+        "next_cursor": {
+          "type": "string"
+        }
+      },
+      "title": "Query contract events response, events are ordered from oldest to newest"
+    },
+    "pbContractList": {
+      "type": "object",
+      "properties": {
+        "contract_status": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/pbContractStatus"
+          }
+        }
+      }
+    },
+    "pbContractResponse": {
+      "type": "object",
+      "properties": {
+        "status": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "message": {
+          "type": "string"
+        },
+        "body": {
+          "type": "string",
+          "format": "byte"
+        }
+      },
+      "title": "ContractResponse is the response returnd by contract"
+    },
+    "pbContractStatData": {
+      "type": "object",
+      "properties": {
+        "accountCount": {
+          "type": "string",
+          "format": "int64"
+        },
+        "contractCount": {
+          "type": "string",
+          "format": "int64"
+        }
+      }
+    },
+    "pbContractStatDataRequest": {
+      "type": "object",
+      "properties": {
+        "header": {
+          "$ref": "#/definitions/pbHeader"
+        },
+        "bcname": {
+          "type": "string"
+        }
+      }
+    },
+    "pbContractStatDataResponse": {
+      "type": "object",
+      "properties": {
+        "header": {
+          "$ref": "#/definitions/pbHeader"
+        },
+        "bcname": {
+          "type": "string"
+        },
+        "data": {
+          "$ref": "#/definitions/pbContractStatData"
+        }
+      }
+    },
+    "pbContractStatus": {
+      "type": "object",
+      "properties": {
+        "contract_name": {
+          "type": "string"
+        },
+        "txid": {
+          "type": "string"
+        },
+        "desc": {
+          "type": "string",
+          "format": "byte"
+        },
+        "is_banned": {
+          "type": "boolean"
+        },
+        "timestamp": {
+          "type": "string",
+          "format": "int64"
+        },
+        "runtime": {
+          "type": "string"
+        }
+      },
+      "title": "Status of a contract"
+    },
+    "pbDposCandidatesResponse": {
+      "type": "object",
+      "properties": {
+        "header": {
+          "$ref": "#/definitions/pbHeader"
+        },
+        "candidatesInfo": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      },
+      "title": "候选人列表返回"
+    },
+    "pbDposCheckResultsResponse": {
+      "type": "object",
+      "properties": {
+        "header": {
+          "$ref": "#/definitions/pbHeader"
+        },
+        "term": {
+          "type": "string",
+          "format": "int64"
+        },
+        "checkResult": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      },
+      "title": "查询检票结果记录返回"
+    },
+    "pbDposNominateInfo": {
+      "type": "object",
+      "properties": {
+        "candidate": {
+          "type": "string"
+        },
+        "txid": {
+          "type": "string"
+        }
+      },
+      "title": "候选人信息"
+    },
+    "pbDposNominateRecordsResponse": {
+      "type": "object",
+      "properties": {
+        "header": {
+          "$ref": "#/definitions/pbHeader"
+        },
+        "nominateRecords": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/pbDposNominateInfo"
+          }
+        }
+      },
+      "title": "提名者提名记录返回"
+    },
+    "pbDposNomineeRecordsResponse": {
+      "type": "object",
+      "properties": {
+        "header": {
+          "$ref": "#/definitions/pbHeader"
+        },
+        "txid": {
+          "type": "string"
+        }
+      },
+      "title": "候选人被提名记录返回"
+    },
+    "pbDposStatus": {
+      "type": "object",
+      "properties": {
+        "term": {
+          "type": "string",
+          "format": "int64"
+        },
+        "block_num": {
+          "type": "string",
+          "format": "int64"
+        },
+        "proposer": {
+          "type": "string"
+        },
+        "proposer_num": {
+          "type": "string",
+          "format": "int64"
+        },
+        "checkResult": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "pbDposStatusResponse": {
+      "type": "object",
+      "properties": {
+        "header": {
+          "$ref": "#/definitions/pbHeader"
+        },
+        "status": {
+          "$ref": "#/definitions/pbDposStatus"
+        }
+      },
+      "title": "query dpos consensus current status reply"
+    },
+    "pbDposVoteRecordsResponse": {
+      "type": "object",
+      "properties": {
+        "header": {
+          "$ref": "#/definitions/pbHeader"
+        },
+        "voteTxidRecords": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/pbvoteRecord"
+          },
+          "title": "选民投票txid记录"
+        }
+      },
+      "title": "选民投票记录返回"
+    },
+    "pbDposVotedRecordsResponse": {
+      "type": "object",
+      "properties": {
+        "header": {
+          "$ref": "#/definitions/pbHeader"
+        },
+        "votedTxidRecords": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/pbvotedRecord"
+          },
+          "title": "候选人被投票的txid记录"
+        }
+      },
+      "title": "候选人被投票记录返回"
+    },
+    "pbEndorserRequest": {
+      "type": "object",
+      "properties": {
+        "header": {
+          "$ref": "#/definitions/pbHeader"
+        },
+        "RequestName": {
+          "type": "string"
+        },
+        "BcName": {
+          "type": "string"
+        },
+        "Fee": {
+          "$ref": "#/definitions/pbTransaction"
+        },
+        "RequestData": {
+          "type": "string",
+          "format": "byte"
+        }
+      },
+      "title": "请求参数"
+    },
+    "pbEndorserResponse": {
+      "type": "object",
+      "properties": {
+        "header": {
+          "$ref": "#/definitions/pbHeader"
+        },
+        "ResponseName": {
+          "type": "string"
+        },
+        "EndorserAddress": {
+          "type": "string"
+        },
+        "EndorserSign": {
+          "$ref": "#/definitions/pbSignatureInfo"
+        },
+        "ResponseData": {
+          "type": "string",
+          "format": "byte"
+        }
+      }
+    },
+    "pbEstimateFeeRequest": {
+      "type": "object",
+      "properties": {
+        "header": {
+          "$ref": "#/definitions/pbHeader"
+        },
+        "bcname": {
+          "type": "string"
+        },
+        "tx": {
+          "$ref": "#/definitions/pbTransaction"
+        },
+        "requests": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/pbInvokeRequest"
+          }
+        },
+        "initiator": {
+          "type": "string"
+        },
+        "auth_require": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "amount": {
+          "type": "string"
+        }
+      },
+      "title": "tx和requests二选一，指定tx时使用草稿交易中的合约请求、发起者和转账输出"
+    },
+    "pbEstimateFeeResponse": {
+      "type": "object",
+      "properties": {
+        "header": {
+          "$ref": "#/definitions/pbHeader"
+        },
+        "bcname": {
+          "type": "string"
+        },
+        "gas_used": {
+          "type": "string",
+          "format": "int64"
+        },
+        "resources": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/pbResourceLimit"
+          }
+        },
+        "gas_price": {
+          "$ref": "#/definitions/pbGasPrice"
+        },
+        "fee": {
+          "type": "string",
+          "format": "int64"
+        },
+        "total_need": {
+          "type": "string"
+        },
+        "utxo_count": {
+          "type": "string",
+          "format": "int64"
+        },
+        "utxo_enough": {
+          "type": "boolean"
+        }
+      }
+    },
+    "pbGasPrice": {
+      "type": "object",
+      "properties": {
+        "cpu_rate": {
+          "type": "string",
+          "format": "int64"
+        },
+        "mem_rate": {
+          "type": "string",
+          "format": "int64"
+        },
+        "disk_rate": {
+          "type": "string",
+          "format": "int64"
+        },
+        "xfee_rate": {
+          "type": "string",
+          "format": "int64"
+        }
+      }
+    },
+    "pbGetAccountContractsRequest": {
+      "type": "object",
+      "properties": {
+        "header": {
+          "$ref": "#/definitions/pbHeader"
+        },
+        "bcname": {
+          "type": "string"
+        },
+        "account": {
+          "type": "string"
+        }
+      },
+      "title": "Query account contracts request"
+    },
+    "pbGetAccountContractsResponse": {
+      "type": "object",
+      "properties": {
+        "header": {
+          "$ref": "#/definitions/pbHeader"
+        },
+        "contracts_status": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/pbContractStatus"
+          }
+        }
+      },
+      "title": "Query account contracts response"
+    },
+    "pbHDInfo": {
+      "type": "object",
+      "properties": {
+        "hd_public_key": {
+          "type": "string",
+          "format": "byte",
+          "title": "HDPublickey"
+        },
+        "original_hash": {
+          "type": "string",
+          "format": "byte",
+          "title": "original_hash"
+        }
+      }
+    },
+    "pbHeader": {
+      "type": "object",
+      "properties": {
+        "logid": {
+          "type": "string"
+        },
+        "from_node": {
+          "type": "string"
+        },
+        "error": {
+          "$ref": "#/definitions/pbXChainErrorEnum"
+        }
+      }
+    },
+    "pbInternalBlock": {
+      "type": "object",
+      "properties": {
+        "version": {
+          "type": "integer",
+          "format": "int32",
+          "title": "block version"
+        },
+        "nonce": {
+          "type": "integer",
+          "format": "int32",
+          "title": "Random number used to avoid replay attacks"
+        },
+        "blockid": {
+          "type": "string",
+          "format": "byte",
+          "title": "blockid generate the hash sign of the block used by sha256"
+        },
+        "pre_hash": {
+          "type": "string",
+          "format": "byte",
+          "title": "pre_hash is the parent blockid of the block"
+        },
+        "proposer": {
+          "type": "string",
+          "format": "byte",
+          "title": "The miner id"
+        },
+        "sign": {
+          "type": "string",
+          "format": "byte",
+          "title": "The sign which miner signed: blockid + nonce + timestamp"
+        },
+        "pubkey": {
+          "type": "string",
+          "format": "byte",
+          "title": "The pk of the miner"
+        },
+        "merkle_root": {
+          "type": "string",
+          "format": "byte",
+          "title": "The Merkle Tree root"
+        },
+        "height": {
+          "type": "string",
+          "format": "int64",
+          "title": "The height of the blockchain"
+        },
+        "timestamp": {
+          "type": "string",
+          "format": "int64",
+          "title": "Timestamp of the block"
+        },
+        "transactions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/pbTransaction"
+          },
+          "title": "Transactions of the block, only txid stored on kv, the detail information\nstored in another table"
+        },
+        "tx_count": {
+          "type": "integer",
+          "format": "int32",
+          "title": "The transaction count of the block"
+        },
+        "merkle_tree": {
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "byte"
+          },
+          "title": "所有交易hash的merkle tree"
+        },
+        "curTerm": {
+          "type": "string",
+          "format": "int64"
+        },
+        "curBlockNum": {
+          "type": "string",
+          "format": "int64"
+        },
+        "failed_txs": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "targetBits": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "Justify": {
+          "$ref": "#/definitions/pbQuorumCert",
+          "title": "Justify used in chained-bft"
+        },
+        "in_trunk": {
+          "type": "boolean",
+          "title": "下面的属性会动态变化\nIf the block is on the trunk"
+        },
+        "next_hash": {
+          "type": "string",
+          "format": "byte",
+          "title": "Next next block which on trunk"
+        }
+      },
+      "title": "The internal block struct"
+    },
+    "pbInvokeRPCRequest": {
+      "type": "object",
+      "properties": {
+        "header": {
+          "$ref": "#/definitions/pbHeader"
+        },
+        "bcname": {
+          "type": "string"
+        },
+        "requests": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/pbInvokeRequest"
+          }
+        },
+        "initiator": {
+          "type": "string"
+        },
+        "auth_require": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "pbInvokeRPCResponse": {
+      "type": "object",
+      "properties": {
+        "header": {
+          "$ref": "#/definitions/pbHeader"
+        },
+        "bcname": {
+          "type": "string"
+        },
+        "response": {
+          "$ref": "#/definitions/pbInvokeResponse"
+        }
+      }
+    },
+    "pbInvokeRequest": {
+      "type": "object",
+      "properties": {
+        "module_name": {
+          "type": "string"
+        },
+        "contract_name": {
+          "type": "string"
+        },
+        "method_name": {
+          "type": "string"
+        },
+        "args": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string",
+            "format": "byte"
+          }
+        },
+        "resource_limits": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/pbResourceLimit"
+          }
+        },
+        "amount": {
+          "type": "string",
+          "title": "amount is the amount transfer to the contract\nattention: In one transaction, transfer to only one contract is allowed"
+        }
+      },
+      "title": "预执行的请求结构"
+    },
+    "pbInvokeResponse": {
+      "type": "object",
+      "properties": {
+        "inputs": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/pbTxInputExt"
+          }
+        },
+        "outputs": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/pbTxOutputExt"
+          }
+        },
+        "response": {
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "byte"
+          }
+        },
+        "gas_used": {
+          "type": "string",
+          "format": "int64"
+        },
+        "requests": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/pbInvokeRequest"
+          }
+        },
+        "responses": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/pbContractResponse"
+          }
+        },
+        "utxoInputs": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/pbTxInput"
+          }
+        },
+        "utxoOutputs": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/pbTxOutput"
+          }
+        }
+      },
+      "title": "预执行的返回结构"
+    },
+    "pbLedgerMeta": {
+      "type": "object",
+      "properties": {
+        "root_blockid": {
+          "type": "string",
+          "format": "byte",
+          "title": "root block id"
+        },
+        "tip_blockid": {
+          "type": "string",
+          "format": "byte",
+          "title": "tip block id"
+        },
+        "trunk_height": {
+          "type": "string",
+          "format": "int64",
+          "title": "the height of the trunk"
+        }
+      },
+      "title": "Ledger metadata"
+    },
+    "pbModifyBlock": {
+      "type": "object",
+      "properties": {
+        "effective_txid": {
+          "type": "string",
+          "title": "txid交易被effective_txid的交易提出可修改区块链的请求"
+        },
+        "marked": {
+          "type": "boolean",
+          "title": "本交易是否已被修改标记"
+        },
+        "effective_height": {
+          "type": "string",
+          "format": "int64",
+          "title": "txid交易被修改生效的高度"
+        },
+        "public_key": {
+          "type": "string",
+          "title": "监管的public key"
+        },
+        "sign": {
+          "type": "string",
+          "title": "监管地址对修改的交易id的签名"
+        }
+      }
+    },
+    "pbPermissionModel": {
+      "type": "object",
+      "properties": {
+        "rule": {
+          "$ref": "#/definitions/pbPermissionRule"
+        },
+        "acceptValue": {
+          "type": "number",
+          "format": "double"
+        }
+      }
+    },
+    "pbPermissionRule": {
+      "type": "string",
+      "enum": [
+        "NULL",
+        "SIGN_THRESHOLD",
+        "SIGN_AKSET",
+        "SIGN_RATE",
+        "SIGN_SUM",
+        "CA_SERVER",
+        "COMMUNITY_VOTE"
+      ],
+      "default": "NULL",
+      "title": "--------   Account and Permission Section --------"
+    },
+    "pbPreExecWithSelectUTXORequest": {
+      "type": "object",
+      "properties": {
+        "header": {
+          "$ref": "#/definitions/pbHeader"
+        },
+        "bcname": {
+          "type": "string"
+        },
+        "address": {
+          "type": "string"
+        },
+        "totalAmount": {
+          "type": "string",
+          "format": "int64"
+        },
+        "signInfo": {
+          "$ref": "#/definitions/pbSignatureInfo"
+        },
+        "needLock": {
+          "type": "boolean"
+        },
+        "request": {
+          "$ref": "#/definitions/pbInvokeRPCRequest"
+        }
+      },
+      "title": "PreExecWithSelectUTXORequest preExec + selectUtxo for request"
+    },
+    "pbPreExecWithSelectUTXOResponse": {
+      "type": "object",
+      "properties": {
+        "header": {
+          "$ref": "#/definitions/pbHeader"
+        },
+        "bcname": {
+          "type": "string"
+        },
+        "response": {
+          "$ref": "#/definitions/pbInvokeResponse"
+        },
+        "utxoOutput": {
+          "$ref": "#/definitions/pbUtxoOutput",
+          "title": "for preExec \u0026 selectUTXO"
+        }
+      },
+      "title": "PreExecWithSelectUTXOResponse preExec + selectUtxo for response"
+    },
+    "pbQCSignInfos": {
+      "type": "object",
+      "properties": {
+        "QCSignInfos": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/pbSignInfo"
+          },
+          "title": "QCSignInfos"
+        }
+      },
+      "description": "QCSignInfos is the signs of the leader gathered from replicas of a specifically certType.\nA slice of signs is used at present.\nTODO @qizheng09: It will be change to Threshold-Signatures after \nCrypto lib support Threshold-Signatures."
+    },
+    "pbQCState": {
+      "type": "string",
+      "enum": [
+        "NEW_VIEW",
+        "PREPARE",
+        "PRE_COMMIT",
+        "COMMIT",
+        "DECIDE"
+      ],
+      "default": "NEW_VIEW",
+      "title": "QCState is the phase of hotstuff"
+    },
+    "pbQuorumCert": {
+      "type": "object",
+      "properties": {
+        "ProposalId": {
+          "type": "string",
+          "format": "byte",
+          "description": "The id of Proposal this QC certified."
+        },
+        "ProposalMsg": {
+          "type": "string",
+          "format": "byte",
+          "description": "The msg of Proposal this QC certified."
+        },
+        "Type": {
+          "$ref": "#/definitions/pbQCState",
+          "title": "The current type of this QC certified.\nthe type contains ` + "`" + `NEW_VIEW` + "`" + `, ` + "`" + `PREPARE` + "`" + `"
+        },
+        "ViewNumber": {
+          "type": "string",
+          "format": "int64",
+          "description": "The view number of this QC certified."
+        },
+        "SignInfos": {
+          "$ref": "#/definitions/pbQCSignInfos",
+          "description": "SignInfos is the signs of the leader gathered from replicas\nof a specifically certType."
+        }
+      },
+      "description": "QuorumCert is a data type that combines a collection of signatures from replicas."
+    },
+    "pbRawUrl": {
+      "type": "object",
+      "properties": {
+        "header": {
+          "$ref": "#/definitions/pbHeader"
+        },
+        "rawUrl": {
+          "type": "string"
+        }
+      },
+      "title": "RawUrl return the node's  connect url"
+    },
+    "pbResourceLimit": {
+      "type": "object",
+      "properties": {
+        "type": {
+          "$ref": "#/definitions/pbResourceType"
+        },
+        "limit": {
+          "type": "string",
+          "format": "int64"
+        }
+      }
+    },
+    "pbResourceType": {
+      "type": "string",
+      "enum": [
+        "CPU",
+        "MEMORY",
+        "DISK",
+        "XFEE"
+      ],
+      "default": "CPU"
+    },
+    "pbSignInfo": {
+      "type": "object",
+      "properties": {
+        "Address": {
+          "type": "string"
+        },
+        "PublicKey": {
+          "type": "string"
+        },
+        "Sign": {
+          "type": "string",
+          "format": "byte"
+        }
+      },
+      "title": "SignInfo is the signature information of the"
+    },
+    "pbSignatureInfo": {
+      "type": "object",
+      "properties": {
+        "PublicKey": {
+          "type": "string"
+        },
+        "Sign": {
+          "type": "string",
+          "format": "byte"
+        }
+      },
+      "title": "签名详情"
+    },
+    "pbSpeeds": {
+      "type": "object",
+      "properties": {
+        "SumSpeeds": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "number",
+            "format": "double"
+          }
+        },
+        "BcSpeeds": {
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/pbBCSpeeds"
+          }
+        }
+      }
+    },
+    "pbSystemsStatus": {
+      "type": "object",
+      "properties": {
+        "header": {
+          "$ref": "#/definitions/pbHeader"
+        },
+        "bcs_status": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/pbBCStatus"
+          }
+        },
+        "speeds": {
+          "$ref": "#/definitions/pbSpeeds"
+        },
+        "peerUrls": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "pbSystemsStatusReply": {
+      "type": "object",
+      "properties": {
+        "header": {
+          "$ref": "#/definitions/pbHeader"
+        },
+        "systems_status": {
+          "$ref": "#/definitions/pbSystemsStatus"
+        }
+      }
+    },
+    "pbTokenDetail": {
+      "type": "object",
+      "properties": {
+        "bcname": {
+          "type": "string"
+        },
+        "balance": {
+          "type": "string"
+        },
+        "error": {
+          "$ref": "#/definitions/pbXChainErrorEnum"
+        }
+      }
+    },
+    "pbTokenFrozenDetail": {
+      "type": "object",
+      "properties": {
+        "balance": {
+          "type": "string"
+        },
+        "isFrozen": {
+          "type": "boolean"
+        }
+      }
+    },
+    "pbTokenFrozenDetails": {
+      "type": "object",
+      "properties": {
+        "bcname": {
+          "type": "string"
+        },
+        "tfd": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/pbTokenFrozenDetail"
+          }
+        },
+        "error": {
+          "$ref": "#/definitions/pbXChainErrorEnum"
+        }
+      }
+    },
+    "pbTokenHolder": {
+      "type": "object",
+      "properties": {
+        "address": {
+          "type": "string"
+        },
+        "balance": {
+          "type": "string"
+        }
+      }
+    },
+    "pbTokenStatsRequest": {
+      "type": "object",
+      "properties": {
+        "header": {
+          "$ref": "#/definitions/pbHeader"
+        },
+        "bcname": {
+          "type": "string"
+        },
+        "top_n": {
+          "type": "integer",
+          "format": "int32"
+        }
+      }
+    },
+    "pbTokenStatsResponse": {
+      "type": "object",
+      "properties": {
+        "header": {
+          "$ref": "#/definitions/pbHeader"
+        },
+        "bcname": {
+          "type": "string"
+        },
+        "height": {
+          "type": "string",
+          "format": "int64"
+        },
+        "total_supply": {
+          "type": "string"
+        },
+        "frozen_amount": {
+          "type": "string"
+        },
+        "holder_count": {
+          "type": "string",
+          "format": "int64"
+        },
+        "top_holders": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/pbTokenHolder"
+          }
+        }
+      },
+      "title": "Token stats response, top holders are ordered by balance descending"
+    },
+    "pbTransaction": {
+      "type": "object",
+      "properties": {
+        "txid": {
+          "type": "string",
+          "format": "byte",
+          "title": "txid is the id of this transaction"
+        },
+        "blockid": {
+          "type": "string",
+          "format": "byte",
+          "title": "the blockid the transaction belong to"
+        },
+        "tx_inputs": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/pbTxInput"
+          },
+          "title": "Transaction input list"
+        },
+        "tx_outputs": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/pbTxOutput"
+          },
+          "title": "Transaction output list"
+        },
+        "desc": {
+          "type": "string",
+          "format": "byte",
+          "title": "Transaction description or system contract"
+        },
+        "coinbase": {
+          "type": "boolean",
+          "title": "Mining rewards"
+        },
+        "nonce": {
+          "type": "string",
+          "title": "Random number used to avoid replay attacks"
+        },
+        "timestamp": {
+          "type": "string",
+          "format": "int64",
+          "title": "Timestamp to launch the transaction"
+        },
+        "version": {
+          "type": "integer",
+          "format": "int32",
+          "title": "tx format version; tx格式版本号"
+        },
+        "autogen": {
+          "type": "boolean",
+          "title": "auto generated tx"
+        },
+        "tx_inputs_ext": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/pbTxInputExt"
+          }
+        },
+        "tx_outputs_ext": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/pbTxOutputExt"
+          }
+        },
+        "contract_requests": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/pbInvokeRequest"
+          }
+        },
+        "initiator": {
+          "type": "string",
+          "title": "权限系统新增字段\n交易发起者, 可以是一个Address或者一个Account"
+        },
+        "auth_require": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "交易发起需要被收集签名的AddressURL集合信息，包括用于utxo转账和用于合约调用"
+        },
+        "initiator_signs": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/pbSignatureInfo"
+          },
+          "title": "交易发起者对交易元数据签名，签名的内容包括auth_require字段"
+        },
+        "auth_require_signs": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/pbSignatureInfo"
+          },
+          "title": "收集到的签名"
+        },
+        "received_timestamp": {
+          "type": "string",
+          "format": "int64",
+          "title": "节点收到tx的时间戳，不参与签名"
+        },
+        "xuper_sign": {
+          "$ref": "#/definitions/pbXuperSignature",
+          "title": "统一签名(支持多重签名/环签名等，与initiator_signs/auth_require_signs不同时使用)"
+        },
+        "modify_block": {
+          "$ref": "#/definitions/pbModifyBlock",
+          "title": "可修改区块链标记"
+        },
+        "HD_info": {
+          "$ref": "#/definitions/pbHDInfo",
+          "title": "HD加解密相关信息"
+        }
+      },
+      "title": "Transaction is the information of the transaction"
+    },
+    "pbTransactionStatus": {
+      "type": "string",
+      "enum": [
+        "UNDEFINE",
+        "NOEXIST",
+        "CONFIRM",
+        "FURCATION",
+        "UNCONFIRM",
+        "FAILED"
+      ],
+      "default": "UNDEFINE",
+      "description": "- UNDEFINE: Undefined status\n - NOEXIST: Transaction not exist\n - CONFIRM: Transaction have been confirmed\n - FURCATION: Transaction is on the furcation\n - UNCONFIRM: Transaction have not been confirmed\n - FAILED: Transaction occurs error",
+      "title": "TransactionStatus is the status of transaction"
+    },
+    "pbTxDirection": {
+      "type": "string",
+      "enum": [
+        "DIRECTION_NONE",
+        "DIRECTION_IN",
+        "DIRECTION_OUT"
+      ],
+      "default": "DIRECTION_NONE"
+    },
+    "pbTxInput": {
+      "type": "object",
+      "properties": {
+        "ref_txid": {
+          "type": "string",
+          "format": "byte",
+          "title": "The transaction id referenced to"
+        },
+        "ref_offset": {
+          "type": "integer",
+          "format": "int32",
+          "title": "The output offset of the transaction referenced to"
+        },
+        "from_addr": {
+          "type": "string",
+          "format": "byte",
+          "title": "The address of the launcher"
+        },
+        "amount": {
+          "type": "string",
+          "format": "byte",
+          "title": "The amount of the transaction"
+        },
+        "frozen_height": {
+          "type": "string",
+          "format": "int64",
+          "title": "Frozen height"
+        }
+      },
+      "title": "Transaction input"
+    },
+    "pbTxInputExt": {
+      "type": "object",
+      "properties": {
+        "bucket": {
+          "type": "string"
+        },
+        "key": {
+          "type": "string",
+          "format": "byte"
+        },
+        "ref_txid": {
+          "type": "string",
+          "format": "byte"
+        },
+        "ref_offset": {
+          "type": "integer",
+          "format": "int32"
+        }
+      },
+      "title": "扩展输入"
+    },
+    "pbTxOutput": {
+      "type": "object",
+      "properties": {
+        "amount": {
+          "type": "string",
+          "format": "byte",
+          "title": "The amount of the transaction"
+        },
+        "to_addr": {
+          "type": "string",
+          "format": "byte",
+          "title": "The address of the launcher"
+        },
+        "frozen_height": {
+          "type": "string",
+          "format": "int64",
+          "title": "Fronzen height"
+        }
+      },
+      "title": "Transaction output"
+    },
+    "pbTxOutputExt": {
+      "type": "object",
+      "properties": {
+        "bucket": {
+          "type": "string"
+        },
+        "key": {
+          "type": "string",
+          "format": "byte"
+        },
+        "value": {
+          "type": "string",
+          "format": "byte"
+        }
+      },
+      "title": "扩展输出"
+    },
+    "pbTxPoolConflict": {
+      "type": "object",
+      "properties": {
+        "ref_txid": {
+          "type": "string",
+          "format": "byte"
+        },
+        "ref_offset": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "txids": {
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "byte"
+          }
+        }
+      },
+      "title": "utxo spent by more than one unconfirmed tx"
+    },
+    "pbTxPoolInitiatorStat": {
+      "type": "object",
+      "properties": {
+        "initiator": {
+          "type": "string"
+        },
+        "tx_count": {
+          "type": "string",
+          "format": "int64"
+        }
+      }
+    },
+    "pbTxPoolStatusRequest": {
+      "type": "object",
+      "properties": {
+        "header": {
+          "$ref": "#/definitions/pbHeader"
+        },
+        "bcname": {
+          "type": "string"
+        }
+      }
+    },
+    "pbTxPoolStatusResponse": {
+      "type": "object",
+      "properties": {
+        "header": {
+          "$ref": "#/definitions/pbHeader"
+        },
+        "bcname": {
+          "type": "string"
+        },
+        "tx_count": {
+          "type": "string",
+          "format": "int64"
+        },
+        "oldest_timestamp": {
+          "type": "string",
+          "format": "int64"
+        },
+        "newest_timestamp": {
+          "type": "string",
+          "format": "int64"
+        },
+        "max_age_seconds": {
+          "type": "string",
+          "format": "int64"
+        },
+        "top_initiators": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/pbTxPoolInitiatorStat"
+          }
+        },
+        "conflicts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/pbTxPoolConflict"
+          }
+        }
+      }
+    },
+    "pbTxStatus": {
+      "type": "object",
+      "properties": {
+        "header": {
+          "$ref": "#/definitions/pbHeader"
+        },
+        "bcname": {
+          "type": "string"
+        },
+        "txid": {
+          "type": "string",
+          "format": "byte"
+        },
+        "status": {
+          "$ref": "#/definitions/pbTransactionStatus"
+        },
+        "distance": {
+          "type": "string",
+          "format": "int64"
+        },
+        "tx": {
+          "$ref": "#/definitions/pbTransaction"
+        }
+      }
+    },
+    "pbUnconfirmedTxidsResponse": {
+      "type": "object",
+      "properties": {
+        "header": {
+          "$ref": "#/definitions/pbHeader"
+        },
+        "bcname": {
+          "type": "string"
+        },
+        "txids": {
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "byte"
+          }
+        },
+        "next_cursor": {
+          "type": "string"
+        },
+        "total": {
+          "type": "string",
+          "format": "int64"
+        }
+      }
+    },
+    "pbUnconfirmedTxsRequest": {
+      "type": "object",
+      "properties": {
+        "header": {
+          "$ref": "#/definitions/pbHeader"
+        },
+        "bcname": {
+          "type": "string"
+        },
+        "initiator": {
+          "type": "string"
+        },
+        "cursor": {
+          "type": "string"
+        },
+        "limit": {
+          "type": "integer",
+          "format": "int32"
+        }
+      },
+      "title": "Unconfirmed txs are ordered by timestamp, then txid"
+    },
+    "pbUnconfirmedTxsResponse": {
+      "type": "object",
+      "properties": {
+        "header": {
+          "$ref": "#/definitions/pbHeader"
+        },
+        "bcname": {
+          "type": "string"
+        },
+        "txs": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/pbTransaction"
+          }
+        },
+        "next_cursor": {
+          "type": "string"
+        }
+      }
+    },
+    "pbUtxo": {
+      "type": "object",
+      "properties": {
+        "amount": {
+          "type": "string",
+          "format": "byte"
+        },
+        "toAddr": {
+          "type": "string",
+          "format": "byte"
+        },
+        "toPubkey": {
+          "type": "string",
+          "format": "byte"
+        },
+        "refTxid": {
+          "type": "string",
+          "format": "byte"
+        },
+        "refOffset": {
+          "type": "integer",
+          "format": "int32"
+        }
+      }
+    },
+    "pbUtxoInput": {
+      "type": "object",
+      "properties": {
+        "header": {
+          "$ref": "#/definitions/pbHeader"
+        },
+        "bcname": {
+          "type": "string",
+          "title": "which bcname to select"
+        },
+        "address": {
+          "type": "string",
+          "title": "address to select"
+        },
+        "publickey": {
+          "type": "string",
+          "title": "publickey of the address"
+        },
+        "totalNeed": {
+          "type": "string",
+          "title": "totalNeed refer the total need utxos to select"
+        },
+        "userSign": {
+          "type": "string",
+          "format": "byte",
+          "title": "userSign of input"
+        },
+        "needLock": {
+          "type": "boolean",
+          "title": "need lock"
+        }
+      },
+      "title": "UtxoInput query info to query utxos"
+    },
+    "pbUtxoKey": {
+      "type": "object",
+      "properties": {
+        "refTxid": {
+          "type": "string"
+        },
+        "offset": {
+          "type": "string"
+        },
+        "amount": {
+          "type": "string"
+        }
+      }
+    },
+    "pbUtxoMeta": {
+      "type": "object",
+      "properties": {
+        "latest_blockid": {
+          "type": "string",
+          "format": "byte"
+        },
+        "lock_key_list": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "utxo_total": {
+          "type": "string"
+        },
+        "avgDelay": {
+          "type": "string",
+          "format": "int64"
+        },
+        "unconfirmTxAmount": {
+          "type": "string",
+          "format": "int64"
+        },
+        "max_block_size": {
+          "type": "string",
+          "format": "int64"
+        },
+        "reserved_contracts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/pbInvokeRequest"
+          }
+        },
+        "forbidden_contract": {
+          "$ref": "#/definitions/pbInvokeRequest"
+        },
+        "new_account_resource_amount": {
+          "type": "string",
+          "format": "int64"
+        },
+        "irreversibleBlockHeight": {
+          "type": "string",
+          "format": "int64"
+        },
+        "irreversibleSlideWindow": {
+          "type": "string",
+          "format": "int64"
+        },
+        "gasPrice": {
+          "$ref": "#/definitions/pbGasPrice"
+        },
+        "group_chain_contract": {
+          "$ref": "#/definitions/pbInvokeRequest"
+        }
+      },
+      "title": "Utxo metadata"
+    },
+    "pbUtxoOutput": {
+      "type": "object",
+      "properties": {
+        "header": {
+          "$ref": "#/definitions/pbHeader"
+        },
+        "utxoList": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/pbUtxo"
+          },
+          "title": "outSign return the output\nbytes outSign = 2;\nutxo list"
+        },
+        "totalSelected": {
+          "type": "string",
+          "title": "total selected amount"
+        }
+      },
+      "title": "UtxoOutput query results"
+    },
+    "pbUtxoRecord": {
+      "type": "object",
+      "properties": {
+        "utxoCount": {
+          "type": "string"
+        },
+        "utxoAmount": {
+          "type": "string"
+        },
+        "item": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/pbUtxoKey"
+          }
+        }
+      }
+    },
+    "pbUtxoRecordDetail": {
+      "type": "object",
+      "properties": {
+        "header": {
+          "$ref": "#/definitions/pbHeader"
+        },
+        "bcname": {
+          "type": "string"
+        },
+        "accountName": {
+          "type": "string"
+        },
+        "openUtxoRecord": {
+          "$ref": "#/definitions/pbUtxoRecord"
+        },
+        "lockedUtxoRecord": {
+          "$ref": "#/definitions/pbUtxoRecord"
+        },
+        "frozenUtxoRecord": {
+          "$ref": "#/definitions/pbUtxoRecord"
+        },
+        "displayCount": {
+          "type": "string",
+          "format": "int64"
+        }
+      }
+    },
+    "pbViewOption": {
+      "type": "string",
+      "enum": [
+        "NONE",
+        "LEDGER",
+        "UTXOINFO",
+        "BRANCHINFO",
+        "PEERS"
+      ],
+      "default": "NONE",
+      "description": "- NONE: Without any flag: Default\n - LEDGER: Ledger flag: Get Ledger Info\n - UTXOINFO: Utxo flag: Get UTXO Info\n - BRANCHINFO: Branch flag: Get BranchId Info\n - PEERS: Peers flag: Get Peers Info",
+      "title": "View option to be choosed (only used in status filter currently)"
+    },
+    "pbWaitTxRequest": {
+      "type": "object",
+      "properties": {
+        "header": {
+          "$ref": "#/definitions/pbHeader"
+        },
+        "bcname": {
+          "type": "string"
+        },
+        "txid": {
+          "type": "string",
+          "format": "byte"
+        },
+        "confirmations": {
+          "type": "string",
+          "format": "int64"
+        },
+        "timeout_seconds": {
+          "type": "string",
+          "format": "int64"
+        }
+      }
+    },
+    "pbWaitTxResponse": {
+      "type": "object",
+      "properties": {
+        "header": {
+          "$ref": "#/definitions/pbHeader"
+        },
+        "bcname": {
+          "type": "string"
+        },
+        "txid": {
+          "type": "string",
+          "format": "byte"
+        },
+        "result": {
+          "$ref": "#/definitions/pbWaitTxResult"
+        },
+        "status": {
+          "$ref": "#/definitions/pbTransactionStatus"
+        },
+        "confirmations": {
+          "type": "string",
+          "format": "int64"
+        },
+        "blockid": {
+          "type": "string",
+          "format": "byte"
+        }
+      }
+    },
+    "pbWaitTxResult": {
+      "type": "string",
+      "enum": [
+        "WAIT_UNDEFINE",
+        "WAIT_CONFIRMED",
+        "WAIT_DROPPED",
+        "WAIT_TIMEOUT"
+      ],
+      "default": "WAIT_UNDEFINE"
+    },
+    "pbXChainErrorEnum": {
+      "type": "string",
+      "enum": [
+        "SUCCESS",
+        "UNKNOW_ERROR",
+        "CONNECT_REFUSE",
+        "NOT_ENOUGH_UTXO_ERROR",
+        "UTXOVM_ALREADY_UNCONFIRM_ERROR",
+        "UTXOVM_NOT_FOUND_ERROR",
+        "INPUT_OUTPUT_NOT_EQUAL_ERROR",
+        "TX_NOT_FOUND_ERROR",
+        "TX_SIGN_ERROR",
+        "BLOCKCHAIN_NOTEXIST",
+        "VALIDATE_ERROR",
+        "CANNOT_SYNC_BLOCK_ERROR",
+        "CONFIRM_BLOCK_ERROR",
+        "UTXOVM_PLAY_ERROR",
+        "WALK_ERROR",
+        "NOT_READY_ERROR",
+        "BLOCK_EXIST_ERROR",
+        "ROOT_BLOCK_EXIST_ERROR",
+        "TX_DUPLICATE_ERROR",
+        "SERVICE_REFUSED_ERROR",
+        "TXDATA_SIGN_ERROR",
+        "TX_SLE_ERROR",
+        "TX_FEE_NOT_ENOUGH_ERROR",
+        "UTXO_SIGN_ERROR",
+        "DPOS_QUERY_ERROR",
+        "RWSET_INVALID_ERROR",
+        "RWACL_INVALID_ERROR",
+        "GAS_NOT_ENOUGH_ERROR",
+        "TX_VERSION_INVALID_ERROR",
+        "COMPLIANCE_CHECK_NOT_APPROVED",
+        "ACCOUNT_CONTRACT_STATUS_ERROR",
+        "TX_VERIFICATION_ERROR"
+      ],
+      "default": "SUCCESS"
+    },
+    "pbXuperSignature": {
+      "type": "object",
+      "properties": {
+        "public_keys": {
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "byte"
+          }
+        },
+        "signature": {
+          "type": "string",
+          "format": "byte"
+        }
+      },
+      "title": "Unified Xuper Signature"
+    },
+    "pbvoteRecord": {
+      "type": "object",
+      "properties": {
+        "candidate": {
+          "type": "string"
+        },
+        "txid": {
+          "type": "string"
+        }
+      },
+      "title": "选民投票记录"
+    },
+    "pbvotedRecord": {
+      "type": "object",
+      "properties": {
+        "voter": {
+          "type": "string"
+        },
+        "txid": {
+          "type": "string"
+        }
+      },
+      "title": "候选人被投票记录"
+    },
+    "protobufAny": {
+      "type": "object",
+      "properties": {
+        "type_url": {
+          "type": "string"
+        },
+        "value": {
+          "type": "string",
+          "format": "byte"
+        }
+      }
+    },
+    "runtimeError": {
+      "type": "object",
+      "properties": {
+        "error": {
+          "type": "string"
+        },
+        "code": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "message": {
+          "type": "string"
+        },
+        "details": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/protobufAny"
+          }
+        }
+      }
+    }
+  }
+}
+`
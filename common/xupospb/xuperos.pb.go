@@ -24,6 +24,70 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+// 交易对地址的资金方向
+type TxDirection int32
+
+const (
+	// 未发生转账，如只作为发起者
+	TxDirection_DIRECTION_NONE TxDirection = 0
+	// 转入
+	TxDirection_DIRECTION_IN TxDirection = 1
+	// 转出
+	TxDirection_DIRECTION_OUT TxDirection = 2
+)
+
+var TxDirection_name = map[int32]string{
+	0: "DIRECTION_NONE",
+	1: "DIRECTION_IN",
+	2: "DIRECTION_OUT",
+}
+
+var TxDirection_value = map[string]int32{
+	"DIRECTION_NONE": 0,
+	"DIRECTION_IN":   1,
+	"DIRECTION_OUT":  2,
+}
+
+func (x TxDirection) String() string {
+	return proto.EnumName(TxDirection_name, int32(x))
+}
+
+func (TxDirection) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_76de507326ad4f72, []int{0}
+}
+
+// 区块状态，与xldgpb.BlockStatus保持一致
+type BlockStatus int32
+
+const (
+	BlockStatus_BLOCK_ERROR   BlockStatus = 0
+	BlockStatus_BLOCK_TRUNK   BlockStatus = 1
+	BlockStatus_BLOCK_BRANCH  BlockStatus = 2
+	BlockStatus_BLOCK_NOEXIST BlockStatus = 3
+)
+
+var BlockStatus_name = map[int32]string{
+	0: "BLOCK_ERROR",
+	1: "BLOCK_TRUNK",
+	2: "BLOCK_BRANCH",
+	3: "BLOCK_NOEXIST",
+}
+
+var BlockStatus_value = map[string]int32{
+	"BLOCK_ERROR":   0,
+	"BLOCK_TRUNK":   1,
+	"BLOCK_BRANCH":  2,
+	"BLOCK_NOEXIST": 3,
+}
+
+func (x BlockStatus) String() string {
+	return proto.EnumName(BlockStatus_name, int32(x))
+}
+
+func (BlockStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_76de507326ad4f72, []int{1}
+}
+
 // 通用请求Header
 type ReqHeader struct {
 	// 请求id
@@ -220,34 +284,426 @@ func (m *BaseResp) GetHeader() *RespHeader {
 	return nil
 }
 
+// 地址相关交易记录
+type AddressTx struct {
+	Height    int64       `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Txid      []byte      `protobuf:"bytes,2,opt,name=txid,proto3" json:"txid,omitempty"`
+	Direction TxDirection `protobuf:"varint,3,opt,name=direction,proto3,enum=xupospb.TxDirection" json:"direction,omitempty"`
+	// 净转入或转出金额
+	Amount               string   `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Timestamp            int64    `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddressTx) Reset()         { *m = AddressTx{} }
+func (m *AddressTx) String() string { return proto.CompactTextString(m) }
+func (*AddressTx) ProtoMessage()    {}
+func (*AddressTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76de507326ad4f72, []int{4}
+}
+
+func (m *AddressTx) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddressTx.Unmarshal(m, b)
+}
+func (m *AddressTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddressTx.Marshal(b, m, deterministic)
+}
+func (m *AddressTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressTx.Merge(m, src)
+}
+func (m *AddressTx) XXX_Size() int {
+	return xxx_messageInfo_AddressTx.Size(m)
+}
+func (m *AddressTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressTx proto.InternalMessageInfo
+
+func (m *AddressTx) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *AddressTx) GetTxid() []byte {
+	if m != nil {
+		return m.Txid
+	}
+	return nil
+}
+
+func (m *AddressTx) GetDirection() TxDirection {
+	if m != nil {
+		return m.Direction
+	}
+	return TxDirection_DIRECTION_NONE
+}
+
+func (m *AddressTx) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *AddressTx) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type AddressTxsReq struct {
+	Header *ReqHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	BcName string     `protobuf:"bytes,2,opt,name=bc_name,json=bcName,proto3" json:"bc_name,omitempty"`
+	// 地址或合约账户
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	// 分页游标，为空从最新交易开始
+	Cursor               string   `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit                int32    `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddressTxsReq) Reset()         { *m = AddressTxsReq{} }
+func (m *AddressTxsReq) String() string { return proto.CompactTextString(m) }
+func (*AddressTxsReq) ProtoMessage()    {}
+func (*AddressTxsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76de507326ad4f72, []int{5}
+}
+
+func (m *AddressTxsReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddressTxsReq.Unmarshal(m, b)
+}
+func (m *AddressTxsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddressTxsReq.Marshal(b, m, deterministic)
+}
+func (m *AddressTxsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressTxsReq.Merge(m, src)
+}
+func (m *AddressTxsReq) XXX_Size() int {
+	return xxx_messageInfo_AddressTxsReq.Size(m)
+}
+func (m *AddressTxsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressTxsReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressTxsReq proto.InternalMessageInfo
+
+func (m *AddressTxsReq) GetHeader() *ReqHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *AddressTxsReq) GetBcName() string {
+	if m != nil {
+		return m.BcName
+	}
+	return ""
+}
+
+func (m *AddressTxsReq) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AddressTxsReq) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+func (m *AddressTxsReq) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type AddressTxsResp struct {
+	Header *RespHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// 按高度从新到旧排列
+	Txs []*AddressTx `protobuf:"bytes,2,rep,name=txs,proto3" json:"txs,omitempty"`
+	// 下一页游标，为空表示没有更多数据
+	NextCursor           string   `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddressTxsResp) Reset()         { *m = AddressTxsResp{} }
+func (m *AddressTxsResp) String() string { return proto.CompactTextString(m) }
+func (*AddressTxsResp) ProtoMessage()    {}
+func (*AddressTxsResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76de507326ad4f72, []int{6}
+}
+
+func (m *AddressTxsResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddressTxsResp.Unmarshal(m, b)
+}
+func (m *AddressTxsResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddressTxsResp.Marshal(b, m, deterministic)
+}
+func (m *AddressTxsResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressTxsResp.Merge(m, src)
+}
+func (m *AddressTxsResp) XXX_Size() int {
+	return xxx_messageInfo_AddressTxsResp.Size(m)
+}
+func (m *AddressTxsResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressTxsResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressTxsResp proto.InternalMessageInfo
+
+func (m *AddressTxsResp) GetHeader() *RespHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *AddressTxsResp) GetTxs() []*AddressTx {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+func (m *AddressTxsResp) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
+type BlockRangeReq struct {
+	Header *ReqHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	BcName string     `protobuf:"bytes,2,opt,name=bc_name,json=bcName,proto3" json:"bc_name,omitempty"`
+	// 起止高度，包含to，超过主干高度时到主干最新区块为止
+	From int64 `protobuf:"varint,3,opt,name=from,proto3" json:"from,omitempty"`
+	To   int64 `protobuf:"varint,4,opt,name=to,proto3" json:"to,omitempty"`
+	// 是否返回区块内容
+	NeedContent bool `protobuf:"varint,5,opt,name=need_content,json=needContent,proto3" json:"need_content,omitempty"`
+	// 返回区块内容时不包含交易，只返回区块头
+	ExcludeTx            bool     `protobuf:"varint,6,opt,name=exclude_tx,json=excludeTx,proto3" json:"exclude_tx,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockRangeReq) Reset()         { *m = BlockRangeReq{} }
+func (m *BlockRangeReq) String() string { return proto.CompactTextString(m) }
+func (*BlockRangeReq) ProtoMessage()    {}
+func (*BlockRangeReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76de507326ad4f72, []int{7}
+}
+
+func (m *BlockRangeReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockRangeReq.Unmarshal(m, b)
+}
+func (m *BlockRangeReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockRangeReq.Marshal(b, m, deterministic)
+}
+func (m *BlockRangeReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockRangeReq.Merge(m, src)
+}
+func (m *BlockRangeReq) XXX_Size() int {
+	return xxx_messageInfo_BlockRangeReq.Size(m)
+}
+func (m *BlockRangeReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockRangeReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockRangeReq proto.InternalMessageInfo
+
+func (m *BlockRangeReq) GetHeader() *ReqHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *BlockRangeReq) GetBcName() string {
+	if m != nil {
+		return m.BcName
+	}
+	return ""
+}
+
+func (m *BlockRangeReq) GetFrom() int64 {
+	if m != nil {
+		return m.From
+	}
+	return 0
+}
+
+func (m *BlockRangeReq) GetTo() int64 {
+	if m != nil {
+		return m.To
+	}
+	return 0
+}
+
+func (m *BlockRangeReq) GetNeedContent() bool {
+	if m != nil {
+		return m.NeedContent
+	}
+	return false
+}
+
+func (m *BlockRangeReq) GetExcludeTx() bool {
+	if m != nil {
+		return m.ExcludeTx
+	}
+	return false
+}
+
+type BlockRangeResp struct {
+	Header  *RespHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Height  int64       `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Blockid []byte      `protobuf:"bytes,3,opt,name=blockid,proto3" json:"blockid,omitempty"`
+	// 查询时区块的主干状态
+	Status BlockStatus `protobuf:"varint,4,opt,name=status,proto3,enum=xupospb.BlockStatus" json:"status,omitempty"`
+	// 序列化的xldgpb.InternalBlock，need_content为false时为空
+	Block                []byte   `protobuf:"bytes,5,opt,name=block,proto3" json:"block,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockRangeResp) Reset()         { *m = BlockRangeResp{} }
+func (m *BlockRangeResp) String() string { return proto.CompactTextString(m) }
+func (*BlockRangeResp) ProtoMessage()    {}
+func (*BlockRangeResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76de507326ad4f72, []int{8}
+}
+
+func (m *BlockRangeResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockRangeResp.Unmarshal(m, b)
+}
+func (m *BlockRangeResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockRangeResp.Marshal(b, m, deterministic)
+}
+func (m *BlockRangeResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockRangeResp.Merge(m, src)
+}
+func (m *BlockRangeResp) XXX_Size() int {
+	return xxx_messageInfo_BlockRangeResp.Size(m)
+}
+func (m *BlockRangeResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockRangeResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockRangeResp proto.InternalMessageInfo
+
+func (m *BlockRangeResp) GetHeader() *RespHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *BlockRangeResp) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockRangeResp) GetBlockid() []byte {
+	if m != nil {
+		return m.Blockid
+	}
+	return nil
+}
+
+func (m *BlockRangeResp) GetStatus() BlockStatus {
+	if m != nil {
+		return m.Status
+	}
+	return BlockStatus_BLOCK_ERROR
+}
+
+func (m *BlockRangeResp) GetBlock() []byte {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("xupospb.TxDirection", TxDirection_name, TxDirection_value)
+	proto.RegisterEnum("xupospb.BlockStatus", BlockStatus_name, BlockStatus_value)
 	proto.RegisterType((*ReqHeader)(nil), "xupospb.ReqHeader")
 	proto.RegisterType((*RespHeader)(nil), "xupospb.RespHeader")
 	proto.RegisterType((*BaseReq)(nil), "xupospb.BaseReq")
 	proto.RegisterType((*BaseResp)(nil), "xupospb.BaseResp")
+	proto.RegisterType((*AddressTx)(nil), "xupospb.AddressTx")
+	proto.RegisterType((*AddressTxsReq)(nil), "xupospb.AddressTxsReq")
+	proto.RegisterType((*AddressTxsResp)(nil), "xupospb.AddressTxsResp")
+	proto.RegisterType((*BlockRangeReq)(nil), "xupospb.BlockRangeReq")
+	proto.RegisterType((*BlockRangeResp)(nil), "xupospb.BlockRangeResp")
 }
 
 func init() { proto.RegisterFile("xuperos.proto", fileDescriptor_76de507326ad4f72) }
 
 var fileDescriptor_76de507326ad4f72 = []byte{
-	// 263 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0x4f, 0x4b, 0x03, 0x31,
-	0x10, 0xc5, 0xad, 0xd5, 0xfd, 0x33, 0x22, 0x68, 0x44, 0x5c, 0xf5, 0x22, 0x39, 0x89, 0xc2, 0x1e,
-	0x5a, 0xc4, 0x9b, 0xa2, 0xbd, 0xd8, 0x83, 0x0a, 0xf1, 0xe2, 0x6d, 0x49, 0x37, 0xe3, 0xb6, 0xb8,
-	0x6b, 0xd2, 0x99, 0x56, 0xfa, 0xf1, 0x25, 0xe9, 0xb6, 0x48, 0x0f, 0xbd, 0x25, 0x33, 0xf9, 0xbd,
-	0x97, 0xf7, 0xe0, 0x70, 0x31, 0x77, 0x48, 0x96, 0x73, 0x47, 0x76, 0x66, 0x45, 0xbc, 0x98, 0x3b,
-	0xcb, 0x6e, 0x24, 0x1f, 0x21, 0x55, 0x38, 0x7d, 0x41, 0x6d, 0x90, 0xc4, 0x29, 0x44, 0xb5, 0xad,
-	0x8a, 0x89, 0xc9, 0x3a, 0x57, 0x9d, 0xeb, 0x54, 0xed, 0xd7, 0xb6, 0x1a, 0x1a, 0x71, 0x09, 0x29,
-	0x63, 0xfd, 0x55, 0xfc, 0xe8, 0x06, 0xb3, 0xdd, 0xb0, 0x49, 0xfc, 0xe0, 0x4d, 0x37, 0x28, 0x09,
-	0x40, 0x21, 0xbb, 0xed, 0x0a, 0xe7, 0x90, 0x20, 0x51, 0x51, 0x5a, 0xb3, 0x14, 0xe8, 0xaa, 0x18,
-	0x89, 0x06, 0xd6, 0xa0, 0x38, 0x03, 0x7f, 0x2c, 0x1a, 0xae, 0xb2, 0x6e, 0x40, 0x22, 0x24, 0x7a,
-	0xe5, 0xca, 0x33, 0x33, 0xd2, 0x25, 0x7a, 0xb1, 0xbd, 0xb0, 0x89, 0xc3, 0x7d, 0x68, 0xe4, 0x1d,
-	0xc4, 0xcf, 0x9a, 0x51, 0xe1, 0x54, 0xdc, 0x40, 0x34, 0x0e, 0xd6, 0xc1, 0xf0, 0xa0, 0x27, 0xf2,
-	0x36, 0x59, 0xbe, 0x8e, 0xa5, 0xda, 0x17, 0xf2, 0x1e, 0x92, 0x25, 0xc6, 0x4e, 0xdc, 0x6e, 0x70,
-	0x27, 0xff, 0xb8, 0x55, 0x9a, 0x15, 0xd8, 0x7b, 0x80, 0xf8, 0xd3, 0xd7, 0xf7, 0xfe, 0x21, 0xfa,
-	0x00, 0x83, 0x31, 0x96, 0xdf, 0x4f, 0xf5, 0xe4, 0x17, 0xc5, 0xd1, 0x9a, 0x6a, 0xff, 0x73, 0x71,
-	0xbc, 0x31, 0x61, 0x27, 0x77, 0x46, 0x51, 0x28, 0xbd, 0xff, 0x17, 0x00, 0x00, 0xff, 0xff, 0x7f,
-	0x11, 0x28, 0x06, 0x85, 0x01, 0x00, 0x00,
+	// 701 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xae, 0xe3, 0xfc, 0x4e, 0x7e, 0x70, 0x97, 0xd2, 0x9a, 0x02, 0xa2, 0x58, 0x1c, 0x50, 0x41,
+	0x15, 0x4a, 0x85, 0x38, 0xa2, 0x26, 0x0d, 0x34, 0x2a, 0x38, 0xd2, 0xd6, 0x95, 0x7a, 0xb3, 0x1c,
+	0x7b, 0x9b, 0x5a, 0x8d, 0xbd, 0xee, 0xee, 0x06, 0xf9, 0x01, 0x78, 0x0c, 0x2e, 0x3c, 0x03, 0x37,
+	0x9e, 0x80, 0xc7, 0x42, 0xbb, 0x76, 0x62, 0x37, 0x54, 0x48, 0x95, 0xb8, 0x79, 0xbe, 0xdd, 0xf9,
+	0xe6, 0xfb, 0x66, 0xc6, 0x0b, 0xdd, 0x74, 0x91, 0x10, 0x46, 0xf9, 0x41, 0xc2, 0xa8, 0xa0, 0xa8,
+	0x91, 0x2e, 0x12, 0xca, 0x93, 0xa9, 0xf5, 0x01, 0x5a, 0x98, 0xdc, 0x9c, 0x10, 0x2f, 0x20, 0x0c,
+	0x3d, 0x82, 0xfa, 0x9c, 0xce, 0xdc, 0x30, 0x30, 0xb5, 0x3d, 0xed, 0x55, 0x0b, 0xd7, 0xe6, 0x74,
+	0x36, 0x0e, 0xd0, 0x13, 0x68, 0x71, 0x32, 0xbf, 0x74, 0x63, 0x2f, 0x22, 0x66, 0x45, 0x9d, 0x34,
+	0x25, 0x60, 0x7b, 0x11, 0xb1, 0x18, 0x00, 0x26, 0x3c, 0xf9, 0x37, 0xc3, 0x63, 0x68, 0x12, 0xc6,
+	0x5c, 0x9f, 0x06, 0x19, 0x81, 0x8e, 0x1b, 0x84, 0xb1, 0x21, 0x0d, 0x08, 0xda, 0x01, 0xf9, 0xe9,
+	0x46, 0x7c, 0x66, 0xea, 0x2a, 0xa5, 0x4e, 0x18, 0xfb, 0xc2, 0x67, 0x32, 0x47, 0x30, 0xcf, 0x27,
+	0x92, 0xac, 0xaa, 0x4e, 0x1a, 0x2a, 0x1e, 0x07, 0xd6, 0x3b, 0x68, 0x0c, 0x3c, 0x4e, 0x30, 0xb9,
+	0x41, 0xfb, 0x50, 0xbf, 0x52, 0xa5, 0x55, 0xc1, 0x76, 0x1f, 0x1d, 0xe4, 0xce, 0x0e, 0x56, 0xb6,
+	0x70, 0x7e, 0xc3, 0x7a, 0x0f, 0xcd, 0x2c, 0x8d, 0x27, 0xe8, 0xf5, 0x5a, 0xde, 0xc3, 0x52, 0x1e,
+	0x4f, 0xd6, 0x12, 0x7f, 0x68, 0xd0, 0x3a, 0x0a, 0x02, 0x46, 0x38, 0x77, 0x52, 0xb4, 0x2d, 0x53,
+	0xc3, 0xd9, 0x95, 0x50, 0xa9, 0x3a, 0xce, 0x23, 0x84, 0xa0, 0x2a, 0xd2, 0x30, 0x50, 0x06, 0x3b,
+	0x58, 0x7d, 0xa3, 0x3e, 0xb4, 0x82, 0x90, 0x11, 0x5f, 0x84, 0x34, 0x56, 0xfe, 0x7a, 0xfd, 0xad,
+	0x55, 0x25, 0x27, 0x3d, 0x5e, 0x9e, 0xe1, 0xe2, 0x9a, 0xe4, 0xf7, 0x22, 0xba, 0x88, 0x45, 0x6e,
+	0x3b, 0x8f, 0xd0, 0x53, 0x68, 0x89, 0x30, 0x22, 0x5c, 0x78, 0x51, 0x62, 0xd6, 0x54, 0xe9, 0x02,
+	0xb0, 0xbe, 0x6b, 0xd0, 0x5d, 0x69, 0xe4, 0xf7, 0x6c, 0x8d, 0x9c, 0xc2, 0xd4, 0x2f, 0x0f, 0xb8,
+	0x3e, 0xf5, 0xe5, 0x78, 0x91, 0x09, 0x0d, 0x2f, 0x63, 0xcd, 0xc7, 0xb3, 0x0c, 0xa5, 0x4c, 0x7f,
+	0xc1, 0x38, 0x65, 0x4b, 0x99, 0x59, 0x84, 0xb6, 0xa0, 0x36, 0x0f, 0xa3, 0x50, 0x28, 0x89, 0x35,
+	0x9c, 0x05, 0xd6, 0x37, 0x0d, 0x7a, 0x65, 0x79, 0xf7, 0x1c, 0x01, 0x7a, 0x09, 0xba, 0x48, 0xb9,
+	0x59, 0xd9, 0xd3, 0x6f, 0x39, 0x59, 0x51, 0x62, 0x79, 0x8c, 0x9e, 0x43, 0x3b, 0x26, 0xa9, 0x70,
+	0x73, 0x61, 0x99, 0x62, 0x90, 0xd0, 0x50, 0x21, 0xd6, 0x2f, 0x0d, 0xba, 0x83, 0x39, 0xf5, 0xaf,
+	0xb1, 0x17, 0xcf, 0xc8, 0x7f, 0xeb, 0x12, 0x82, 0xea, 0x25, 0xa3, 0x91, 0x2a, 0xa8, 0x63, 0xf5,
+	0x8d, 0x7a, 0x50, 0x11, 0x54, 0xf5, 0x46, 0xc7, 0x15, 0x41, 0xd1, 0x0b, 0xe8, 0xc4, 0x84, 0x04,
+	0xae, 0x4f, 0x63, 0x41, 0xe2, 0xac, 0x3d, 0x4d, 0xdc, 0x96, 0xd8, 0x30, 0x83, 0xd0, 0x33, 0x00,
+	0x92, 0xfa, 0xf3, 0x45, 0x40, 0x5c, 0x91, 0x9a, 0x75, 0x75, 0xa1, 0x95, 0x23, 0x4e, 0x6a, 0xfd,
+	0xd4, 0xa0, 0x57, 0x16, 0x7f, 0xdf, 0x1e, 0x16, 0x8b, 0x5b, 0xb9, 0xb5, 0xb8, 0x26, 0x34, 0xa6,
+	0x92, 0x36, 0x0c, 0x94, 0x81, 0x0e, 0x5e, 0x86, 0xe8, 0x0d, 0xd4, 0xb9, 0xf0, 0xc4, 0x82, 0x9b,
+	0xd5, 0xb5, 0xdd, 0x55, 0x3a, 0xce, 0xd4, 0x19, 0xce, 0xef, 0xc8, 0xc9, 0xab, 0x44, 0x65, 0xad,
+	0x83, 0xb3, 0x60, 0xff, 0x23, 0xb4, 0x4b, 0x8b, 0x8e, 0x10, 0xf4, 0x8e, 0xc7, 0x78, 0x34, 0x74,
+	0xc6, 0x13, 0xdb, 0xb5, 0x27, 0xf6, 0xc8, 0xd8, 0x40, 0x06, 0x74, 0x0a, 0x6c, 0x6c, 0x1b, 0x1a,
+	0xda, 0x84, 0x6e, 0x81, 0x4c, 0xce, 0x1d, 0xa3, 0xb2, 0xef, 0x40, 0xbb, 0x54, 0x14, 0x3d, 0x80,
+	0xf6, 0xe0, 0xf3, 0x64, 0x78, 0xea, 0x8e, 0x30, 0x9e, 0x60, 0x63, 0xa3, 0x00, 0x1c, 0x7c, 0x6e,
+	0x9f, 0x1a, 0x9a, 0x64, 0xcd, 0x80, 0x01, 0x3e, 0xb2, 0x87, 0x27, 0x46, 0x45, 0xb2, 0x66, 0x88,
+	0x3d, 0x19, 0x5d, 0x8c, 0xcf, 0x1c, 0x43, 0xef, 0xff, 0xd6, 0xa0, 0x71, 0x21, 0x9f, 0xc6, 0xc9,
+	0x19, 0x3a, 0x04, 0x18, 0x5e, 0x11, 0xff, 0xfa, 0x68, 0x1e, 0x7e, 0x25, 0xc8, 0x28, 0xbc, 0x66,
+	0x6f, 0xcd, 0xee, 0xe6, 0x1a, 0xc2, 0x13, 0x6b, 0x03, 0x0d, 0xa0, 0xfb, 0x89, 0x88, 0x62, 0xb5,
+	0xd1, 0xf6, 0xdf, 0xcb, 0x29, 0x7f, 0xc7, 0xdd, 0x9d, 0x3b, 0x71, 0xc5, 0x71, 0xac, 0x38, 0x8a,
+	0xd1, 0x96, 0x38, 0x6e, 0x2d, 0xeb, 0xee, 0xce, 0x9d, 0xb8, 0xe4, 0x78, 0xab, 0x4d, 0xeb, 0xea,
+	0x69, 0x3f, 0xfc, 0x33, 0x00, 0x04, 0xd9, 0xa2, 0x1c, 0xeb, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -264,6 +720,10 @@ const _ = grpc.SupportPackageIsVersion6
 type XuperOSClient interface {
 	// 示例接口
 	CheckAlive(ctx context.Context, in *BaseReq, opts ...grpc.CallOption) (*BaseResp, error)
+	// 分页查询地址相关交易，需要开启地址交易索引
+	GetAddressTxs(ctx context.Context, in *AddressTxsReq, opts ...grpc.CallOption) (*AddressTxsResp, error)
+	// 按高度顺序流式返回区块，出错时最后一条响应的header带错误码
+	GetBlockRange(ctx context.Context, in *BlockRangeReq, opts ...grpc.CallOption) (XuperOS_GetBlockRangeClient, error)
 }
 
 type xuperOSClient struct {
@@ -283,10 +743,55 @@ func (c *xuperOSClient) CheckAlive(ctx context.Context, in *BaseReq, opts ...grp
 	return out, nil
 }
 
+func (c *xuperOSClient) GetAddressTxs(ctx context.Context, in *AddressTxsReq, opts ...grpc.CallOption) (*AddressTxsResp, error) {
+	out := new(AddressTxsResp)
+	err := c.cc.Invoke(ctx, "/xupospb.XuperOS/GetAddressTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xuperOSClient) GetBlockRange(ctx context.Context, in *BlockRangeReq, opts ...grpc.CallOption) (XuperOS_GetBlockRangeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_XuperOS_serviceDesc.Streams[0], "/xupospb.XuperOS/GetBlockRange", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &xuperOSGetBlockRangeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type XuperOS_GetBlockRangeClient interface {
+	Recv() (*BlockRangeResp, error)
+	grpc.ClientStream
+}
+
+type xuperOSGetBlockRangeClient struct {
+	grpc.ClientStream
+}
+
+func (x *xuperOSGetBlockRangeClient) Recv() (*BlockRangeResp, error) {
+	m := new(BlockRangeResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // XuperOSServer is the server API for XuperOS service.
 type XuperOSServer interface {
 	// 示例接口
 	CheckAlive(context.Context, *BaseReq) (*BaseResp, error)
+	// 分页查询地址相关交易，需要开启地址交易索引
+	GetAddressTxs(context.Context, *AddressTxsReq) (*AddressTxsResp, error)
+	// 按高度顺序流式返回区块，出错时最后一条响应的header带错误码
+	GetBlockRange(*BlockRangeReq, XuperOS_GetBlockRangeServer) error
 }
 
 // UnimplementedXuperOSServer can be embedded to have forward compatible implementations.
@@ -296,6 +801,12 @@ type UnimplementedXuperOSServer struct {
 func (*UnimplementedXuperOSServer) CheckAlive(ctx context.Context, req *BaseReq) (*BaseResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckAlive not implemented")
 }
+func (*UnimplementedXuperOSServer) GetAddressTxs(ctx context.Context, req *AddressTxsReq) (*AddressTxsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAddressTxs not implemented")
+}
+func (*UnimplementedXuperOSServer) GetBlockRange(req *BlockRangeReq, srv XuperOS_GetBlockRangeServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetBlockRange not implemented")
+}
 
 func RegisterXuperOSServer(s *grpc.Server, srv XuperOSServer) {
 	s.RegisterService(&_XuperOS_serviceDesc, srv)
@@ -319,6 +830,45 @@ func _XuperOS_CheckAlive_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _XuperOS_GetAddressTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddressTxsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XuperOSServer).GetAddressTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xupospb.XuperOS/GetAddressTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XuperOSServer).GetAddressTxs(ctx, req.(*AddressTxsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _XuperOS_GetBlockRange_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BlockRangeReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(XuperOSServer).GetBlockRange(m, &xuperOSGetBlockRangeServer{stream})
+}
+
+type XuperOS_GetBlockRangeServer interface {
+	Send(*BlockRangeResp) error
+	grpc.ServerStream
+}
+
+type xuperOSGetBlockRangeServer struct {
+	grpc.ServerStream
+}
+
+func (x *xuperOSGetBlockRangeServer) Send(m *BlockRangeResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _XuperOS_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "xupospb.XuperOS",
 	HandlerType: (*XuperOSServer)(nil),
@@ -327,7 +877,17 @@ var _XuperOS_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CheckAlive",
 			Handler:    _XuperOS_CheckAlive_Handler,
 		},
+		{
+			MethodName: "GetAddressTxs",
+			Handler:    _XuperOS_GetAddressTxs_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetBlockRange",
+			Handler:       _XuperOS_GetBlockRange_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "xuperos.proto",
 }
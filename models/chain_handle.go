@@ -14,35 +14,44 @@ import (
 	"github.com/xuperchain/xupercore/lib/logs"
 	"github.com/xuperchain/xupercore/protos"
 
+	"github.com/xuperchain/xuperos/common/chainmgr"
 	sctx "github.com/xuperchain/xuperos/common/context"
 )
 
 type ChainHandle struct {
-	bcName string
-	reqCtx sctx.ReqCtx
-	log    logs.Logger
-	chain  ecom.Chain
+	bcName  string
+	reqCtx  sctx.ReqCtx
+	log     logs.Logger
+	chain   ecom.Chain
+	release func()
 }
 
+// NewChainHandle 获取链并记录使用，请求结束后需要调用Release，卸载链时会等待使用结束
 func NewChainHandle(bcName string, reqCtx sctx.ReqCtx) (*ChainHandle, error) {
 	if bcName == "" || reqCtx == nil || reqCtx.GetEngine() == nil {
 		return nil, ecom.ErrParameter
 	}
 
-	chain, err := reqCtx.GetEngine().Get(bcName)
+	chain, release, err := chainmgr.AcquireChain(reqCtx.GetEngine(), bcName)
 	if err != nil {
 		return nil, ecom.ErrChainNotExist
 	}
 
 	obj := &ChainHandle{
-		bcName: bcName,
-		reqCtx: reqCtx,
-		log:    reqCtx.GetLog(),
-		chain:  chain,
+		bcName:  bcName,
+		reqCtx:  reqCtx,
+		log:     reqCtx.GetLog(),
+		chain:   chain,
+		release: release,
 	}
 	return obj, nil
 }
 
+// Release 结束对链的使用，需要幂等
+func (t *ChainHandle) Release() {
+	t.release()
+}
+
 func (t *ChainHandle) SubmitTx(tx *lpb.Transaction) error {
 	return t.chain.SubmitTx(t.genXctx(), tx)
 }
@@ -128,6 +137,11 @@ func (t *ChainHandle) IsTrunkTipBlock(blockId []byte) (bool, error) {
 	return reader.NewChainReader(t.chain.Context(), t.genXctx()).IsTrunkTipBlock(blockId)
 }
 
+// 当前主干高度
+func (t *ChainHandle) GetTrunkHeight() int64 {
+	return t.chain.Context().Ledger.GetMeta().GetTrunkHeight()
+}
+
 func (t *ChainHandle) QueryBlockByHeight(height int64, needContent bool) (*xpb.BlockInfo, error) {
 	return reader.NewLedgerReader(t.chain.Context(), t.genXctx()).QueryBlockByHeight(height, needContent)
 }
@@ -136,6 +150,16 @@ func (t *ChainHandle) GetAccountByAK(address string) ([]string, error) {
 	return reader.NewContractReader(t.chain.Context(), t.genXctx()).GetAccountByAK(address)
 }
 
+// 获取未确认交易池快照
+func (t *ChainHandle) GetTxPool() (*TxPool, error) {
+	txs, err := t.chain.Context().State.GetUnconfirmedTx(false)
+	if err != nil {
+		t.log.Warn("get unconfirmed tx failed", "err", err)
+		return nil, ecom.ErrInternal
+	}
+	return NewTxPool(txs), nil
+}
+
 func (t *ChainHandle) genXctx() xctx.XContext {
 	return &xctx.BaseCtx{
 		XLog:  t.reqCtx.GetLog(),
@@ -0,0 +1,33 @@
+package models
+
+import (
+	"bytes"
+
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/state/xmodel"
+	lpb "github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
+	"github.com/xuperchain/xupercore/protos"
+)
+
+var (
+	// 合约事件写在交易读写集的临时bucket中
+	contractEventKey = []byte("contractEvent")
+)
+
+// ParseContractEvents 从交易中解析合约事件
+func ParseContractEvents(tx *lpb.Transaction) ([]*protos.ContractEvent, error) {
+	var events []*protos.ContractEvent
+	for _, out := range tx.GetTxOutputsExt() {
+		if out.GetBucket() != xmodel.TransientBucket {
+			continue
+		}
+		if !bytes.Equal(out.GetKey(), contractEventKey) {
+			continue
+		}
+		err := xmodel.UnmsarshalMessages(out.GetValue(), &events)
+		if err != nil {
+			return nil, err
+		}
+		break
+	}
+	return events, nil
+}
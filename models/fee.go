@@ -0,0 +1,114 @@
+package models
+
+import (
+	"math/big"
+
+	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+	"github.com/xuperchain/xupercore/protos"
+)
+
+// 手续费估算结果
+type FeeEstimate struct {
+	GasUsed int64
+	// 用户合约请求的资源消耗合计，不含保留合约
+	Resources []*protos.ResourceLimit
+	GasPrice  *protos.GasPrice
+	Fee       int64
+	// 发起者需要支付的总金额，含合约转账和手续费
+	TotalNeed  *big.Int
+	UtxoCount  int64
+	UtxoEnough bool
+}
+
+// 预执行合约请求估算gas和手续费，并试选发起者的utxo(不锁定)
+// amount为普通转账金额，不含合约转账和手续费
+func (t *ChainHandle) EstimateFee(reqs []*protos.InvokeRequest, initiator string,
+	authRequires []string, amount *big.Int) (*FeeEstimate, error) {
+	if initiator == "" || amount == nil || amount.Sign() < 0 {
+		return nil, ecom.ErrParameter
+	}
+
+	state := t.chain.Context().State
+	reserved, err := state.GetReservedContractRequests(reqs, true)
+	if err != nil {
+		t.log.Warn("get reserved contract requests failed", "err", err)
+		return nil, ecom.ErrParameter.More("%v", err)
+	}
+	res, err := t.PreExec(reqs, initiator, authRequires)
+	if err != nil {
+		return nil, err
+	}
+
+	estimate := &FeeEstimate{
+		GasUsed:  res.GetGasUsed(),
+		GasPrice: state.GetMeta().GetGasPrice(),
+		Fee:      res.GetGasUsed(),
+	}
+	// 预执行结果中保留合约请求排在最前
+	userReqs := res.GetRequests()
+	if len(reserved) <= len(userReqs) {
+		userReqs = userReqs[len(reserved):]
+	}
+	estimate.Resources = sumResourceLimits(userReqs)
+
+	// 无币化链不需要支付手续费和选择utxo
+	if t.chain.Context().Ledger.GetNoFee() {
+		estimate.Fee = 0
+		estimate.TotalNeed = new(big.Int)
+		estimate.UtxoEnough = true
+		return estimate, nil
+	}
+
+	estimate.TotalNeed, err = totalNeedAmount(reqs, amount, estimate.Fee)
+	if err != nil {
+		return nil, err
+	}
+	if estimate.TotalNeed.Sign() == 0 {
+		estimate.UtxoEnough = true
+		return estimate, nil
+	}
+	inputs, _, _, err := state.SelectUtxos(initiator, estimate.TotalNeed, false, false)
+	if err != nil {
+		// 余额不足不作为错误返回
+		t.log.Debug("select utxo for fee estimate failed", "initiator", initiator, "err", err)
+		return estimate, nil
+	}
+	estimate.UtxoCount = int64(len(inputs))
+	estimate.UtxoEnough = true
+
+	return estimate, nil
+}
+
+// 按资源类型累加各请求的资源消耗
+func sumResourceLimits(reqs []*protos.InvokeRequest) []*protos.ResourceLimit {
+	types := []protos.ResourceType{protos.ResourceType_CPU, protos.ResourceType_MEMORY,
+		protos.ResourceType_DISK, protos.ResourceType_XFEE}
+	limits := make([]*protos.ResourceLimit, 0, len(types))
+	for _, typ := range types {
+		limits = append(limits, &protos.ResourceLimit{Type: typ})
+	}
+	for _, req := range reqs {
+		for _, limit := range req.GetResourceLimits() {
+			if int(limit.GetType()) < len(limits) {
+				limits[limit.GetType()].Limit += limit.GetLimit()
+			}
+		}
+	}
+	return limits
+}
+
+// 转账金额、合约转账金额和手续费之和
+func totalNeedAmount(reqs []*protos.InvokeRequest, amount *big.Int, fee int64) (*big.Int, error) {
+	total := new(big.Int).Add(amount, big.NewInt(fee))
+	for _, req := range reqs {
+		if req.GetAmount() == "" {
+			continue
+		}
+		reqAmount, ok := new(big.Int).SetString(req.GetAmount(), 10)
+		if !ok || reqAmount.Sign() < 0 {
+			return nil, ecom.ErrParameter.More("invalid contract amount")
+		}
+		total.Add(total, reqAmount)
+	}
+	return total, nil
+}
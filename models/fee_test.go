@@ -0,0 +1,53 @@
+package models
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/xuperchain/xupercore/protos"
+)
+
+func TestFeeEstimateHelpers(t *testing.T) {
+	reqs := []*protos.InvokeRequest{
+		{
+			ContractName: "counter",
+			Amount:       "10",
+			ResourceLimits: []*protos.ResourceLimit{
+				{Type: protos.ResourceType_CPU, Limit: 100},
+				{Type: protos.ResourceType_DISK, Limit: 20},
+			},
+		},
+		{
+			ContractName: "other",
+			ResourceLimits: []*protos.ResourceLimit{
+				{Type: protos.ResourceType_CPU, Limit: 50},
+				{Type: protos.ResourceType_XFEE, Limit: 5},
+			},
+		},
+	}
+
+	limits := sumResourceLimits(reqs)
+	expect := map[protos.ResourceType]int64{
+		protos.ResourceType_CPU:    150,
+		protos.ResourceType_MEMORY: 0,
+		protos.ResourceType_DISK:   20,
+		protos.ResourceType_XFEE:   5,
+	}
+	if len(limits) != len(expect) {
+		t.Fatalf("unexpected limits %v", limits)
+	}
+	for _, limit := range limits {
+		if limit.GetLimit() != expect[limit.GetType()] {
+			t.Errorf("%s expect %d, actual %d", limit.GetType(), expect[limit.GetType()], limit.GetLimit())
+		}
+	}
+
+	total, err := totalNeedAmount(reqs, big.NewInt(100), 7)
+	if err != nil || total.String() != "117" {
+		t.Errorf("unexpected total need %v, err %v", total, err)
+	}
+	reqs[1].Amount = "-1"
+	if _, err := totalNeedAmount(reqs, big.NewInt(0), 0); err == nil {
+		t.Errorf("expect invalid amount error")
+	}
+}
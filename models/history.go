@@ -0,0 +1,141 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+
+	lpb "github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
+	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+	aclUtils "github.com/xuperchain/xupercore/kernel/permission/acl/utils"
+	"github.com/xuperchain/xupercore/protos"
+)
+
+const (
+	// 历史余额通过从创世块回放账本计算，限制可查询的最大高度
+	MaxBalanceReplayHeight = 100000
+	// 手续费输出地址，上链后归属区块矿工
+	feePlaceholder = "$"
+)
+
+var (
+	ErrHeightBeyondTip      = ecom.ErrParameter.More("height beyond trunk tip")
+	ErrBlockNotInTrunk      = ecom.ErrParameter.More("block not in trunk")
+	ErrHistoryPruned        = ecom.ErrBlockNotExist.More("history state pruned")
+	ErrReplayHeightExceeded = ecom.ErrForbidden.More("height exceeds max balance replay height")
+)
+
+// 解析历史查询的目标区块，blockid优先，否则按高度查询主干区块
+func (t *ChainHandle) ResolveHistoryBlock(height int64, blockid []byte) (*lpb.InternalBlock, error) {
+	if height < 0 {
+		return nil, ecom.ErrParameter
+	}
+
+	ledger := t.chain.Context().Ledger
+	if len(blockid) > 0 {
+		block, err := ledger.QueryBlockHeader(blockid)
+		if err != nil {
+			t.log.Warn("query history block failed", "blockid", blockid, "err", err)
+			return nil, ErrHistoryPruned
+		}
+		if !block.GetInTrunk() {
+			return nil, ErrBlockNotInTrunk
+		}
+		if height > 0 && height != block.GetHeight() {
+			return nil, ecom.ErrParameter.More("height and blockid mismatch")
+		}
+		return block, nil
+	}
+
+	if height > ledger.GetMeta().GetTrunkHeight() {
+		return nil, ErrHeightBeyondTip
+	}
+	block, err := ledger.QueryBlockByHeight(height)
+	if err != nil {
+		t.log.Warn("query history block failed", "height", height, "err", err)
+		return nil, ErrHistoryPruned
+	}
+	return block, nil
+}
+
+// 查询账户在指定区块时的ACL，账户不存在时返回nil
+func (t *ChainHandle) QueryAccountACLAt(block *lpb.InternalBlock, account string) (*protos.Acl, error) {
+	return t.queryACLAt(block, aclUtils.GetAccountBucket(), account)
+}
+
+// 查询合约方法在指定区块时的ACL，未设置时返回nil
+func (t *ChainHandle) QueryContractMethodACLAt(block *lpb.InternalBlock,
+	contract, method string) (*protos.Acl, error) {
+	return t.queryACLAt(block, aclUtils.GetContractBucket(),
+		aclUtils.MakeContractMethodKey(contract, method))
+}
+
+// 查询地址在指定区块时的余额，只统计已上链交易
+func (t *ChainHandle) GetBalanceAt(block *lpb.InternalBlock, address string) (string, error) {
+	ledger := t.chain.Context().Ledger
+	balance, err := replayBalance(address, block.GetHeight(), ledger.QueryBlockByHeight)
+	if err != nil {
+		t.log.Warn("replay balance failed", "address", address, "height", block.GetHeight(), "err", err)
+		return "", err
+	}
+	return balance.String(), nil
+}
+
+func (t *ChainHandle) queryACLAt(block *lpb.InternalBlock, bucket, key string) (*protos.Acl, error) {
+	reader, err := t.chain.Context().State.CreateXMSnapshotReader(block.GetBlockid())
+	if err != nil {
+		t.log.Warn("create snapshot reader failed", "blockid", block.GetBlockid(), "err", err)
+		return nil, ErrHistoryPruned
+	}
+	val, err := reader.Get(bucket, []byte(key))
+	if err != nil {
+		// 版本链上的交易或区块已被裁剪时无法回溯
+		t.log.Warn("read snapshot failed", "bucket", bucket, "key", key, "err", err)
+		return nil, ErrHistoryPruned
+	}
+	if len(val) == 0 {
+		return nil, nil
+	}
+
+	acl := &protos.Acl{}
+	if err := json.Unmarshal(val, acl); err != nil {
+		t.log.Warn("unmarshal acl failed", "bucket", bucket, "key", key, "err", err)
+		return nil, ecom.ErrInternal
+	}
+	return acl, nil
+}
+
+// 从创世块回放到height，累加地址收到的输出并扣除花费的输入
+func replayBalance(address string, height int64,
+	queryBlock func(height int64) (*lpb.InternalBlock, error)) (*big.Int, error) {
+	if height > MaxBalanceReplayHeight {
+		return nil, ErrReplayHeightExceeded
+	}
+
+	addr := []byte(address)
+	balance := new(big.Int)
+	for h := int64(0); h <= height; h++ {
+		block, err := queryBlock(h)
+		if err != nil {
+			return nil, ErrHistoryPruned
+		}
+		for _, tx := range block.GetTransactions() {
+			for _, input := range tx.GetTxInputs() {
+				if bytes.Equal(input.GetFromAddr(), addr) {
+					balance.Sub(balance, new(big.Int).SetBytes(input.GetAmount()))
+				}
+			}
+			for _, output := range tx.GetTxOutputs() {
+				toAddr := output.GetToAddr()
+				if string(toAddr) == feePlaceholder {
+					toAddr = block.GetProposer()
+				}
+				if bytes.Equal(toAddr, addr) {
+					balance.Add(balance, new(big.Int).SetBytes(output.GetAmount()))
+				}
+			}
+		}
+	}
+
+	return balance, nil
+}
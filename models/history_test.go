@@ -0,0 +1,62 @@
+package models
+
+import (
+	"math/big"
+	"testing"
+
+	lpb "github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
+	"github.com/xuperchain/xupercore/protos"
+)
+
+func TestReplayBalance(t *testing.T) {
+	amount := func(n int64) []byte {
+		return big.NewInt(n).Bytes()
+	}
+	blocks := []*lpb.InternalBlock{
+		// 创世块给alice 100
+		{Height: 0, Transactions: []*lpb.Transaction{
+			{TxOutputs: []*protos.TxOutput{{ToAddr: []byte("alice"), Amount: amount(100)}}},
+		}},
+		// alice转给bob 30，手续费1归bob出块
+		{Height: 1, Proposer: []byte("bob"), Transactions: []*lpb.Transaction{
+			{
+				TxInputs: []*protos.TxInput{{FromAddr: []byte("alice"), Amount: amount(100)}},
+				TxOutputs: []*protos.TxOutput{
+					{ToAddr: []byte("bob"), Amount: amount(30)},
+					{ToAddr: []byte("$"), Amount: amount(1)},
+					{ToAddr: []byte("alice"), Amount: amount(69)},
+				},
+			},
+		}},
+	}
+	queryBlock := func(height int64) (*lpb.InternalBlock, error) {
+		if height >= int64(len(blocks)) {
+			return nil, ErrHistoryPruned
+		}
+		return blocks[height], nil
+	}
+
+	cases := []struct {
+		addr   string
+		height int64
+		expect string
+	}{
+		{"alice", 0, "100"},
+		{"alice", 1, "69"},
+		{"bob", 0, "0"},
+		{"bob", 1, "31"},
+	}
+	for _, c := range cases {
+		balance, err := replayBalance(c.addr, c.height, queryBlock)
+		if err != nil || balance.String() != c.expect {
+			t.Errorf("%s at %d expect %s, actual %v, err %v", c.addr, c.height, c.expect, balance, err)
+		}
+	}
+
+	if _, err := replayBalance("alice", 2, queryBlock); err != ErrHistoryPruned {
+		t.Errorf("expect pruned, actual %v", err)
+	}
+	if _, err := replayBalance("alice", MaxBalanceReplayHeight+1, queryBlock); err != ErrReplayHeightExceeded {
+		t.Errorf("expect replay height exceeded, actual %v", err)
+	}
+}
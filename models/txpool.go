@@ -0,0 +1,158 @@
+package models
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"sort"
+	"time"
+
+	lpb "github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
+	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+)
+
+const (
+	DefTxPoolLimit = 20
+	MaxTxPoolLimit = 100
+	// 状态统计中返回的发起者和冲突数量上限
+	maxTxPoolStatItems = 20
+)
+
+var (
+	ErrInvalidTxPoolCursor = ecom.ErrParameter.More("invalid cursor")
+
+	txPoolCursorRegex = regexp.MustCompile(`^\d{20}/[0-9a-f]+$`)
+)
+
+// 未确认交易池快照，按时间戳和txid排序
+type TxPool struct {
+	txs []*lpb.Transaction
+}
+
+// 交易池统计
+type TxPoolStatus struct {
+	TxCount         int64
+	OldestTimestamp int64
+	NewestTimestamp int64
+	MaxAgeSeconds   int64
+	TopInitiators   []*TxPoolInitiatorStat
+	Conflicts       []*TxPoolConflict
+}
+
+type TxPoolInitiatorStat struct {
+	Initiator string
+	TxCount   int64
+}
+
+// 被多笔未确认交易引用的utxo
+type TxPoolConflict struct {
+	RefTxid   []byte
+	RefOffset int32
+	Txids     [][]byte
+}
+
+func NewTxPool(txs []*lpb.Transaction) *TxPool {
+	sorted := make([]*lpb.Transaction, len(txs))
+	copy(sorted, txs)
+	sort.Slice(sorted, func(i, j int) bool {
+		return txPoolKey(sorted[i]) < txPoolKey(sorted[j])
+	})
+
+	return &TxPool{txs: sorted}
+}
+
+// 分页查询交易，initiator为空不过滤，nextCursor为空表示没有更多数据
+func (t *TxPool) List(initiator, cursor string, limit int) ([]*lpb.Transaction, string, int64, error) {
+	if cursor != "" && !txPoolCursorRegex.MatchString(cursor) {
+		return nil, "", 0, ErrInvalidTxPoolCursor
+	}
+	if limit <= 0 {
+		limit = DefTxPoolLimit
+	}
+	if limit > MaxTxPoolLimit {
+		limit = MaxTxPoolLimit
+	}
+
+	var total int64
+	txs := make([]*lpb.Transaction, 0, limit)
+	nextCursor := ""
+	for _, tx := range t.txs {
+		if initiator != "" && tx.GetInitiator() != initiator {
+			continue
+		}
+		total++
+		if txPoolKey(tx) <= cursor || nextCursor != "" {
+			continue
+		}
+		if len(txs) >= limit {
+			nextCursor = txPoolKey(txs[len(txs)-1])
+			continue
+		}
+		txs = append(txs, tx)
+	}
+
+	return txs, nextCursor, total, nil
+}
+
+// 统计交易池大小、等待时间、发起者分布和utxo冲突
+func (t *TxPool) Status(now time.Time) *TxPoolStatus {
+	status := &TxPoolStatus{
+		TxCount:       int64(len(t.txs)),
+		TopInitiators: make([]*TxPoolInitiatorStat, 0),
+		Conflicts:     make([]*TxPoolConflict, 0),
+	}
+	if len(t.txs) == 0 {
+		return status
+	}
+	status.OldestTimestamp = t.txs[0].GetTimestamp()
+	status.NewestTimestamp = t.txs[len(t.txs)-1].GetTimestamp()
+	if age := now.UnixNano() - status.OldestTimestamp; age > 0 {
+		status.MaxAgeSeconds = age / int64(time.Second)
+	}
+
+	initiators := make(map[string]*TxPoolInitiatorStat)
+	spent := make(map[string]*TxPoolConflict)
+	for _, tx := range t.txs {
+		stat, ok := initiators[tx.GetInitiator()]
+		if !ok {
+			stat = &TxPoolInitiatorStat{Initiator: tx.GetInitiator()}
+			initiators[tx.GetInitiator()] = stat
+			status.TopInitiators = append(status.TopInitiators, stat)
+		}
+		stat.TxCount++
+
+		for _, input := range tx.GetTxInputs() {
+			key := fmt.Sprintf("%x_%d", input.GetRefTxid(), input.GetRefOffset())
+			ref, ok := spent[key]
+			if !ok {
+				ref = &TxPoolConflict{RefTxid: input.GetRefTxid(), RefOffset: input.GetRefOffset()}
+				spent[key] = ref
+			}
+			// 同一交易重复引用只记一次
+			if n := len(ref.Txids); n > 0 && bytes.Equal(ref.Txids[n-1], tx.GetTxid()) {
+				continue
+			}
+			ref.Txids = append(ref.Txids, tx.GetTxid())
+			if len(ref.Txids) == 2 {
+				status.Conflicts = append(status.Conflicts, ref)
+			}
+		}
+	}
+
+	sort.SliceStable(status.TopInitiators, func(i, j int) bool {
+		return status.TopInitiators[i].TxCount > status.TopInitiators[j].TxCount
+	})
+	if len(status.TopInitiators) > maxTxPoolStatItems {
+		status.TopInitiators = status.TopInitiators[:maxTxPoolStatItems]
+	}
+	if len(status.Conflicts) > maxTxPoolStatItems {
+		status.Conflicts = status.Conflicts[:maxTxPoolStatItems]
+	}
+
+	return status
+}
+
+// 排序和分页游标使用的key
+func txPoolKey(tx *lpb.Transaction) string {
+	return fmt.Sprintf("%020d/%x", tx.GetTimestamp(), tx.GetTxid())
+}
@@ -0,0 +1,70 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	lpb "github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
+	"github.com/xuperchain/xupercore/protos"
+)
+
+func TestTxPool(t *testing.T) {
+	now := time.Now()
+	var txs []*lpb.Transaction
+	for i := 0; i < 5; i++ {
+		initiator := "alice"
+		if i%2 == 1 {
+			initiator = "bob"
+		}
+		txs = append(txs, &lpb.Transaction{
+			Txid:      []byte{byte(i)},
+			Initiator: initiator,
+			Timestamp: now.Add(-time.Duration(i) * time.Minute).UnixNano(),
+			TxInputs:  []*protos.TxInput{{RefTxid: []byte{byte(100 + i%3)}}},
+		})
+	}
+	pool := NewTxPool(txs)
+
+	// 分页从最早的交易开始
+	var txids []byte
+	cursor := ""
+	for {
+		page, next, total, err := pool.List("", cursor, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if total != 5 {
+			t.Errorf("unexpected total %d", total)
+		}
+		for _, tx := range page {
+			txids = append(txids, tx.Txid[0])
+		}
+		if next == "" {
+			break
+		}
+		cursor = next
+	}
+	if string(txids) != string([]byte{4, 3, 2, 1, 0}) {
+		t.Errorf("unexpected txids %v", txids)
+	}
+
+	page, _, total, _ := pool.List("bob", "", 0)
+	if total != 2 || len(page) != 2 || page[0].Initiator != "bob" {
+		t.Errorf("unexpected bob txs %v", page)
+	}
+	if _, _, _, err := pool.List("", "bad", 0); err != ErrInvalidTxPoolCursor {
+		t.Errorf("expect invalid cursor, actual %v", err)
+	}
+
+	status := pool.Status(now)
+	if status.TxCount != 5 || status.MaxAgeSeconds != 240 {
+		t.Errorf("unexpected status %+v", status)
+	}
+	if status.TopInitiators[0].Initiator != "alice" || status.TopInitiators[0].TxCount != 3 {
+		t.Errorf("unexpected top initiator %+v", status.TopInitiators[0])
+	}
+	// 引用100和101的交易各有两笔
+	if len(status.Conflicts) != 2 || len(status.Conflicts[0].Txids) != 2 {
+		t.Errorf("unexpected conflicts %+v", status.Conflicts)
+	}
+}
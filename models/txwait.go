@@ -0,0 +1,94 @@
+package models
+
+import (
+	"context"
+	"time"
+
+	lpb "github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
+	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+	"github.com/xuperchain/xupercore/kernel/engines/xuperos/xpb"
+)
+
+const (
+	DefWaitTxTimeout = 30 * time.Second
+	MaxWaitTxTimeout = 300 * time.Second
+	// 查询交易状态的间隔
+	waitTxInterval = 500 * time.Millisecond
+)
+
+// 等待结果，与pb定义保持一致
+const (
+	WaitTxConfirmed = 1
+	WaitTxDropped   = 2
+	WaitTxTimeout   = 3
+)
+
+// 交易等待结果
+type TxWaitResult struct {
+	Result        int32
+	Status        lpb.TransactionStatus
+	Confirmations int64
+	Blockid       []byte
+}
+
+// 等待交易进入主干区块且之上有confirmations个区块，交易被丢弃或超时时返回
+func (t *ChainHandle) WaitTx(ctx context.Context, txid []byte, confirmations int64,
+	timeout time.Duration) (*TxWaitResult, error) {
+	return waitTx(ctx, func() (*xpb.TxInfo, error) {
+		return t.QueryTx(txid)
+	}, confirmations, timeout, waitTxInterval)
+}
+
+func waitTx(ctx context.Context, queryTx func() (*xpb.TxInfo, error), confirmations int64,
+	timeout, interval time.Duration) (*TxWaitResult, error) {
+	if confirmations < 0 || timeout < 0 {
+		return nil, ecom.ErrParameter
+	}
+	if timeout == 0 {
+		timeout = DefWaitTxTimeout
+	}
+	if timeout > MaxWaitTxTimeout {
+		timeout = MaxWaitTxTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	res := &TxWaitResult{Status: lpb.TransactionStatus_TX_UNDEFINE}
+	notExist := 0
+	for {
+		txInfo, err := queryTx()
+		switch {
+		case err == ecom.ErrTxNotExist:
+			// 交易从交易池移入区块的瞬间可能两边都查不到，连续两次查不到才认为被丢弃
+			res.Status = lpb.TransactionStatus_TX_NOEXIST
+			notExist++
+			if notExist >= 2 {
+				res.Result = WaitTxDropped
+				return res, nil
+			}
+		case err != nil:
+			return nil, err
+		default:
+			notExist = 0
+			res.Status = txInfo.GetStatus()
+			res.Confirmations = 0
+			res.Blockid = txInfo.GetTx().GetBlockid()
+			if txInfo.GetStatus() == lpb.TransactionStatus_TX_CONFIRM {
+				res.Confirmations = txInfo.GetDistance()
+				if res.Confirmations >= confirmations {
+					res.Result = WaitTxConfirmed
+					return res, nil
+				}
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			res.Result = WaitTxTimeout
+			return res, nil
+		case <-ticker.C:
+		}
+	}
+}
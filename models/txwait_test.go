@@ -0,0 +1,54 @@
+package models
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	lpb "github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
+	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+	"github.com/xuperchain/xupercore/kernel/engines/xuperos/xpb"
+)
+
+func TestWaitTx(t *testing.T) {
+	// 依次返回未确认、上链、确认数增加
+	var calls int64
+	queryTx := func() (*xpb.TxInfo, error) {
+		calls++
+		if calls == 1 {
+			return &xpb.TxInfo{Status: lpb.TransactionStatus_TX_UNCONFIRM, Tx: &lpb.Transaction{}}, nil
+		}
+		return &xpb.TxInfo{Status: lpb.TransactionStatus_TX_CONFIRM, Distance: calls - 2,
+			Tx: &lpb.Transaction{Blockid: []byte("block")}}, nil
+	}
+	res, err := waitTx(context.Background(), queryTx, 2, time.Second, time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Result != WaitTxConfirmed || res.Confirmations != 2 || string(res.Blockid) != "block" {
+		t.Errorf("unexpected result %+v", res)
+	}
+
+	// 连续两次查不到认为被丢弃
+	calls = 0
+	queryTx = func() (*xpb.TxInfo, error) {
+		calls++
+		return nil, ecom.ErrTxNotExist
+	}
+	res, _ = waitTx(context.Background(), queryTx, 0, time.Second, time.Millisecond)
+	if res.Result != WaitTxDropped || calls != 2 {
+		t.Errorf("unexpected result %+v, calls %d", res, calls)
+	}
+
+	queryTx = func() (*xpb.TxInfo, error) {
+		return &xpb.TxInfo{Status: lpb.TransactionStatus_TX_UNCONFIRM}, nil
+	}
+	res, _ = waitTx(context.Background(), queryTx, 0, 10*time.Millisecond, time.Millisecond)
+	if res.Result != WaitTxTimeout || res.Status != lpb.TransactionStatus_TX_UNCONFIRM {
+		t.Errorf("unexpected result %+v", res)
+	}
+
+	if _, err := waitTx(context.Background(), queryTx, -1, 0, time.Millisecond); err != ecom.ErrParameter {
+		t.Errorf("expect param error, actual %v", err)
+	}
+}
@@ -2,92 +2,213 @@ package common
 
 import (
 	"fmt"
+	"unicode/utf8"
 
-	"github.com/golang/protobuf/proto"
 	"github.com/xuperchain/xupercore/protos"
 
 	"github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
 	"github.com/xuperchain/xuperos/common/xupospb/pb"
 )
 
-// 为了完全兼容老版本pb结构，转换交易结构
-func TxToXledger(tx *pb.Transaction) *xldgpb.Transaction {
-	if tx == nil {
-		return nil
+// 新老版本pb结构字段编号和类型完全一致，逐字段转换，避免序列化再反序列化带来的额外分配
+// 转换结果与反序列化结果保持一致(空的bytes、列表和map为nil，列表中的nil元素为空结构)，
+// 因为txid等计算依赖json序列化，nil和空值的结果不同
+// 注意：转换结果和源对象共享字节切片、字符串切片和map，调用方不能修改
+
+// 记录转换过程中的第一个错误，字段转换函数不需要逐个返回错误
+type converter struct {
+	err error
+}
+
+// proto3的string字段必须是合法的utf-8，与序列化时的校验保持一致
+func (c *converter) str(field, s string) string {
+	if c.err == nil && !utf8.ValidString(s) {
+		c.err = fmt.Errorf("string field %s contains invalid utf-8", field)
 	}
+	return s
+}
 
-	prtBuf, err := proto.Marshal(tx)
-	if err != nil {
+func (c *converter) strs(field string, ss []string) []string {
+	if len(ss) == 0 {
 		return nil
 	}
+	for _, s := range ss {
+		c.str(field, s)
+	}
+	return ss
+}
 
-	var newTx xldgpb.Transaction
-	err = proto.Unmarshal(prtBuf, &newTx)
-	if err != nil {
+func (c *converter) strMap(field string, m map[string]string) map[string]string {
+	if len(m) == 0 {
 		return nil
 	}
-
-	return &newTx
+	for k, v := range m {
+		c.str(field, k)
+		c.str(field, v)
+	}
+	return m
 }
 
-// 为了完全兼容老版本pb结构，转换交易结构
-func TxToXchain(tx *xldgpb.Transaction) *pb.Transaction {
-	if tx == nil {
+// map中值为nil的bytes反序列化后为空切片
+func (c *converter) bytesMap(field string, m map[string][]byte) map[string][]byte {
+	if len(m) == 0 {
 		return nil
 	}
+	hasNil := false
+	for k, v := range m {
+		c.str(field, k)
+		hasNil = hasNil || v == nil
+	}
+	if !hasNil {
+		return m
+	}
+
+	newMap := make(map[string][]byte, len(m))
+	for k, v := range m {
+		if v == nil {
+			v = []byte{}
+		}
+		newMap[k] = v
+	}
+	return newMap
+}
 
-	prtBuf, err := proto.Marshal(tx)
-	if err != nil {
+func bytesField(b []byte) []byte {
+	if len(b) == 0 {
 		return nil
 	}
+	return b
+}
 
-	var newTx pb.Transaction
-	err = proto.Unmarshal(prtBuf, &newTx)
-	if err != nil {
+// 列表中值为nil的bytes反序列化后为空切片
+func bytesList(bs [][]byte) [][]byte {
+	if len(bs) == 0 {
 		return nil
 	}
+	hasNil := false
+	for _, b := range bs {
+		hasNil = hasNil || b == nil
+	}
+	if !hasNil {
+		return bs
+	}
 
-	return &newTx
+	newList := make([][]byte, 0, len(bs))
+	for _, b := range bs {
+		if b == nil {
+			b = []byte{}
+		}
+		newList = append(newList, b)
+	}
+	return newList
 }
 
-// 为了完全兼容老版本pb结构，转换区块结构
-func BlockToXledger(block *pb.InternalBlock) *xldgpb.InternalBlock {
-	if block == nil {
-		return nil
+// 为了完全兼容老版本pb结构，转换交易结构
+func TxToXledger(tx *pb.Transaction) (*xldgpb.Transaction, error) {
+	if tx == nil {
+		return nil, nil
 	}
 
-	blkBuf, err := proto.Marshal(block)
-	if err != nil {
-		return nil
+	c := &converter{}
+	newTx := c.txToXledger(tx)
+	if c.err != nil {
+		return nil, c.err
 	}
+	return newTx, nil
+}
 
-	var newBlock xldgpb.InternalBlock
-	err = proto.Unmarshal(blkBuf, &newBlock)
-	if err != nil {
-		return nil
+// 为了完全兼容老版本pb结构，转换交易结构
+func TxToXchain(tx *xldgpb.Transaction) (*pb.Transaction, error) {
+	if tx == nil {
+		return nil, nil
 	}
 
-	return &newBlock
+	c := &converter{}
+	newTx := c.txToXchain(tx)
+	if c.err != nil {
+		return nil, c.err
+	}
+	return newTx, nil
 }
 
 // 为了完全兼容老版本pb结构，转换区块结构
-func BlockToXchain(block *xldgpb.InternalBlock) *pb.InternalBlock {
+func BlockToXledger(block *pb.InternalBlock) (*xldgpb.InternalBlock, error) {
 	if block == nil {
-		return nil
+		return nil, nil
 	}
 
-	blkBuf, err := proto.Marshal(block)
-	if err != nil {
-		return nil
+	c := &converter{}
+	newBlock := &xldgpb.InternalBlock{
+		Version:     block.GetVersion(),
+		Nonce:       block.GetNonce(),
+		Blockid:     bytesField(block.GetBlockid()),
+		PreHash:     bytesField(block.GetPreHash()),
+		Proposer:    bytesField(block.GetProposer()),
+		Sign:        bytesField(block.GetSign()),
+		Pubkey:      bytesField(block.GetPubkey()),
+		MerkleRoot:  bytesField(block.GetMerkleRoot()),
+		Height:      block.GetHeight(),
+		Timestamp:   block.GetTimestamp(),
+		TxCount:     block.GetTxCount(),
+		MerkleTree:  bytesList(block.GetMerkleTree()),
+		CurTerm:     block.GetCurTerm(),
+		CurBlockNum: block.GetCurBlockNum(),
+		FailedTxs:   c.strMap("failed_txs", block.GetFailedTxs()),
+		TargetBits:  block.GetTargetBits(),
+		Justify:     c.quorumCertToXledger(block.GetJustify()),
+		InTrunk:     block.GetInTrunk(),
+		NextHash:    bytesField(block.GetNextHash()),
+	}
+	if len(block.GetTransactions()) > 0 {
+		newBlock.Transactions = make([]*xldgpb.Transaction, 0, len(block.GetTransactions()))
+		for _, tx := range block.GetTransactions() {
+			newBlock.Transactions = append(newBlock.Transactions, c.txToXledger(tx))
+		}
 	}
+	if c.err != nil {
+		return nil, c.err
+	}
+	return newBlock, nil
+}
 
-	var newBlock pb.InternalBlock
-	err = proto.Unmarshal(blkBuf, &newBlock)
-	if err != nil {
-		return nil
+// 为了完全兼容老版本pb结构，转换区块结构
+func BlockToXchain(block *xldgpb.InternalBlock) (*pb.InternalBlock, error) {
+	if block == nil {
+		return nil, nil
 	}
 
-	return &newBlock
+	c := &converter{}
+	newBlock := &pb.InternalBlock{
+		Version:     block.GetVersion(),
+		Nonce:       block.GetNonce(),
+		Blockid:     bytesField(block.GetBlockid()),
+		PreHash:     bytesField(block.GetPreHash()),
+		Proposer:    bytesField(block.GetProposer()),
+		Sign:        bytesField(block.GetSign()),
+		Pubkey:      bytesField(block.GetPubkey()),
+		MerkleRoot:  bytesField(block.GetMerkleRoot()),
+		Height:      block.GetHeight(),
+		Timestamp:   block.GetTimestamp(),
+		TxCount:     block.GetTxCount(),
+		MerkleTree:  bytesList(block.GetMerkleTree()),
+		CurTerm:     block.GetCurTerm(),
+		CurBlockNum: block.GetCurBlockNum(),
+		FailedTxs:   c.strMap("failed_txs", block.GetFailedTxs()),
+		TargetBits:  block.GetTargetBits(),
+		Justify:     c.quorumCertToXchain(block.GetJustify()),
+		InTrunk:     block.GetInTrunk(),
+		NextHash:    bytesField(block.GetNextHash()),
+	}
+	if len(block.GetTransactions()) > 0 {
+		newBlock.Transactions = make([]*pb.Transaction, 0, len(block.GetTransactions()))
+		for _, tx := range block.GetTransactions() {
+			newBlock.Transactions = append(newBlock.Transactions, c.txToXchain(tx))
+		}
+	}
+	if c.err != nil {
+		return nil, c.err
+	}
+	return newBlock, nil
 }
 
 func ConvertInvokeReq(reqs []*pb.InvokeRequest) ([]*protos.InvokeRequest, error) {
@@ -95,80 +216,74 @@ func ConvertInvokeReq(reqs []*pb.InvokeRequest) ([]*protos.InvokeRequest, error)
 		return nil, nil
 	}
 
+	c := &converter{}
 	newReqs := make([]*protos.InvokeRequest, 0, len(reqs))
 	for _, req := range reqs {
-		buf, err := proto.Marshal(req)
-		if err != nil {
-			return nil, err
-		}
-
-		var tmp protos.InvokeRequest
-		err = proto.Unmarshal(buf, &tmp)
-		if err != nil {
-			return nil, err
-		}
-
-		newReqs = append(newReqs, &tmp)
+		newReqs = append(newReqs, c.invokeReqToProtos(req))
+	}
+	if c.err != nil {
+		return nil, c.err
 	}
-
 	return newReqs, nil
 }
 
-func ConvertInvokeResp(resp *protos.InvokeResponse) *pb.InvokeResponse {
+func ConvertInvokeResp(resp *protos.InvokeResponse) (*pb.InvokeResponse, error) {
 	if resp == nil {
-		return nil
+		return nil, nil
 	}
 
-	buf, err := proto.Marshal(resp)
-	if err != nil {
-		return nil
+	c := &converter{}
+	newResp := &pb.InvokeResponse{
+		Inputs:      c.txInputsExtToXchain(resp.GetInputs()),
+		Outputs:     c.txOutputsExtToXchain(resp.GetOutputs()),
+		Response:    bytesList(resp.GetResponse()),
+		GasUsed:     resp.GetGasUsed(),
+		Requests:    c.invokeReqsToXchain(resp.GetRequests()),
+		UtxoInputs:  txInputsToXchain(resp.GetUtxoInputs()),
+		UtxoOutputs: txOutputsToXchain(resp.GetUtxoOutputs()),
+	}
+	if len(resp.GetResponses()) > 0 {
+		newResp.Responses = make([]*pb.ContractResponse, 0, len(resp.GetResponses()))
+		for _, res := range resp.GetResponses() {
+			newResp.Responses = append(newResp.Responses, &pb.ContractResponse{
+				Status:  res.GetStatus(),
+				Message: c.str("message", res.GetMessage()),
+				Body:    bytesField(res.GetBody()),
+			})
+		}
 	}
-
-	var tmp pb.InvokeResponse
-	err = proto.Unmarshal(buf, &tmp)
-	if err != nil {
-		return nil
+	if c.err != nil {
+		return nil, c.err
 	}
-
-	return &tmp
+	return newResp, nil
 }
 
-func UtxoToXchain(utxo *xldgpb.Utxo) *pb.Utxo {
+func UtxoToXchain(utxo *xldgpb.Utxo) (*pb.Utxo, error) {
 	if utxo == nil {
-		return nil
-	}
-
-	buf, err := proto.Marshal(utxo)
-	if err != nil {
-		return nil
-	}
-
-	var tmp pb.Utxo
-	err = proto.Unmarshal(buf, &tmp)
-	if err != nil {
-		return nil
+		return nil, nil
 	}
 
-	return &tmp
+	return &pb.Utxo{
+		Amount:    bytesField(utxo.GetAmount()),
+		ToAddr:    bytesField(utxo.GetToAddr()),
+		ToPubkey:  bytesField(utxo.GetToPubkey()),
+		RefTxid:   bytesField(utxo.GetRefTxid()),
+		RefOffset: utxo.GetRefOffset(),
+	}, nil
 }
 
-func UtxoToXledger(utxo *pb.Utxo) *xldgpb.Utxo {
+func UtxoToXledger(utxo *pb.Utxo) (*xldgpb.Utxo, error) {
 	if utxo == nil {
-		return nil
-	}
-
-	buf, err := proto.Marshal(utxo)
-	if err != nil {
-		return nil
-	}
-
-	var tmp xldgpb.Utxo
-	err = proto.Unmarshal(buf, &tmp)
-	if err != nil {
-		return nil
+		return nil, nil
 	}
 
-	return &tmp
+	return &xldgpb.Utxo{
+		Amount:    bytesField(utxo.GetAmount()),
+		ToAddr:    bytesField(utxo.GetToAddr()),
+		ToPubkey:  bytesField(utxo.GetToPubkey()),
+		RefTxid:   bytesField(utxo.GetRefTxid()),
+		RefOffset: utxo.GetRefOffset(),
+	}, nil
 }
 
 func UtxoListToXchain(utxoList []*xldgpb.Utxo) ([]*pb.Utxo, error) {
@@ -178,9 +293,12 @@ func UtxoListToXchain(utxoList []*xldgpb.Utxo) ([]*pb.Utxo, error) {
 
 	tmpList := make([]*pb.Utxo, 0, len(utxoList))
 	for _, utxo := range utxoList {
-		tmp := UtxoToXchain(utxo)
-		if tmp == nil {
-			return nil, fmt.Errorf("convert utxo failed")
+		if utxo == nil {
+			utxo = &xldgpb.Utxo{}
+		}
+		tmp, err := UtxoToXchain(utxo)
+		if err != nil {
+			return nil, fmt.Errorf("convert utxo failed: %v", err)
 		}
 		tmpList = append(tmpList, tmp)
 	}
@@ -214,42 +332,62 @@ func UtxoRecordToXchain(record *xldgpb.UtxoRecord) *pb.UtxoRecord {
 	return newRecord
 }
 
-func AclToXchain(acl *protos.Acl) *pb.Acl {
+func AclToXchain(acl *protos.Acl) (*pb.Acl, error) {
 	if acl == nil {
-		return nil
+		return nil, nil
 	}
 
-	buf, err := proto.Marshal(acl)
-	if err != nil {
-		return nil
+	c := &converter{}
+	newAcl := &pb.Acl{}
+	if pm := acl.GetPm(); pm != nil {
+		newAcl.Pm = &pb.PermissionModel{
+			Rule:        pb.PermissionRule(pm.GetRule()),
+			AcceptValue: pm.GetAcceptValue(),
+		}
 	}
-
-	var tmp pb.Acl
-	err = proto.Unmarshal(buf, &tmp)
-	if err != nil {
-		return nil
+	if len(acl.GetAksWeight()) > 0 {
+		newAcl.AksWeight = acl.GetAksWeight()
+		for ak := range acl.GetAksWeight() {
+			c.str("aksWeight", ak)
+		}
 	}
-
-	return &tmp
+	if akSets := acl.GetAkSets(); akSets != nil {
+		newAcl.AkSets = &pb.AkSets{
+			Expression: c.str("expression", akSets.GetExpression()),
+		}
+		if len(akSets.GetSets()) > 0 {
+			newAcl.AkSets.Sets = make(map[string]*pb.AkSet, len(akSets.GetSets()))
+			for name, set := range akSets.GetSets() {
+				newAcl.AkSets.Sets[c.str("sets", name)] = &pb.AkSet{
+					Aks: c.strs("aks", set.GetAks()),
+				}
+			}
+		}
+	}
+	if c.err != nil {
+		return nil, c.err
+	}
+	return newAcl, nil
 }
 
-func ContractStatusToXchain(contractStatus *protos.ContractStatus) *pb.ContractStatus {
+func ContractStatusToXchain(contractStatus *protos.ContractStatus) (*pb.ContractStatus, error) {
 	if contractStatus == nil {
-		return nil
+		return nil, nil
 	}
 
-	buf, err := proto.Marshal(contractStatus)
-	if err != nil {
-		return nil
+	c := &converter{}
+	tmp := &pb.ContractStatus{
+		ContractName: c.str("contract_name", contractStatus.GetContractName()),
+		Txid:         c.str("txid", contractStatus.GetTxid()),
+		Desc:         bytesField(contractStatus.GetDesc()),
+		IsBanned:     contractStatus.GetIsBanned(),
+		Timestamp:    contractStatus.GetTimestamp(),
+		Runtime:      c.str("runtime", contractStatus.GetRuntime()),
 	}
-
-	var tmp pb.ContractStatus
-	err = proto.Unmarshal(buf, &tmp)
-	if err != nil {
-		return nil
+	if c.err != nil {
+		return nil, c.err
 	}
-
-	return &tmp
+	return tmp, nil
 }
 
 func ContractStatusListToXchain(contractStatusList []*protos.ContractStatus) ([]*pb.ContractStatus, error) {
@@ -259,9 +397,12 @@ func ContractStatusListToXchain(contractStatusList []*protos.ContractStatus) ([]
 
 	tmpList := make([]*pb.ContractStatus, 0, len(contractStatusList))
 	for _, cs := range contractStatusList {
-		tmp := ContractStatusToXchain(cs)
-		if tmp == nil {
-			return nil, fmt.Errorf("convert contract status failed")
+		if cs == nil {
+			cs = &protos.ContractStatus{}
+		}
+		tmp, err := ContractStatusToXchain(cs)
+		if err != nil {
+			return nil, fmt.Errorf("convert contract status failed: %v", err)
 		}
 		tmpList = append(tmpList, tmp)
 	}
@@ -277,23 +418,20 @@ func PeerInfoToStrings(info protos.PeerInfo) []string {
 	return peerUrls
 }
 
-func BalanceDetailToXchain(detail *xldgpb.BalanceDetailInfo) *pb.TokenFrozenDetail {
+func BalanceDetailToXchain(detail *xldgpb.BalanceDetailInfo) (*pb.TokenFrozenDetail, error) {
 	if detail == nil {
-		return nil
+		return nil, nil
 	}
 
-	buf, err := proto.Marshal(detail)
-	if err != nil {
-		return nil
+	c := &converter{}
+	tmp := &pb.TokenFrozenDetail{
+		Balance:  c.str("balance", detail.GetBalance()),
+		IsFrozen: detail.GetIsFrozen(),
 	}
-
-	var tmp pb.TokenFrozenDetail
-	err = proto.Unmarshal(buf, &tmp)
-	if err != nil {
-		return nil
+	if c.err != nil {
+		return nil, c.err
 	}
-
-	return &tmp
+	return tmp, nil
 }
 
 func BalanceDetailsToXchain(details []*xldgpb.BalanceDetailInfo) ([]*pb.TokenFrozenDetail, error) {
@@ -303,9 +441,12 @@ func BalanceDetailsToXchain(details []*xldgpb.BalanceDetailInfo) ([]*pb.TokenFro
 
 	tmpList := make([]*pb.TokenFrozenDetail, 0, len(details))
 	for _, detail := range details {
-		tmp := BalanceDetailToXchain(detail)
-		if tmp == nil {
-			return nil, fmt.Errorf("convert balance detail failed")
+		if detail == nil {
+			detail = &xldgpb.BalanceDetailInfo{}
+		}
+		tmp, err := BalanceDetailToXchain(detail)
+		if err != nil {
+			return nil, fmt.Errorf("convert balance detail failed: %v", err)
 		}
 		tmpList = append(tmpList, tmp)
 	}
@@ -313,40 +454,386 @@ func BalanceDetailsToXchain(details []*xldgpb.BalanceDetailInfo) ([]*pb.TokenFro
 	return tmpList, nil
 }
 
-func LedgerMetaToXchain(meta *xldgpb.LedgerMeta) *pb.LedgerMeta {
+func LedgerMetaToXchain(meta *xldgpb.LedgerMeta) (*pb.LedgerMeta, error) {
 	if meta == nil {
+		return nil, nil
+	}
+
+	return &pb.LedgerMeta{
+		RootBlockid: bytesField(meta.GetRootBlockid()),
+		TipBlockid:  bytesField(meta.GetTipBlockid()),
+		TrunkHeight: meta.GetTrunkHeight(),
+	}, nil
+}
+
+func UtxoMetaToXchain(meta *xldgpb.UtxoMeta) (*pb.UtxoMeta, error) {
+	if meta == nil {
+		return nil, nil
+	}
+
+	c := &converter{}
+	tmp := &pb.UtxoMeta{
+		LatestBlockid:            bytesField(meta.GetLatestBlockid()),
+		LockKeyList:              c.strs("lock_key_list", meta.GetLockKeyList()),
+		UtxoTotal:                c.str("utxo_total", meta.GetUtxoTotal()),
+		AvgDelay:                 meta.GetAvgDelay(),
+		UnconfirmTxAmount:        meta.GetUnconfirmTxAmount(),
+		MaxBlockSize:             meta.GetMaxBlockSize(),
+		ReservedContracts:        c.invokeReqsToXchain(meta.GetReservedContracts()),
+		NewAccountResourceAmount: meta.GetNewAccountResourceAmount(),
+		IrreversibleBlockHeight:  meta.GetIrreversibleBlockHeight(),
+		IrreversibleSlideWindow:  meta.GetIrreversibleSlideWindow(),
+	}
+	if meta.GetForbiddenContract() != nil {
+		tmp.ForbiddenContract = c.invokeReqToXchain(meta.GetForbiddenContract())
+	}
+	if meta.GetGroupChainContract() != nil {
+		tmp.GroupChainContract = c.invokeReqToXchain(meta.GetGroupChainContract())
+	}
+	if gasPrice := meta.GetGasPrice(); gasPrice != nil {
+		tmp.GasPrice = &pb.GasPrice{
+			CpuRate:  gasPrice.GetCpuRate(),
+			MemRate:  gasPrice.GetMemRate(),
+			DiskRate: gasPrice.GetDiskRate(),
+			XfeeRate: gasPrice.GetXfeeRate(),
+		}
+	}
+	if c.err != nil {
+		return nil, c.err
+	}
+	return tmp, nil
+}
+
+// 以下转换函数入参为nil时返回空结构，与列表中nil元素的反序列化结果一致，
+// 可选的消息字段需要调用方判断nil
+
+func (c *converter) txToXledger(tx *pb.Transaction) *xldgpb.Transaction {
+	newTx := &xldgpb.Transaction{
+		Txid:              bytesField(tx.GetTxid()),
+		Blockid:           bytesField(tx.GetBlockid()),
+		Desc:              bytesField(tx.GetDesc()),
+		Coinbase:          tx.GetCoinbase(),
+		Nonce:             c.str("nonce", tx.GetNonce()),
+		Timestamp:         tx.GetTimestamp(),
+		Version:           tx.GetVersion(),
+		Autogen:           tx.GetAutogen(),
+		Initiator:         c.str("initiator", tx.GetInitiator()),
+		AuthRequire:       c.strs("auth_require", tx.GetAuthRequire()),
+		InitiatorSigns:    c.signsToProtos(tx.GetInitiatorSigns()),
+		AuthRequireSigns:  c.signsToProtos(tx.GetAuthRequireSigns()),
+		ReceivedTimestamp: tx.GetReceivedTimestamp(),
+	}
+	if len(tx.GetTxInputs()) > 0 {
+		newTx.TxInputs = make([]*protos.TxInput, 0, len(tx.GetTxInputs()))
+		for _, input := range tx.GetTxInputs() {
+			newTx.TxInputs = append(newTx.TxInputs, &protos.TxInput{
+				RefTxid:      bytesField(input.GetRefTxid()),
+				RefOffset:    input.GetRefOffset(),
+				FromAddr:     bytesField(input.GetFromAddr()),
+				Amount:       bytesField(input.GetAmount()),
+				FrozenHeight: input.GetFrozenHeight(),
+			})
+		}
+	}
+	if len(tx.GetTxOutputs()) > 0 {
+		newTx.TxOutputs = make([]*protos.TxOutput, 0, len(tx.GetTxOutputs()))
+		for _, output := range tx.GetTxOutputs() {
+			newTx.TxOutputs = append(newTx.TxOutputs, &protos.TxOutput{
+				Amount:       bytesField(output.GetAmount()),
+				ToAddr:       bytesField(output.GetToAddr()),
+				FrozenHeight: output.GetFrozenHeight(),
+			})
+		}
+	}
+	if len(tx.GetTxInputsExt()) > 0 {
+		newTx.TxInputsExt = make([]*protos.TxInputExt, 0, len(tx.GetTxInputsExt()))
+		for _, input := range tx.GetTxInputsExt() {
+			newTx.TxInputsExt = append(newTx.TxInputsExt, &protos.TxInputExt{
+				Bucket:    c.str("bucket", input.GetBucket()),
+				Key:       bytesField(input.GetKey()),
+				RefTxid:   bytesField(input.GetRefTxid()),
+				RefOffset: input.GetRefOffset(),
+			})
+		}
+	}
+	if len(tx.GetTxOutputsExt()) > 0 {
+		newTx.TxOutputsExt = make([]*protos.TxOutputExt, 0, len(tx.GetTxOutputsExt()))
+		for _, output := range tx.GetTxOutputsExt() {
+			newTx.TxOutputsExt = append(newTx.TxOutputsExt, &protos.TxOutputExt{
+				Bucket: c.str("bucket", output.GetBucket()),
+				Key:    bytesField(output.GetKey()),
+				Value:  bytesField(output.GetValue()),
+			})
+		}
+	}
+	if len(tx.GetContractRequests()) > 0 {
+		newTx.ContractRequests = make([]*protos.InvokeRequest, 0, len(tx.GetContractRequests()))
+		for _, req := range tx.GetContractRequests() {
+			newTx.ContractRequests = append(newTx.ContractRequests, c.invokeReqToProtos(req))
+		}
+	}
+	if sign := tx.GetXuperSign(); sign != nil {
+		newTx.XuperSign = &xldgpb.XuperSignature{
+			PublicKeys: bytesList(sign.GetPublicKeys()),
+			Signature:  bytesField(sign.GetSignature()),
+		}
+	}
+	if mb := tx.GetModifyBlock(); mb != nil {
+		newTx.ModifyBlock = &xldgpb.ModifyBlock{
+			EffectiveTxid:   c.str("effective_txid", mb.GetEffectiveTxid()),
+			Marked:          mb.GetMarked(),
+			EffectiveHeight: mb.GetEffectiveHeight(),
+			PublicKey:       c.str("public_key", mb.GetPublicKey()),
+			Sign:            c.str("sign", mb.GetSign()),
+		}
+	}
+	if hd := tx.GetHDInfo(); hd != nil {
+		newTx.HDInfo = &xldgpb.HDInfo{
+			HdPublicKey:  bytesField(hd.GetHdPublicKey()),
+			OriginalHash: bytesField(hd.GetOriginalHash()),
+		}
+	}
+	return newTx
+}
+
+func (c *converter) txToXchain(tx *xldgpb.Transaction) *pb.Transaction {
+	newTx := &pb.Transaction{
+		Txid:              bytesField(tx.GetTxid()),
+		Blockid:           bytesField(tx.GetBlockid()),
+		TxInputs:          txInputsToXchain(tx.GetTxInputs()),
+		TxOutputs:         txOutputsToXchain(tx.GetTxOutputs()),
+		Desc:              bytesField(tx.GetDesc()),
+		Coinbase:          tx.GetCoinbase(),
+		Nonce:             c.str("nonce", tx.GetNonce()),
+		Timestamp:         tx.GetTimestamp(),
+		Version:           tx.GetVersion(),
+		Autogen:           tx.GetAutogen(),
+		TxInputsExt:       c.txInputsExtToXchain(tx.GetTxInputsExt()),
+		TxOutputsExt:      c.txOutputsExtToXchain(tx.GetTxOutputsExt()),
+		ContractRequests:  c.invokeReqsToXchain(tx.GetContractRequests()),
+		Initiator:         c.str("initiator", tx.GetInitiator()),
+		AuthRequire:       c.strs("auth_require", tx.GetAuthRequire()),
+		InitiatorSigns:    c.signsToXchain(tx.GetInitiatorSigns()),
+		AuthRequireSigns:  c.signsToXchain(tx.GetAuthRequireSigns()),
+		ReceivedTimestamp: tx.GetReceivedTimestamp(),
+	}
+	if sign := tx.GetXuperSign(); sign != nil {
+		newTx.XuperSign = &pb.XuperSignature{
+			PublicKeys: bytesList(sign.GetPublicKeys()),
+			Signature:  bytesField(sign.GetSignature()),
+		}
+	}
+	if mb := tx.GetModifyBlock(); mb != nil {
+		newTx.ModifyBlock = &pb.ModifyBlock{
+			EffectiveTxid:   c.str("effective_txid", mb.GetEffectiveTxid()),
+			Marked:          mb.GetMarked(),
+			EffectiveHeight: mb.GetEffectiveHeight(),
+			PublicKey:       c.str("public_key", mb.GetPublicKey()),
+			Sign:            c.str("sign", mb.GetSign()),
+		}
+	}
+	if hd := tx.GetHDInfo(); hd != nil {
+		newTx.HDInfo = &pb.HDInfo{
+			HdPublicKey:  bytesField(hd.GetHdPublicKey()),
+			OriginalHash: bytesField(hd.GetOriginalHash()),
+		}
+	}
+	return newTx
+}
+
+func txInputsToXchain(inputs []*protos.TxInput) []*pb.TxInput {
+	if len(inputs) == 0 {
 		return nil
 	}
 
-	buf, err := proto.Marshal(meta)
-	if err != nil {
+	newInputs := make([]*pb.TxInput, 0, len(inputs))
+	for _, input := range inputs {
+		newInputs = append(newInputs, &pb.TxInput{
+			RefTxid:      bytesField(input.GetRefTxid()),
+			RefOffset:    input.GetRefOffset(),
+			FromAddr:     bytesField(input.GetFromAddr()),
+			Amount:       bytesField(input.GetAmount()),
+			FrozenHeight: input.GetFrozenHeight(),
+		})
+	}
+	return newInputs
+}
+
+func txOutputsToXchain(outputs []*protos.TxOutput) []*pb.TxOutput {
+	if len(outputs) == 0 {
 		return nil
 	}
 
-	var tmp pb.LedgerMeta
-	err = proto.Unmarshal(buf, &tmp)
-	if err != nil {
+	newOutputs := make([]*pb.TxOutput, 0, len(outputs))
+	for _, output := range outputs {
+		newOutputs = append(newOutputs, &pb.TxOutput{
+			Amount:       bytesField(output.GetAmount()),
+			ToAddr:       bytesField(output.GetToAddr()),
+			FrozenHeight: output.GetFrozenHeight(),
+		})
+	}
+	return newOutputs
+}
+
+func (c *converter) txInputsExtToXchain(inputs []*protos.TxInputExt) []*pb.TxInputExt {
+	if len(inputs) == 0 {
 		return nil
 	}
 
-	return &tmp
+	newInputs := make([]*pb.TxInputExt, 0, len(inputs))
+	for _, input := range inputs {
+		newInputs = append(newInputs, &pb.TxInputExt{
+			Bucket:    c.str("bucket", input.GetBucket()),
+			Key:       bytesField(input.GetKey()),
+			RefTxid:   bytesField(input.GetRefTxid()),
+			RefOffset: input.GetRefOffset(),
+		})
+	}
+	return newInputs
 }
 
-func UtxoMetaToXchain(meta *xldgpb.UtxoMeta) *pb.UtxoMeta {
-	if meta == nil {
+func (c *converter) txOutputsExtToXchain(outputs []*protos.TxOutputExt) []*pb.TxOutputExt {
+	if len(outputs) == 0 {
 		return nil
 	}
 
-	buf, err := proto.Marshal(meta)
-	if err != nil {
+	newOutputs := make([]*pb.TxOutputExt, 0, len(outputs))
+	for _, output := range outputs {
+		newOutputs = append(newOutputs, &pb.TxOutputExt{
+			Bucket: c.str("bucket", output.GetBucket()),
+			Key:    bytesField(output.GetKey()),
+			Value:  bytesField(output.GetValue()),
+		})
+	}
+	return newOutputs
+}
+
+func (c *converter) invokeReqToProtos(req *pb.InvokeRequest) *protos.InvokeRequest {
+	newReq := &protos.InvokeRequest{
+		ModuleName:   c.str("module_name", req.GetModuleName()),
+		ContractName: c.str("contract_name", req.GetContractName()),
+		MethodName:   c.str("method_name", req.GetMethodName()),
+		Args:         c.bytesMap("args", req.GetArgs()),
+		Amount:       c.str("amount", req.GetAmount()),
+	}
+	if len(req.GetResourceLimits()) > 0 {
+		newReq.ResourceLimits = make([]*protos.ResourceLimit, 0, len(req.GetResourceLimits()))
+		for _, limit := range req.GetResourceLimits() {
+			newReq.ResourceLimits = append(newReq.ResourceLimits, &protos.ResourceLimit{
+				Type:  protos.ResourceType(limit.GetType()),
+				Limit: limit.GetLimit(),
+			})
+		}
+	}
+	return newReq
+}
+
+func (c *converter) invokeReqsToXchain(reqs []*protos.InvokeRequest) []*pb.InvokeRequest {
+	if len(reqs) == 0 {
 		return nil
 	}
 
-	var tmp pb.UtxoMeta
-	err = proto.Unmarshal(buf, &tmp)
-	if err != nil {
+	newReqs := make([]*pb.InvokeRequest, 0, len(reqs))
+	for _, req := range reqs {
+		newReqs = append(newReqs, c.invokeReqToXchain(req))
+	}
+	return newReqs
+}
+
+func (c *converter) invokeReqToXchain(req *protos.InvokeRequest) *pb.InvokeRequest {
+	newReq := &pb.InvokeRequest{
+		ModuleName:   c.str("module_name", req.GetModuleName()),
+		ContractName: c.str("contract_name", req.GetContractName()),
+		MethodName:   c.str("method_name", req.GetMethodName()),
+		Args:         c.bytesMap("args", req.GetArgs()),
+		Amount:       c.str("amount", req.GetAmount()),
+	}
+	if len(req.GetResourceLimits()) > 0 {
+		newReq.ResourceLimits = make([]*pb.ResourceLimit, 0, len(req.GetResourceLimits()))
+		for _, limit := range req.GetResourceLimits() {
+			newReq.ResourceLimits = append(newReq.ResourceLimits, &pb.ResourceLimit{
+				Type:  pb.ResourceType(limit.GetType()),
+				Limit: limit.GetLimit(),
+			})
+		}
+	}
+	return newReq
+}
+
+func (c *converter) signsToProtos(signs []*pb.SignatureInfo) []*protos.SignatureInfo {
+	if len(signs) == 0 {
+		return nil
+	}
+
+	newSigns := make([]*protos.SignatureInfo, 0, len(signs))
+	for _, sign := range signs {
+		newSigns = append(newSigns, &protos.SignatureInfo{
+			PublicKey: c.str("PublicKey", sign.GetPublicKey()),
+			Sign:      bytesField(sign.GetSign()),
+		})
+	}
+	return newSigns
+}
+
+func (c *converter) signsToXchain(signs []*protos.SignatureInfo) []*pb.SignatureInfo {
+	if len(signs) == 0 {
+		return nil
+	}
+
+	newSigns := make([]*pb.SignatureInfo, 0, len(signs))
+	for _, sign := range signs {
+		newSigns = append(newSigns, &pb.SignatureInfo{
+			PublicKey: c.str("PublicKey", sign.GetPublicKey()),
+			Sign:      bytesField(sign.GetSign()),
+		})
+	}
+	return newSigns
+}
+
+func (c *converter) quorumCertToXledger(qc *pb.QuorumCert) *xldgpb.QuorumCert {
+	if qc == nil {
+		return nil
+	}
+
+	newQC := &xldgpb.QuorumCert{
+		ProposalId:  bytesField(qc.GetProposalId()),
+		ProposalMsg: bytesField(qc.GetProposalMsg()),
+		Type:        xldgpb.QCState(qc.GetType()),
+		ViewNumber:  qc.GetViewNumber(),
+	}
+	if signInfos := qc.GetSignInfos(); signInfos != nil {
+		newQC.SignInfos = &xldgpb.QCSignInfos{}
+		for _, info := range signInfos.GetQCSignInfos() {
+			newQC.SignInfos.QCSignInfos = append(newQC.SignInfos.QCSignInfos, &xldgpb.SignInfo{
+				Address:   c.str("Address", info.GetAddress()),
+				PublicKey: c.str("PublicKey", info.GetPublicKey()),
+				Sign:      bytesField(info.GetSign()),
+			})
+		}
+	}
+	return newQC
+}
+
+func (c *converter) quorumCertToXchain(qc *xldgpb.QuorumCert) *pb.QuorumCert {
+	if qc == nil {
 		return nil
 	}
 
-	return &tmp
+	newQC := &pb.QuorumCert{
+		ProposalId:  bytesField(qc.GetProposalId()),
+		ProposalMsg: bytesField(qc.GetProposalMsg()),
+		Type:        pb.QCState(qc.GetType()),
+		ViewNumber:  qc.GetViewNumber(),
+	}
+	if signInfos := qc.GetSignInfos(); signInfos != nil {
+		newQC.SignInfos = &pb.QCSignInfos{}
+		for _, info := range signInfos.GetQCSignInfos() {
+			newQC.SignInfos.QCSignInfos = append(newQC.SignInfos.QCSignInfos, &pb.SignInfo{
+				Address:   c.str("Address", info.GetAddress()),
+				PublicKey: c.str("PublicKey", info.GetPublicKey()),
+				Sign:      bytesField(info.GetSign()),
+			})
+		}
+	}
+	return newQC
 }
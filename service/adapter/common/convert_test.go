@@ -0,0 +1,304 @@
+package common
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/state/utxo/txhash"
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
+	"github.com/xuperchain/xupercore/protos"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/xuperchain/xuperos/common/xupospb/pb"
+)
+
+// 原来序列化再反序列化的转换方式，作为对照
+func legacyConvert(src, dst proto.Message) error {
+	buf, err := proto.Marshal(src)
+	if err != nil {
+		return err
+	}
+	return proto.Unmarshal(buf, dst)
+}
+
+// 为消息的所有字段填充非零值，列表和map填充多个元素
+func fillMessage(m protoreflect.Message, seed *int) {
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		switch {
+		case fd.IsList():
+			list := m.Mutable(fd).List()
+			for j := 0; j < 2; j++ {
+				list.Append(fillValue(fd, list.NewElement(), seed))
+			}
+		case fd.IsMap():
+			mp := m.Mutable(fd).Map()
+			for j := 0; j < 2; j++ {
+				key := fillValue(fd.MapKey(), protoreflect.Value{}, seed).MapKey()
+				mp.Set(key, fillValue(fd.MapValue(), mp.NewValue(), seed))
+			}
+		case fd.Message() != nil:
+			fillMessage(m.Mutable(fd).Message(), seed)
+		default:
+			m.Set(fd, fillValue(fd, protoreflect.Value{}, seed))
+		}
+	}
+}
+
+func fillValue(fd protoreflect.FieldDescriptor, msg protoreflect.Value, seed *int) protoreflect.Value {
+	*seed++
+	switch fd.Kind() {
+	case protoreflect.MessageKind:
+		fillMessage(msg.Message(), seed)
+		return msg
+	case protoreflect.BoolKind:
+		return protoreflect.ValueOfBool(true)
+	case protoreflect.EnumKind:
+		return protoreflect.ValueOfEnum(protoreflect.EnumNumber(*seed % 3))
+	case protoreflect.Int32Kind:
+		return protoreflect.ValueOfInt32(int32(*seed))
+	case protoreflect.Int64Kind:
+		return protoreflect.ValueOfInt64(int64(*seed))
+	case protoreflect.DoubleKind:
+		return protoreflect.ValueOfFloat64(float64(*seed) + 0.5)
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(fmt.Sprintf("s%d", *seed))
+	case protoreflect.BytesKind:
+		return protoreflect.ValueOfBytes([]byte{byte(*seed), 0xff})
+	default:
+		panic(fmt.Sprintf("unsupported kind %s", fd.Kind()))
+	}
+}
+
+func filled(m proto.Message) proto.Message {
+	seed := 0
+	fillMessage(proto.MessageV2(m).ProtoReflect(), &seed)
+	return m
+}
+
+// map字段按key排序序列化，结果可比较
+func marshalDeterministic(m proto.Message) ([]byte, error) {
+	buf := proto.NewBuffer(nil)
+	buf.SetDeterministic(true)
+	err := buf.Marshal(m)
+	return buf.Bytes(), err
+}
+
+// 转换结果需要和原来的反序列化结果完全一致，包括nil和空值
+func checkEquivalent(t *testing.T, name string, actual, expect proto.Message) {
+	t.Helper()
+	if !reflect.DeepEqual(actual, expect) {
+		t.Errorf("%s not equivalent\nactual:%v\nexpect:%v", name, actual, expect)
+	}
+	actualBuf, err1 := marshalDeterministic(actual)
+	expectBuf, err2 := marshalDeterministic(expect)
+	if err1 != nil || err2 != nil || !bytes.Equal(actualBuf, expectBuf) {
+		t.Errorf("%s wire not equal, err:%v %v", name, err1, err2)
+	}
+}
+
+func TestConvertEquivalence(t *testing.T) {
+	xblock := filled(&xldgpb.InternalBlock{}).(*xldgpb.InternalBlock)
+	expectBlock := &pb.InternalBlock{}
+	if err := legacyConvert(xblock, expectBlock); err != nil {
+		t.Fatal(err)
+	}
+	block, err := BlockToXchain(xblock)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkEquivalent(t, "BlockToXchain", block, expectBlock)
+
+	pblock := filled(&pb.InternalBlock{}).(*pb.InternalBlock)
+	expectXBlock := &xldgpb.InternalBlock{}
+	legacyConvert(pblock, expectXBlock)
+	newXBlock, err := BlockToXledger(pblock)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkEquivalent(t, "BlockToXledger", newXBlock, expectXBlock)
+
+	resp := filled(&protos.InvokeResponse{}).(*protos.InvokeResponse)
+	expectResp := &pb.InvokeResponse{}
+	legacyConvert(resp, expectResp)
+	newResp, err := ConvertInvokeResp(resp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkEquivalent(t, "ConvertInvokeResp", newResp, expectResp)
+
+	req := filled(&pb.InvokeRequest{}).(*pb.InvokeRequest)
+	expectReq := &protos.InvokeRequest{}
+	legacyConvert(req, expectReq)
+	newReqs, err := ConvertInvokeReq([]*pb.InvokeRequest{req})
+	if err != nil || len(newReqs) != 1 {
+		t.Fatal(err)
+	}
+	checkEquivalent(t, "ConvertInvokeReq", newReqs[0], expectReq)
+
+	utxo := filled(&xldgpb.Utxo{}).(*xldgpb.Utxo)
+	expectUtxo := &pb.Utxo{}
+	legacyConvert(utxo, expectUtxo)
+	newUtxo, _ := UtxoToXchain(utxo)
+	checkEquivalent(t, "UtxoToXchain", newUtxo, expectUtxo)
+
+	putxo := filled(&pb.Utxo{}).(*pb.Utxo)
+	expectXUtxo := &xldgpb.Utxo{}
+	legacyConvert(putxo, expectXUtxo)
+	newXUtxo, _ := UtxoToXledger(putxo)
+	checkEquivalent(t, "UtxoToXledger", newXUtxo, expectXUtxo)
+
+	acl := filled(&protos.Acl{}).(*protos.Acl)
+	expectAcl := &pb.Acl{}
+	legacyConvert(acl, expectAcl)
+	newAcl, err := AclToXchain(acl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkEquivalent(t, "AclToXchain", newAcl, expectAcl)
+
+	cs := filled(&protos.ContractStatus{}).(*protos.ContractStatus)
+	expectCS := &pb.ContractStatus{}
+	legacyConvert(cs, expectCS)
+	newCS, _ := ContractStatusToXchain(cs)
+	checkEquivalent(t, "ContractStatusToXchain", newCS, expectCS)
+
+	detail := filled(&xldgpb.BalanceDetailInfo{}).(*xldgpb.BalanceDetailInfo)
+	expectDetail := &pb.TokenFrozenDetail{}
+	legacyConvert(detail, expectDetail)
+	newDetail, _ := BalanceDetailToXchain(detail)
+	checkEquivalent(t, "BalanceDetailToXchain", newDetail, expectDetail)
+
+	lmeta := filled(&xldgpb.LedgerMeta{}).(*xldgpb.LedgerMeta)
+	expectLMeta := &pb.LedgerMeta{}
+	legacyConvert(lmeta, expectLMeta)
+	newLMeta, _ := LedgerMetaToXchain(lmeta)
+	checkEquivalent(t, "LedgerMetaToXchain", newLMeta, expectLMeta)
+
+	umeta := filled(&xldgpb.UtxoMeta{}).(*xldgpb.UtxoMeta)
+	expectUMeta := &pb.UtxoMeta{}
+	legacyConvert(umeta, expectUMeta)
+	newUMeta, err := UtxoMetaToXchain(umeta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkEquivalent(t, "UtxoMetaToXchain", newUMeta, expectUMeta)
+}
+
+func TestConvertEmptyValues(t *testing.T) {
+	// nil元素、空切片、空map和nil的map值反序列化后的形式与源对象不同
+	tx := &pb.Transaction{
+		Txid:      []byte{},
+		TxInputs:  []*pb.TxInput{nil, {Amount: []byte{}}},
+		TxOutputs: []*pb.TxOutput{},
+		ContractRequests: []*pb.InvokeRequest{{
+			Args:           map[string][]byte{"a": nil, "b": {}, "c": {1}},
+			ResourceLimits: []*pb.ResourceLimit{nil},
+		}},
+		AuthRequire:    []string{},
+		InitiatorSigns: []*pb.SignatureInfo{},
+		XuperSign:      &pb.XuperSignature{PublicKeys: [][]byte{nil, {1}}},
+		ModifyBlock:    &pb.ModifyBlock{},
+		Version:        1,
+	}
+	expect := &xldgpb.Transaction{}
+	legacyConvert(tx, expect)
+	newTx, err := TxToXledger(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkEquivalent(t, "TxToXledger", newTx, expect)
+
+	// txid基于json序列化计算，需要保持一致
+	expectID, _ := txhash.MakeTransactionID(expect)
+	actualID, _ := txhash.MakeTransactionID(newTx)
+	if !bytes.Equal(expectID, actualID) {
+		t.Errorf("txid mismatch %x %x", actualID, expectID)
+	}
+
+	xtx := &xldgpb.Transaction{
+		TxInputs:    []*protos.TxInput{nil},
+		TxInputsExt: []*protos.TxInputExt{},
+		Desc:        []byte{},
+	}
+	expectTx := &pb.Transaction{}
+	legacyConvert(xtx, expectTx)
+	newXTx, _ := TxToXchain(xtx)
+	checkEquivalent(t, "TxToXchain", newXTx, expectTx)
+
+	acl := &protos.Acl{
+		AksWeight: map[string]float64{},
+		AkSets:    &protos.AkSets{Sets: map[string]*protos.AkSet{"a": nil}},
+	}
+	expectAcl := &pb.Acl{}
+	legacyConvert(acl, expectAcl)
+	newAcl, _ := AclToXchain(acl)
+	checkEquivalent(t, "AclToXchain", newAcl, expectAcl)
+
+	for _, f := range []func() (interface{}, error){
+		func() (interface{}, error) { return TxToXchain(nil) },
+		func() (interface{}, error) { return BlockToXchain(nil) },
+		func() (interface{}, error) { return AclToXchain(nil) },
+	} {
+		if res, err := f(); err != nil || !reflect.ValueOf(res).IsNil() {
+			t.Errorf("expect nil result for nil input, actual %v %v", res, err)
+		}
+	}
+}
+
+func TestConvertInvalidUTF8(t *testing.T) {
+	// 非法utf-8字符串原来序列化失败，现在返回错误
+	block := &xldgpb.InternalBlock{
+		Transactions: []*xldgpb.Transaction{{
+			ContractRequests: []*protos.InvokeRequest{{ContractName: "\xff"}},
+		}},
+	}
+	if err := legacyConvert(block, &pb.InternalBlock{}); err == nil {
+		t.Fatal("expect legacy convert error")
+	}
+	if res, err := BlockToXchain(block); err == nil || res != nil {
+		t.Errorf("expect convert error, actual %v", err)
+	}
+	if _, err := TxToXledger(&pb.Transaction{Nonce: "\xff"}); err == nil {
+		t.Error("expect convert error")
+	}
+	if _, err := ContractStatusListToXchain([]*protos.ContractStatus{{Runtime: "\xfe"}}); err == nil {
+		t.Error("expect convert error")
+	}
+}
+
+func benchBlock() *xldgpb.InternalBlock {
+	block := filled(&xldgpb.InternalBlock{}).(*xldgpb.InternalBlock)
+	tx := block.Transactions[0]
+	block.Transactions = make([]*xldgpb.Transaction, 0, 1000)
+	for i := 0; i < 1000; i++ {
+		block.Transactions = append(block.Transactions, tx)
+	}
+	return block
+}
+
+func BenchmarkBlockToXchain(b *testing.B) {
+	block := benchBlock()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := BlockToXchain(block); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkBlockToXchainLegacy(b *testing.B) {
+	block := benchBlock()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := legacyConvert(block, &pb.InternalBlock{}); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -11,9 +11,9 @@ import (
 // 适配原结构计算txid
 func MakeTxId(tx *pb.Transaction) ([]byte, error) {
 	// 转化结构
-	xldgTx := TxToXledger(tx)
-	if xldgTx == nil {
-		return nil, fmt.Errorf("tx convert fail")
+	xldgTx, err := TxToXledger(tx)
+	if err != nil || xldgTx == nil {
+		return nil, fmt.Errorf("tx convert fail: %v", err)
 	}
 	// 计算txid
 	txId, err := txhash.MakeTransactionID(xldgTx)
@@ -26,9 +26,9 @@ func MakeTxId(tx *pb.Transaction) ([]byte, error) {
 // 适配原结构签名
 func ComputeTxSign(cryptoClient crypto_base.CryptoClient, tx *pb.Transaction, jsonSK []byte) ([]byte, error) {
 	// 转换结构
-	xldgTx := TxToXledger(tx)
-	if xldgTx == nil {
-		return nil, fmt.Errorf("tx convert fail")
+	xldgTx, err := TxToXledger(tx)
+	if err != nil || xldgTx == nil {
+		return nil, fmt.Errorf("tx convert fail: %v", err)
 	}
 	txSign, err := txhash.ProcessSignTx(cryptoClient, xldgTx, jsonSK)
 	if err != nil {
@@ -39,9 +39,9 @@ func ComputeTxSign(cryptoClient crypto_base.CryptoClient, tx *pb.Transaction, js
 
 func MakeTxDigestHash(tx *pb.Transaction) ([]byte, error) {
 	// 转换结构
-	xldgTx := TxToXledger(tx)
-	if xldgTx == nil {
-		return nil, fmt.Errorf("tx convert fail")
+	xldgTx, err := TxToXledger(tx)
+	if err != nil || xldgTx == nil {
+		return nil, fmt.Errorf("tx convert fail: %v", err)
 	}
 	digestHash, err := txhash.MakeTxDigestHash(xldgTx)
 	if err != nil {
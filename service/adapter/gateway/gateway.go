@@ -4,35 +4,52 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
+	"path/filepath"
+	"strconv"
 	"strings"
 	"sync"
 
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/xuperchain/xupercore/kernel/engines"
+	"github.com/xuperchain/xupercore/kernel/engines/xuperos"
+	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
 	"github.com/xuperchain/xupercore/lib/logs"
 	"google.golang.org/grpc"
 
 	sconf "github.com/xuperchain/xuperos/common/config"
 	def "github.com/xuperchain/xuperos/common/def"
+	"github.com/xuperchain/xuperos/common/loglevel"
 	"github.com/xuperchain/xuperos/common/xupospb/pb"
+	scom "github.com/xuperchain/xuperos/service/common"
+	"github.com/xuperchain/xuperos/service/gql"
 )
 
 type Gateway struct {
 	scfg     *sconf.ServConf
+	engine   ecom.Engine
 	log      logs.Logger
-	server   *http.Server
+	servers  []*http.Server
+	lock     sync.Mutex
+	isExit   bool
 	isInit   bool
 	exitOnce *sync.Once
 }
 
-func NewGateway(scfg *sconf.ServConf) (*Gateway, error) {
-	if scfg == nil {
+func NewGateway(scfg *sconf.ServConf, engine engines.BCEngine) (*Gateway, error) {
+	if scfg == nil || engine == nil {
 		return nil, fmt.Errorf("param error")
 	}
+	xosEngine, err := xuperos.EngineConvert(engine)
+	if err != nil {
+		return nil, fmt.Errorf("not xuperos engine")
+	}
 
-	log, _ := logs.NewLogger("", def.SubModName)
+	log, _ := loglevel.NewLogger("", def.SubModName)
 	obj := &Gateway{
 		scfg:     scfg,
+		engine:   xosEngine,
 		log:      log,
 		isInit:   true,
 		exitOnce: &sync.Once{},
@@ -83,8 +100,11 @@ func (t *Gateway) runGateway() error {
 		grpc.WithReadBufferSize(t.scfg.ReadBufSize),
 	}
 
-	rpcEndpoint := fmt.Sprintf(":%d", t.scfg.AdapterGWPort)
-	err := pb.RegisterXchainHandlerFromEndpoint(ctx, mux, rpcEndpoint, opts)
+	rpcEndpoint, err := t.rpcEndpoint()
+	if err != nil {
+		return err
+	}
+	err = pb.RegisterXchainHandlerFromEndpoint(ctx, mux, rpcEndpoint, opts)
 	if err != nil {
 		return err
 	}
@@ -96,22 +116,150 @@ func (t *Gateway) runGateway() error {
 		}
 	}
 
-	addr := fmt.Sprintf(":%d", t.scfg.AdapterGWPort)
-	t.server = &http.Server{
-		Addr:    addr,
-		Handler: t.interupt(mux),
+	handler := http.NewServeMux()
+	handler.Handle("/", mux)
+	handler.HandleFunc("/openapi.json", t.openAPIHandler)
+	if t.scfg.EnableGraphQL {
+		gqlHandler, err := gql.NewHandler(t.scfg, t.engine, t.log)
+		if err != nil {
+			return err
+		}
+		handler.Handle("/graphql", gqlHandler)
 	}
-	err = t.server.ListenAndServe()
-	if err != http.ErrServerClosed {
-		return err
+
+	listeners := make([]net.Listener, 0, len(t.scfg.AdapterGWListeners))
+	servers := make([]*http.Server, 0, len(t.scfg.AdapterGWListeners))
+	closeListeners := func() {
+		for _, lis := range listeners {
+			lis.Close()
+		}
 	}
-	return nil
+	for _, lc := range t.scfg.AdapterGWListeners {
+		server := &http.Server{
+			Addr:    lc.Addr(),
+			Handler: t.interupt(allowPaths(handler, lc.AllowMethods)),
+		}
+		if lc.Tls {
+			server.TLSConfig, err = scom.NewTlsConfig(t.engine.Context().EnvCfg, t.scfg.TlsServerName)
+			if err != nil {
+				closeListeners()
+				return err
+			}
+		}
+		lis, err := net.Listen("tcp", lc.Addr())
+		if err != nil {
+			closeListeners()
+			return err
+		}
+		listeners = append(listeners, lis)
+		servers = append(servers, server)
+		t.log.Trace("gateway listen", "addr", lc.Addr(), "isTls", lc.Tls)
+	}
+
+	if len(servers) == 0 {
+		return fmt.Errorf("no gateway listener configured")
+	}
+	if !t.setServers(servers) {
+		closeListeners()
+		return nil
+	}
+
+	serves := make([]func() error, 0, len(servers))
+	for i := range servers {
+		lis, server := listeners[i], servers[i]
+		serves = append(serves, func() error {
+			var err error
+			if server.TLSConfig != nil {
+				err = server.ServeTLS(lis, "", "")
+			} else {
+				err = server.Serve(lis)
+			}
+			if err != http.ErrServerClosed {
+				return err
+			}
+			return nil
+		})
+	}
+	return scom.ServeAll(serves, t.closeServers)
+}
+
+// 网关转发的adapter rpc地址，优先使用未开启tls且不限制方法的监听
+func (t *Gateway) rpcEndpoint() (string, error) {
+	for _, lc := range t.scfg.AdapterRpcListeners {
+		if lc.Tls || len(lc.AllowMethods) > 0 {
+			continue
+		}
+		// 监听所有地址时通过本机回环地址访问
+		host := lc.Address
+		if ip := net.ParseIP(host); host == "" || (ip != nil && ip.IsUnspecified()) {
+			host = "127.0.0.1"
+			if ip != nil && ip.To4() == nil {
+				host = "::1"
+			}
+		}
+		return net.JoinHostPort(host, strconv.Itoa(lc.Port)), nil
+	}
+
+	if sockPath := t.scfg.AdapterRpcUnixSocket; sockPath != "" {
+		if !filepath.IsAbs(sockPath) {
+			sockPath = t.engine.Context().EnvCfg.GenDirAbsPath(sockPath)
+		}
+		return "unix://" + sockPath, nil
+	}
+
+	return "", fmt.Errorf("no adapter rpc listener available for gateway")
+}
+
+// 记录运行中的http server，已退出时返回false
+func (t *Gateway) setServers(servers []*http.Server) bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if t.isExit {
+		return false
+	}
+	t.servers = servers
+	return true
+}
+
+// 监听异常退出时关闭本次运行的http server，不标记退出，重启时可以重新Run
+func (t *Gateway) closeServers() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	for _, server := range t.servers {
+		server.Close()
+	}
+	t.servers = nil
 }
 
 func (t *Gateway) stopGateway() {
-	if t.server != nil {
-		t.server.Shutdown(context.Background())
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.isExit = true
+	for _, server := range t.servers {
+		server.Shutdown(context.Background())
+	}
+}
+
+// 限制监听只能访问指定url路径，paths为空不限制
+func allowPaths(h http.Handler, paths []string) http.Handler {
+	if len(paths) == 0 {
+		return h
 	}
+
+	allowed := make(map[string]bool, len(paths))
+	for _, path := range paths {
+		allowed[path] = true
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !allowed[r.URL.Path] {
+			http.Error(w, "path not allowed on this listener", http.StatusForbidden)
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
 }
 
 // interupt
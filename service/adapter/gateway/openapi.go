@@ -0,0 +1,18 @@
+package gateway
+
+import (
+	"net/http"
+
+	"github.com/xuperchain/xuperos/common/xupospb/pb"
+)
+
+// 输出由google.api.http注解生成的openapi文档
+func (t *Gateway) openAPIHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write([]byte(pb.OpenAPISpec))
+}
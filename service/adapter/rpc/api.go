@@ -2,8 +2,11 @@ package rpc
 
 import (
 	"context"
+	"fmt"
 	"math/big"
+	"time"
 
+	lpb "github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
 	sctx "github.com/xuperchain/xupercore/example/xchain/common/context"
 	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
 	"github.com/xuperchain/xupercore/lib/utils"
@@ -12,6 +15,13 @@ import (
 
 	"github.com/xuperchain/xuperos/common/xupospb/pb"
 	acom "github.com/xuperchain/xuperos/service/adapter/common"
+	"github.com/xuperchain/xuperos/service/index"
+)
+
+const (
+	// 分页查询区块的默认和最大数量
+	DefBlockRangeLimit = 10
+	MaxBlockRangeLimit = 100
 )
 
 // 注意：
@@ -32,9 +42,9 @@ func (t *RpcServ) PostTx(gctx context.Context, req *pb.TxStatus) (*pb.CommonRepl
 		rctx.GetLog().Warn("param error,some param unset")
 		return resp, ecom.ErrParameter
 	}
-	tx := acom.TxToXledger(req.GetTx())
-	if tx == nil {
-		rctx.GetLog().Warn("param error,tx convert to xledger tx failed")
+	tx, err := acom.TxToXledger(req.GetTx())
+	if err != nil || tx == nil {
+		rctx.GetLog().Warn("param error,tx convert to xledger tx failed", "err", err)
 		return resp, ecom.ErrParameter
 	}
 
@@ -44,6 +54,7 @@ func (t *RpcServ) PostTx(gctx context.Context, req *pb.TxStatus) (*pb.CommonRepl
 		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
 		return resp, err
 	}
+	defer handle.Release()
 	err = handle.SubmitTx(tx)
 	rctx.GetLog().SetInfoField("bc_name", req.GetBcname())
 	rctx.GetLog().SetInfoField("txid", utils.F(req.GetTxid()))
@@ -74,16 +85,22 @@ func (t *RpcServ) PreExec(gctx context.Context, req *pb.InvokeRPCRequest) (*pb.I
 		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
 		return resp, err
 	}
+	defer handle.Release()
 	res, err := handle.PreExec(reqs, req.GetInitiator(), req.GetAuthRequire())
 	rctx.GetLog().SetInfoField("bc_name", req.GetBcname())
 	rctx.GetLog().SetInfoField("initiator", req.GetInitiator())
 	// 设置响应
-	if err == nil {
-		resp.Bcname = req.GetBcname()
-		resp.Response = acom.ConvertInvokeResp(res)
+	if err != nil {
+		return resp, err
+	}
+	resp.Bcname = req.GetBcname()
+	resp.Response, err = acom.ConvertInvokeResp(res)
+	if err != nil {
+		rctx.GetLog().Warn("convert invoke response failed", "err", err)
+		return resp, ecom.ErrInternal.More("%v", err)
 	}
 
-	return resp, err
+	return resp, nil
 }
 
 // PreExecWithSelectUTXO preExec + selectUtxo
@@ -158,6 +175,7 @@ func (t *RpcServ) SelectUTXO(gctx context.Context, req *pb.UtxoInput) (*pb.UtxoO
 		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
 		return resp, err
 	}
+	defer handle.Release()
 	out, err := handle.SelectUtxo(req.GetAddress(), totalNeed, req.GetNeedLock(), false,
 		req.GetPublickey(), req.GetUserSign())
 	if err != nil {
@@ -194,6 +212,7 @@ func (t *RpcServ) SelectUTXOBySize(gctx context.Context, req *pb.UtxoInput) (*pb
 		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
 		return resp, err
 	}
+	defer handle.Release()
 	out, err := handle.SelectUTXOBySize(req.GetAddress(), req.GetNeedLock(), false,
 		req.GetPublickey(), req.GetUserSign())
 	if err != nil {
@@ -230,6 +249,7 @@ func (t *RpcServ) QueryContractStatData(gctx context.Context,
 		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
 		return resp, err
 	}
+	defer handle.Release()
 	res, err := handle.QueryContractStatData()
 	if err != nil {
 		rctx.GetLog().Warn("query contract stat data failed", "err", err.Error())
@@ -264,6 +284,7 @@ func (t *RpcServ) QueryUtxoRecord(gctx context.Context,
 		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
 		return resp, err
 	}
+	defer handle.Release()
 	res, err := handle.QueryUtxoRecord(req.GetAccountName(), req.GetDisplayCount())
 	if err != nil {
 		rctx.GetLog().Warn("query utxo record failed", "err", err.Error())
@@ -295,15 +316,34 @@ func (t *RpcServ) QueryACL(gctx context.Context, req *pb.AclStatus) (*pb.AclStat
 		rctx.GetLog().Warn("param error,unset name")
 		return resp, ecom.ErrParameter
 	}
+	if req.GetHeight() < 0 {
+		rctx.GetLog().Warn("param error,invalid height", "height", req.GetHeight())
+		return resp, ecom.ErrParameter
+	}
 
 	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
 	if err != nil {
 		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
 		return resp, err
 	}
+	defer handle.Release()
 
 	var aclRes *protos.Acl
-	if len(req.GetAccountName()) > 0 {
+	if req.GetHasHeight() || req.GetHeight() > 0 || len(req.GetBlockid()) > 0 {
+		// 查询历史状态
+		block, rerr := handle.ResolveHistoryBlock(req.GetHeight(), req.GetBlockid())
+		if rerr != nil {
+			rctx.GetLog().Warn("resolve history block failed", "err", rerr)
+			return resp, rerr
+		}
+		resp.Height = block.GetHeight()
+		resp.Blockid = block.GetBlockid()
+		if len(req.GetAccountName()) > 0 {
+			aclRes, err = handle.QueryAccountACLAt(block, req.GetAccountName())
+		} else {
+			aclRes, err = handle.QueryContractMethodACLAt(block, req.GetContractName(), req.GetMethodName())
+		}
+	} else if len(req.GetAccountName()) > 0 {
 		aclRes, err = handle.QueryAccountACL(req.GetAccountName())
 	} else if len(req.GetContractName()) > 0 && len(req.GetMethodName()) > 0 {
 		aclRes, err = handle.QueryContractMethodACL(req.GetContractName(), req.GetMethodName())
@@ -318,9 +358,9 @@ func (t *RpcServ) QueryACL(gctx context.Context, req *pb.AclStatus) (*pb.AclStat
 		return resp, nil
 	}
 
-	xchainAcl := acom.AclToXchain(aclRes)
-	if xchainAcl == nil {
-		rctx.GetLog().Warn("convert acl failed")
+	xchainAcl, err := acom.AclToXchain(aclRes)
+	if err != nil || xchainAcl == nil {
+		rctx.GetLog().Warn("convert acl failed", "err", err)
 		return resp, ecom.ErrInternal
 	}
 
@@ -350,6 +390,7 @@ func (t *RpcServ) GetAccountContracts(gctx context.Context, req *pb.GetAccountCo
 		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
 		return resp, err
 	}
+	defer handle.Release()
 
 	var res []*protos.ContractStatus
 	res, err = handle.GetAccountContracts(req.GetAccount())
@@ -387,6 +428,23 @@ func (t *RpcServ) QueryTx(gctx context.Context, req *pb.TxStatus) (*pb.TxStatus,
 		rctx.GetLog().Warn("new chain handle failed", "err", err)
 		return resp, ecom.ErrInternal.More("%v", err)
 	}
+	defer handle.Release()
+
+	// 主干深处的交易不会再变化，只需重新计算确认深度
+	cacheKey := fmt.Sprintf("%s/%x", req.GetBcname(), req.GetTxid())
+	tipHeight := handle.GetTrunkHeight()
+	if val, height, ok := t.txCache.Get(cacheKey, tipHeight); ok {
+		resp.Bcname = req.GetBcname()
+		resp.Txid = req.GetTxid()
+		resp.Tx = val.(*pb.Transaction)
+		resp.Status = pb.TransactionStatus_CONFIRM
+		resp.Distance = tipHeight - height
+
+		rctx.GetLog().SetInfoField("bc_name", req.GetBcname())
+		rctx.GetLog().SetInfoField("account", utils.F(req.GetTxid()))
+		rctx.GetLog().SetInfoField("cache_hit", true)
+		return resp, nil
+	}
 
 	txInfo, err := handle.QueryTx(req.GetTxid())
 	if err != nil {
@@ -394,9 +452,9 @@ func (t *RpcServ) QueryTx(gctx context.Context, req *pb.TxStatus) (*pb.TxStatus,
 		return resp, err
 	}
 
-	tx := acom.TxToXchain(txInfo.Tx)
-	if tx == nil {
-		rctx.GetLog().Warn("convert tx failed")
+	tx, err := acom.TxToXchain(txInfo.Tx)
+	if err != nil || tx == nil {
+		rctx.GetLog().Warn("convert tx failed", "err", err)
 		return resp, ecom.ErrInternal
 	}
 	resp.Bcname = req.GetBcname()
@@ -404,6 +462,11 @@ func (t *RpcServ) QueryTx(gctx context.Context, req *pb.TxStatus) (*pb.TxStatus,
 	resp.Tx = tx
 	resp.Status = pb.TransactionStatus(txInfo.Status)
 	resp.Distance = txInfo.Distance
+	if resp.Status == pb.TransactionStatus_CONFIRM {
+		// 查询后读取的主干高度不低于查询时的高度，推算的区块高度偏大，判断是否可缓存时更保守
+		tipHeight = handle.GetTrunkHeight()
+		t.txCache.Add(cacheKey, tx, tipHeight-txInfo.Distance, tipHeight)
+	}
 
 	rctx.GetLog().SetInfoField("bc_name", req.GetBcname())
 	rctx.GetLog().SetInfoField("account", utils.F(req.GetTxid()))
@@ -419,36 +482,73 @@ func (t *RpcServ) GetBalance(gctx context.Context, req *pb.AddressStatus) (*pb.A
 	// 获取请求上下文，对内传递rctx
 	rctx := sctx.ValueReqCtx(gctx)
 
-	if req == nil || req.GetAddress() == "" {
+	if req == nil || req.GetAddress() == "" || req.GetHeight() < 0 {
 		rctx.GetLog().Warn("param error,some param unset")
 		return resp, ecom.ErrParameter
 	}
+	historical := req.GetHasHeight() || req.GetHeight() > 0 || len(req.GetBlockid()) > 0
+	height := req.GetHeight()
 
 	for i := 0; i < len(req.Bcs); i++ {
-		tmpTokenDetail := &pb.TokenDetail{}
-		handle, err := models.NewChainHandle(req.Bcs[i].Bcname, rctx)
+		tmpTokenDetail, resolved, err := t.queryChainBalance(rctx, req.Bcs[i].Bcname, req, historical)
 		if err != nil {
-			tmpTokenDetail.Error = pb.XChainErrorEnum_BLOCKCHAIN_NOTEXIST
-			tmpTokenDetail.Balance = ""
-			resp.Bcs = append(resp.Bcs, tmpTokenDetail)
-			continue
+			return resp, err
 		}
-		balance, err := handle.GetBalance(req.Address)
-		if err != nil {
-			tmpTokenDetail.Error = pb.XChainErrorEnum_UNKNOW_ERROR
-			tmpTokenDetail.Balance = ""
-		} else {
-			tmpTokenDetail.Error = pb.XChainErrorEnum_SUCCESS
-			tmpTokenDetail.Balance = balance
+		if historical && tmpTokenDetail.Error == pb.XChainErrorEnum_SUCCESS {
+			height = resolved
 		}
 		resp.Bcs = append(resp.Bcs, tmpTokenDetail)
 	}
 	resp.Address = req.GetAddress()
+	resp.Height = height
+	resp.Blockid = req.GetBlockid()
 
 	rctx.GetLog().SetInfoField("account", req.GetAddress())
 	return resp, nil
 }
 
+// 查询单条链的余额，每条链查询结束后释放链
+// 历史余额优先从代币统计索引读取，未开启或未同步到该高度时回放账本，高度超出或状态已裁剪直接返回错误
+func (t *RpcServ) queryChainBalance(rctx sctx.ReqCtx, bcName string, req *pb.AddressStatus,
+	historical bool) (*pb.TokenDetail, int64, error) {
+	tmpTokenDetail := &pb.TokenDetail{}
+	handle, err := models.NewChainHandle(bcName, rctx)
+	if err != nil {
+		tmpTokenDetail.Error = pb.XChainErrorEnum_BLOCKCHAIN_NOTEXIST
+		tmpTokenDetail.Balance = ""
+		return tmpTokenDetail, 0, nil
+	}
+	defer handle.Release()
+
+	var balance string
+	var height int64
+	if historical {
+		block, rerr := handle.ResolveHistoryBlock(req.GetHeight(), req.GetBlockid())
+		if rerr != nil {
+			rctx.GetLog().Warn("resolve history block failed", "bc_name", bcName, "err", rerr)
+			return nil, 0, rerr
+		}
+		height = block.GetHeight()
+		balance, err = t.indexer.GetBalanceAt(bcName, req.GetAddress(), height)
+		if err == index.ErrTokenIndexDisabled || err == index.ErrHeightNotIndexed {
+			balance, err = handle.GetBalanceAt(block, req.GetAddress())
+		}
+		if err == models.ErrHistoryPruned || err == models.ErrReplayHeightExceeded {
+			return nil, 0, err
+		}
+	} else {
+		balance, err = handle.GetBalance(req.GetAddress())
+	}
+	if err != nil {
+		tmpTokenDetail.Error = pb.XChainErrorEnum_UNKNOW_ERROR
+		tmpTokenDetail.Balance = ""
+	} else {
+		tmpTokenDetail.Error = pb.XChainErrorEnum_SUCCESS
+		tmpTokenDetail.Balance = balance
+	}
+	return tmpTokenDetail, height, nil
+}
+
 // GetFrozenBalance get balance frozened for account or addr
 func (t *RpcServ) GetFrozenBalance(gctx context.Context, req *pb.AddressStatus) (*pb.AddressStatus, error) {
 	// 默认响应
@@ -462,6 +562,10 @@ func (t *RpcServ) GetFrozenBalance(gctx context.Context, req *pb.AddressStatus)
 		rctx.GetLog().Warn("param error,some param unset")
 		return resp, ecom.ErrParameter
 	}
+	if req.GetHasHeight() || req.GetHeight() != 0 || len(req.GetBlockid()) > 0 {
+		rctx.GetLog().Warn("param error,history frozen balance not supported")
+		return resp, ecom.ErrParameter.More("history frozen balance not supported")
+	}
 
 	for i := 0; i < len(req.Bcs); i++ {
 		tmpTokenDetail := &pb.TokenDetail{}
@@ -472,6 +576,7 @@ func (t *RpcServ) GetFrozenBalance(gctx context.Context, req *pb.AddressStatus)
 			resp.Bcs = append(resp.Bcs, tmpTokenDetail)
 			continue
 		}
+		defer handle.Release()
 		balance, err := handle.GetFrozenBalance(req.Address)
 		if err != nil {
 			tmpTokenDetail.Error = pb.XChainErrorEnum_UNKNOW_ERROR
@@ -511,6 +616,7 @@ func (t *RpcServ) GetBalanceDetail(gctx context.Context, req *pb.AddressBalanceS
 			resp.Tfds = append(resp.Tfds, tmpFrozenDetails)
 			continue
 		}
+		defer handle.Release()
 		tfd, err := handle.GetBalanceDetail(req.GetAddress())
 		if err != nil {
 			tmpFrozenDetails.Error = pb.XChainErrorEnum_UNKNOW_ERROR
@@ -548,6 +654,22 @@ func (t *RpcServ) GetBlock(gctx context.Context, req *pb.BlockID) (*pb.Block, er
 		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
 		return resp, err
 	}
+	defer handle.Release()
+
+	// 主干深处的区块不会再变化，优先读缓存
+	cacheKey := fmt.Sprintf("b/%s/%x", req.GetBcname(), req.GetBlockid())
+	tipHeight := handle.GetTrunkHeight()
+	if val, height, ok := t.blockCache.Get(cacheKey, tipHeight); ok {
+		resp.Block = val.(*pb.InternalBlock)
+		resp.Status = pb.Block_TRUNK
+		resp.Bcname = req.Bcname
+		resp.Blockid = req.Blockid
+
+		rctx.GetLog().SetInfoField("blockid", req.GetBlockid())
+		rctx.GetLog().SetInfoField("height", height)
+		rctx.GetLog().SetInfoField("cache_hit", true)
+		return resp, nil
+	}
 
 	blockInfo, err := handle.QueryBlock(req.GetBlockid(), true)
 	if err != nil {
@@ -555,11 +677,14 @@ func (t *RpcServ) GetBlock(gctx context.Context, req *pb.BlockID) (*pb.Block, er
 		return resp, err
 	}
 
-	block := acom.BlockToXchain(blockInfo.Block)
-	if block == nil {
-		rctx.GetLog().Warn("convert block failed")
+	block, err := acom.BlockToXchain(blockInfo.Block)
+	if err != nil || block == nil {
+		rctx.GetLog().Warn("convert block failed", "err", err)
 		return resp, ecom.ErrInternal
 	}
+	if blockInfo.Status == lpb.BlockStatus_BLOCK_TRUNK {
+		t.blockCache.Add(cacheKey, block, block.GetHeight(), tipHeight)
+	}
 
 	resp.Block = block
 	resp.Status = pb.Block_EBlockStatus(blockInfo.Status)
@@ -588,6 +713,7 @@ func (t *RpcServ) GetBlockChainStatus(gctx context.Context, req *pb.BCStatus) (*
 		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
 		return resp, err
 	}
+	defer handle.Release()
 
 	status, err := handle.QueryChainStatus()
 	if err != nil {
@@ -595,20 +721,20 @@ func (t *RpcServ) GetBlockChainStatus(gctx context.Context, req *pb.BCStatus) (*
 		return resp, err
 	}
 
-	block := acom.BlockToXchain(status.Block)
-	if block == nil {
-		rctx.GetLog().Warn("convert block failed")
-		return resp, err
+	block, err := acom.BlockToXchain(status.Block)
+	if err != nil || block == nil {
+		rctx.GetLog().Warn("convert block failed", "err", err)
+		return resp, ecom.ErrInternal
 	}
-	ledgerMeta := acom.LedgerMetaToXchain(status.LedgerMeta)
-	if ledgerMeta == nil {
-		rctx.GetLog().Warn("convert ledger meta failed")
-		return resp, err
+	ledgerMeta, err := acom.LedgerMetaToXchain(status.LedgerMeta)
+	if err != nil || ledgerMeta == nil {
+		rctx.GetLog().Warn("convert ledger meta failed", "err", err)
+		return resp, ecom.ErrInternal
 	}
-	utxoMeta := acom.UtxoMetaToXchain(status.UtxoMeta)
-	if utxoMeta == nil {
-		rctx.GetLog().Warn("convert utxo meta failed")
-		return resp, err
+	utxoMeta, err := acom.UtxoMetaToXchain(status.UtxoMeta)
+	if err != nil || utxoMeta == nil {
+		rctx.GetLog().Warn("convert utxo meta failed", "err", err)
+		return resp, ecom.ErrInternal
 	}
 	resp.Bcname = req.Bcname
 	resp.Meta = ledgerMeta
@@ -638,6 +764,7 @@ func (t *RpcServ) ConfirmBlockChainStatus(gctx context.Context, req *pb.BCStatus
 		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
 		return resp, err
 	}
+	defer handle.Release()
 
 	isTrunkTip, err := handle.IsTrunkTipBlock(req.GetBlock().GetBlockid())
 	if err != nil {
@@ -741,17 +868,38 @@ func (t *RpcServ) GetBlockByHeight(gctx context.Context, req *pb.BlockHeight) (*
 		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
 		return resp, err
 	}
+	defer handle.Release()
+
+	// 主干深处高度对应的区块不会再变化，优先读缓存
+	cacheKey := fmt.Sprintf("h/%s/%d", req.GetBcname(), req.GetHeight())
+	tipHeight := handle.GetTrunkHeight()
+	if val, _, ok := t.blockCache.Get(cacheKey, tipHeight); ok {
+		block := val.(*pb.InternalBlock)
+		resp.Block = block
+		resp.Status = pb.Block_TRUNK
+		resp.Bcname = req.GetBcname()
+		resp.Blockid = block.Blockid
+
+		rctx.GetLog().SetInfoField("height", req.GetHeight())
+		rctx.GetLog().SetInfoField("blockid", utils.F(block.Blockid))
+		rctx.GetLog().SetInfoField("cache_hit", true)
+		return resp, nil
+	}
+
 	blockInfo, err := handle.QueryBlockByHeight(req.GetHeight(), true)
 	if err != nil {
 		rctx.GetLog().Warn("query block error", "bc", req.GetBcname(), "height", req.GetHeight())
 		return resp, err
 	}
 
-	block := acom.BlockToXchain(blockInfo.Block)
-	if block == nil {
-		rctx.GetLog().Warn("convert block failed")
+	block, err := acom.BlockToXchain(blockInfo.Block)
+	if err != nil || block == nil {
+		rctx.GetLog().Warn("convert block failed", "err", err)
 		return resp, ecom.ErrInternal
 	}
+	if blockInfo.Status == lpb.BlockStatus_BLOCK_TRUNK {
+		t.blockCache.Add(cacheKey, block, block.GetHeight(), tipHeight)
+	}
 	resp.Block = block
 	resp.Status = pb.Block_EBlockStatus(blockInfo.Status)
 	resp.Bcname = req.GetBcname()
@@ -779,6 +927,7 @@ func (t *RpcServ) GetAccountByAK(gctx context.Context, req *pb.AK2AccountRequest
 		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
 		return resp, err
 	}
+	defer handle.Release()
 
 	accounts, err := handle.GetAccountByAK(req.GetAddress())
 	if err != nil || accounts == nil {
@@ -810,6 +959,7 @@ func (t *RpcServ) GetAddressContracts(gctx context.Context, req *pb.AddressContr
 		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
 		return resp, err
 	}
+	defer handle.Release()
 
 	accounts, err := handle.GetAccountByAK(req.GetAddress())
 	if err != nil || accounts == nil {
@@ -946,3 +1096,452 @@ func (t *RpcServ) DposStatus(gctx context.Context, req *pb.DposStatusRequest) (*
 
 	return resp, ecom.ErrForbidden
 }
+
+// GetAddressTxs get transactions of an address or account page by page
+func (t *RpcServ) GetAddressTxs(gctx context.Context, req *pb.AddressTxsRequest) (*pb.AddressTxsResponse, error) {
+	// 默认响应
+	resp := &pb.AddressTxsResponse{}
+	// 获取请求上下文，对内传递rctx
+	rctx := sctx.ValueReqCtx(gctx)
+
+	if req == nil || req.GetBcname() == "" || req.GetAddress() == "" {
+		rctx.GetLog().Warn("param error,some param unset")
+		return resp, ecom.ErrParameter
+	}
+
+	txs, nextCursor, err := t.indexer.GetAddressTxs(req.GetBcname(), req.GetAddress(),
+		req.GetCursor(), int(req.GetLimit()))
+	if err != nil {
+		rctx.GetLog().Warn("get address txs failed", "err", err)
+		return resp, err
+	}
+
+	resp.Bcname = req.GetBcname()
+	resp.Address = req.GetAddress()
+	resp.Txs = make([]*pb.AddressTx, 0, len(txs))
+	for _, tx := range txs {
+		resp.Txs = append(resp.Txs, &pb.AddressTx{
+			Height:    tx.Height,
+			Txid:      tx.Txid,
+			Direction: pb.TxDirection(tx.Direction),
+			Amount:    tx.Amount,
+			Timestamp: tx.Timestamp,
+		})
+	}
+	resp.NextCursor = nextCursor
+
+	rctx.GetLog().SetInfoField("bc_name", req.GetBcname())
+	rctx.GetLog().SetInfoField("address", req.GetAddress())
+	return resp, nil
+}
+
+// QueryContractEvents query historical contract events page by page
+func (t *RpcServ) QueryContractEvents(gctx context.Context,
+	req *pb.ContractEventsRequest) (*pb.ContractEventsResponse, error) {
+	// 默认响应
+	resp := &pb.ContractEventsResponse{}
+	// 获取请求上下文，对内传递rctx
+	rctx := sctx.ValueReqCtx(gctx)
+
+	if req == nil || req.GetBcname() == "" {
+		rctx.GetLog().Warn("param error,some param unset")
+		return resp, ecom.ErrParameter
+	}
+
+	filter := &index.EventFilter{
+		Contract:    req.GetContract(),
+		EventName:   req.GetEventName(),
+		Initiator:   req.GetInitiator(),
+		StartHeight: req.GetStartHeight(),
+		EndHeight:   req.GetEndHeight(),
+	}
+	events, nextCursor, err := t.indexer.QueryContractEvents(req.GetBcname(), filter,
+		req.GetCursor(), int(req.GetLimit()))
+	if err != nil {
+		rctx.GetLog().Warn("query contract events failed", "err", err)
+		return resp, err
+	}
+
+	resp.Bcname = req.GetBcname()
+	resp.Events = make([]*pb.ContractEventInfo, 0, len(events))
+	for _, event := range events {
+		resp.Events = append(resp.Events, &pb.ContractEventInfo{
+			Contract:  event.Contract,
+			Name:      event.Name,
+			Body:      event.Body,
+			Txid:      event.Txid,
+			Height:    event.Height,
+			Initiator: event.Initiator,
+			Timestamp: event.Timestamp,
+		})
+	}
+	resp.NextCursor = nextCursor
+
+	rctx.GetLog().SetInfoField("bc_name", req.GetBcname())
+	rctx.GetLog().SetInfoField("contract", req.GetContract())
+	return resp, nil
+}
+
+// GetBlockRange get trunk blocks by height range page by page
+func (t *RpcServ) GetBlockRange(gctx context.Context, req *pb.BlockRangeRequest) (*pb.BlockRangeResponse, error) {
+	// 默认响应
+	resp := &pb.BlockRangeResponse{}
+	// 获取请求上下文，对内传递rctx
+	rctx := sctx.ValueReqCtx(gctx)
+
+	if req == nil || req.GetBcname() == "" || req.GetFrom() < 0 || req.GetTo() < req.GetFrom() {
+		rctx.GetLog().Warn("param error,some param unset or invalid range")
+		return resp, ecom.ErrParameter
+	}
+	limit := int64(req.GetLimit())
+	if limit <= 0 {
+		limit = DefBlockRangeLimit
+	}
+	if limit > MaxBlockRangeLimit {
+		limit = MaxBlockRangeLimit
+	}
+
+	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
+	if err != nil {
+		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
+		return resp, err
+	}
+	defer handle.Release()
+
+	resp.Bcname = req.GetBcname()
+	resp.Blocks = make([]*pb.Block, 0, limit)
+	height := req.GetFrom()
+	for ; height <= req.GetTo() && height < req.GetFrom()+limit; height++ {
+		blockInfo, err := handle.QueryBlockByHeight(height, true)
+		if err != nil {
+			rctx.GetLog().Warn("query block error", "bc", req.GetBcname(), "height", height)
+			return resp, err
+		}
+		// 超过主干高度，结束
+		if blockInfo.GetBlock() == nil {
+			break
+		}
+
+		block := blockInfo.Block
+		if req.GetExcludeTx() {
+			// 账本可能缓存区块对象，裁剪时复制一份
+			header := *block
+			header.Transactions = nil
+			block = &header
+		}
+		xblock := &pb.InternalBlock{Blockid: block.GetBlockid(), Height: block.GetHeight()}
+		if req.GetNeedContent() {
+			xblock, err = acom.BlockToXchain(block)
+			if err != nil || xblock == nil {
+				rctx.GetLog().Warn("convert block failed", "height", height, "err", err)
+				return resp, ecom.ErrInternal
+			}
+		}
+		resp.Blocks = append(resp.Blocks, &pb.Block{
+			Bcname:  req.GetBcname(),
+			Blockid: block.GetBlockid(),
+			Status:  pb.Block_EBlockStatus(blockInfo.GetStatus()),
+			Block:   xblock,
+		})
+	}
+	resp.NextHeight = height
+	resp.HasMore = height <= req.GetTo() && len(resp.Blocks) == int(limit)
+
+	rctx.GetLog().SetInfoField("from", req.GetFrom())
+	rctx.GetLog().SetInfoField("count", len(resp.Blocks))
+	return resp, nil
+}
+
+// GetUnconfirmedTxids list unconfirmed txids page by page
+func (t *RpcServ) GetUnconfirmedTxids(gctx context.Context,
+	req *pb.UnconfirmedTxsRequest) (*pb.UnconfirmedTxidsResponse, error) {
+	// 默认响应
+	resp := &pb.UnconfirmedTxidsResponse{}
+	// 获取请求上下文，对内传递rctx
+	rctx := sctx.ValueReqCtx(gctx)
+
+	if req == nil || req.GetBcname() == "" {
+		rctx.GetLog().Warn("param error,some param unset")
+		return resp, ecom.ErrParameter
+	}
+
+	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
+	if err != nil {
+		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
+		return resp, err
+	}
+	defer handle.Release()
+	pool, err := handle.GetTxPool()
+	if err != nil {
+		return resp, err
+	}
+	txs, nextCursor, total, err := pool.List(req.GetInitiator(), req.GetCursor(), int(req.GetLimit()))
+	if err != nil {
+		rctx.GetLog().Warn("list unconfirmed tx failed", "err", err)
+		return resp, err
+	}
+
+	resp.Bcname = req.GetBcname()
+	resp.Txids = make([][]byte, 0, len(txs))
+	for _, tx := range txs {
+		resp.Txids = append(resp.Txids, tx.GetTxid())
+	}
+	resp.NextCursor = nextCursor
+	resp.Total = total
+
+	rctx.GetLog().SetInfoField("bc_name", req.GetBcname())
+	rctx.GetLog().SetInfoField("total", total)
+	return resp, nil
+}
+
+// GetUnconfirmedTxs get unconfirmed txs page by page
+func (t *RpcServ) GetUnconfirmedTxs(gctx context.Context,
+	req *pb.UnconfirmedTxsRequest) (*pb.UnconfirmedTxsResponse, error) {
+	// 默认响应
+	resp := &pb.UnconfirmedTxsResponse{}
+	// 获取请求上下文，对内传递rctx
+	rctx := sctx.ValueReqCtx(gctx)
+
+	if req == nil || req.GetBcname() == "" {
+		rctx.GetLog().Warn("param error,some param unset")
+		return resp, ecom.ErrParameter
+	}
+
+	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
+	if err != nil {
+		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
+		return resp, err
+	}
+	defer handle.Release()
+	pool, err := handle.GetTxPool()
+	if err != nil {
+		return resp, err
+	}
+	txs, nextCursor, _, err := pool.List(req.GetInitiator(), req.GetCursor(), int(req.GetLimit()))
+	if err != nil {
+		rctx.GetLog().Warn("list unconfirmed tx failed", "err", err)
+		return resp, err
+	}
+
+	resp.Bcname = req.GetBcname()
+	resp.Txs = make([]*pb.Transaction, 0, len(txs))
+	for _, tx := range txs {
+		xtx, err := acom.TxToXchain(tx)
+		if err != nil {
+			rctx.GetLog().Warn("convert tx failed", "txid", utils.F(tx.GetTxid()), "err", err)
+			return resp, ecom.ErrInternal
+		}
+		resp.Txs = append(resp.Txs, xtx)
+	}
+	resp.NextCursor = nextCursor
+
+	rctx.GetLog().SetInfoField("bc_name", req.GetBcname())
+	rctx.GetLog().SetInfoField("initiator", req.GetInitiator())
+	return resp, nil
+}
+
+// GetTxPoolStatus summarize unconfirmed tx pool
+func (t *RpcServ) GetTxPoolStatus(gctx context.Context,
+	req *pb.TxPoolStatusRequest) (*pb.TxPoolStatusResponse, error) {
+	// 默认响应
+	resp := &pb.TxPoolStatusResponse{}
+	// 获取请求上下文，对内传递rctx
+	rctx := sctx.ValueReqCtx(gctx)
+
+	if req == nil || req.GetBcname() == "" {
+		rctx.GetLog().Warn("param error,some param unset")
+		return resp, ecom.ErrParameter
+	}
+
+	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
+	if err != nil {
+		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
+		return resp, err
+	}
+	defer handle.Release()
+	pool, err := handle.GetTxPool()
+	if err != nil {
+		return resp, err
+	}
+	status := pool.Status(time.Now())
+
+	resp.Bcname = req.GetBcname()
+	resp.TxCount = status.TxCount
+	resp.OldestTimestamp = status.OldestTimestamp
+	resp.NewestTimestamp = status.NewestTimestamp
+	resp.MaxAgeSeconds = status.MaxAgeSeconds
+	resp.TopInitiators = make([]*pb.TxPoolInitiatorStat, 0, len(status.TopInitiators))
+	for _, stat := range status.TopInitiators {
+		resp.TopInitiators = append(resp.TopInitiators, &pb.TxPoolInitiatorStat{
+			Initiator: stat.Initiator,
+			TxCount:   stat.TxCount,
+		})
+	}
+	resp.Conflicts = make([]*pb.TxPoolConflict, 0, len(status.Conflicts))
+	for _, conflict := range status.Conflicts {
+		resp.Conflicts = append(resp.Conflicts, &pb.TxPoolConflict{
+			RefTxid:   conflict.RefTxid,
+			RefOffset: conflict.RefOffset,
+			Txids:     conflict.Txids,
+		})
+	}
+
+	rctx.GetLog().SetInfoField("bc_name", req.GetBcname())
+	rctx.GetLog().SetInfoField("tx_count", status.TxCount)
+	return resp, nil
+}
+
+// WaitTx wait tx to be confirmed with enough blocks on top
+func (t *RpcServ) WaitTx(gctx context.Context, req *pb.WaitTxRequest) (*pb.WaitTxResponse, error) {
+	// 默认响应
+	resp := &pb.WaitTxResponse{}
+	// 获取请求上下文，对内传递rctx
+	rctx := sctx.ValueReqCtx(gctx)
+
+	if req == nil || req.GetBcname() == "" || len(req.GetTxid()) == 0 ||
+		req.GetConfirmations() < 0 || req.GetTimeoutSeconds() < 0 {
+		rctx.GetLog().Warn("param error,some param unset or invalid")
+		return resp, ecom.ErrParameter
+	}
+
+	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
+	if err != nil {
+		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
+		return resp, err
+	}
+	defer handle.Release()
+	res, err := handle.WaitTx(gctx, req.GetTxid(), req.GetConfirmations(),
+		time.Duration(req.GetTimeoutSeconds())*time.Second)
+	if err != nil {
+		rctx.GetLog().Warn("wait tx failed", "txid", utils.F(req.GetTxid()), "err", err)
+		return resp, err
+	}
+
+	resp.Bcname = req.GetBcname()
+	resp.Txid = req.GetTxid()
+	resp.Result = pb.WaitTxResult(res.Result)
+	resp.Status = pb.TransactionStatus(res.Status)
+	resp.Confirmations = res.Confirmations
+	resp.Blockid = res.Blockid
+
+	rctx.GetLog().SetInfoField("txid", utils.F(req.GetTxid()))
+	rctx.GetLog().SetInfoField("result", resp.Result.String())
+	return resp, nil
+}
+
+// EstimateFee estimate gas, fee and utxos needed by a draft tx or invoke requests
+func (t *RpcServ) EstimateFee(gctx context.Context, req *pb.EstimateFeeRequest) (*pb.EstimateFeeResponse, error) {
+	// 默认响应
+	resp := &pb.EstimateFeeResponse{}
+	// 获取请求上下文，对内传递rctx
+	rctx := sctx.ValueReqCtx(gctx)
+
+	if req == nil || req.GetBcname() == "" {
+		rctx.GetLog().Warn("param error,some param unset")
+		return resp, ecom.ErrParameter
+	}
+
+	invokeReqs := req.GetRequests()
+	initiator := req.GetInitiator()
+	authRequire := req.GetAuthRequire()
+	amount := big.NewInt(0)
+	if tx := req.GetTx(); tx != nil {
+		// 草稿交易的转账金额为转给发起者和手续费以外的输出之和
+		invokeReqs = tx.GetContractRequests()
+		initiator = tx.GetInitiator()
+		authRequire = tx.GetAuthRequire()
+		for _, output := range tx.GetTxOutputs() {
+			toAddr := string(output.GetToAddr())
+			if toAddr == "$" || toAddr == initiator {
+				continue
+			}
+			amount.Add(amount, new(big.Int).SetBytes(output.GetAmount()))
+		}
+	} else if req.GetAmount() != "" {
+		if _, ok := amount.SetString(req.GetAmount(), 10); !ok || amount.Sign() < 0 {
+			rctx.GetLog().Warn("param error,invalid amount", "amount", req.GetAmount())
+			return resp, ecom.ErrParameter
+		}
+	}
+	if initiator == "" {
+		rctx.GetLog().Warn("param error,initiator unset")
+		return resp, ecom.ErrParameter
+	}
+	reqs, err := acom.ConvertInvokeReq(invokeReqs)
+	if err != nil {
+		rctx.GetLog().Warn("param error, convert failed", "err", err)
+		return resp, ecom.ErrParameter
+	}
+
+	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
+	if err != nil {
+		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
+		return resp, err
+	}
+	defer handle.Release()
+	res, err := handle.EstimateFee(reqs, initiator, authRequire, amount)
+	if err != nil {
+		rctx.GetLog().Warn("estimate fee failed", "err", err)
+		return resp, err
+	}
+
+	resp.Bcname = req.GetBcname()
+	resp.GasUsed = res.GasUsed
+	resp.Resources = make([]*pb.ResourceLimit, 0, len(res.Resources))
+	for _, limit := range res.Resources {
+		resp.Resources = append(resp.Resources, &pb.ResourceLimit{
+			Type:  pb.ResourceType(limit.GetType()),
+			Limit: limit.GetLimit(),
+		})
+	}
+	resp.GasPrice = &pb.GasPrice{
+		CpuRate:  res.GasPrice.GetCpuRate(),
+		MemRate:  res.GasPrice.GetMemRate(),
+		DiskRate: res.GasPrice.GetDiskRate(),
+		XfeeRate: res.GasPrice.GetXfeeRate(),
+	}
+	resp.Fee = res.Fee
+	resp.TotalNeed = res.TotalNeed.String()
+	resp.UtxoCount = res.UtxoCount
+	resp.UtxoEnough = res.UtxoEnough
+
+	rctx.GetLog().SetInfoField("bc_name", req.GetBcname())
+	rctx.GetLog().SetInfoField("initiator", initiator)
+	rctx.GetLog().SetInfoField("gas_used", res.GasUsed)
+	return resp, nil
+}
+
+// GetTokenStats get total supply, frozen amount and top holders from token index
+func (t *RpcServ) GetTokenStats(gctx context.Context, req *pb.TokenStatsRequest) (*pb.TokenStatsResponse, error) {
+	// 默认响应
+	resp := &pb.TokenStatsResponse{}
+	// 获取请求上下文，对内传递rctx
+	rctx := sctx.ValueReqCtx(gctx)
+
+	if req == nil || req.GetBcname() == "" || req.GetTopN() < 0 {
+		rctx.GetLog().Warn("param error,some param unset or invalid")
+		return resp, ecom.ErrParameter
+	}
+
+	stats, err := t.indexer.GetTokenStats(req.GetBcname(), int(req.GetTopN()))
+	if err != nil {
+		rctx.GetLog().Warn("get token stats failed", "err", err)
+		return resp, err
+	}
+
+	resp.Bcname = req.GetBcname()
+	resp.Height = stats.Height
+	resp.TotalSupply = stats.TotalSupply
+	resp.FrozenAmount = stats.FrozenAmount
+	resp.HolderCount = stats.HolderCount
+	resp.TopHolders = make([]*pb.TokenHolder, 0, len(stats.TopHolders))
+	for _, holder := range stats.TopHolders {
+		resp.TopHolders = append(resp.TopHolders, &pb.TokenHolder{
+			Address: holder.Address,
+			Balance: holder.Balance,
+		})
+	}
+
+	rctx.GetLog().SetInfoField("bc_name", req.GetBcname())
+	return resp, nil
+}
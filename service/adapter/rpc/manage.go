@@ -1,11 +1,8 @@
 package rpc
 
 import (
-	"crypto/tls"
-	"crypto/x509"
 	"errors"
 	"fmt"
-	"io/ioutil"
 	"net"
 	"path/filepath"
 	"sync"
@@ -23,7 +20,10 @@ import (
 
 	sconf "github.com/xuperchain/xuperos/common/config"
 	"github.com/xuperchain/xuperos/common/def"
+	"github.com/xuperchain/xuperos/common/loglevel"
 	"github.com/xuperchain/xuperos/common/xupospb/pb"
+	scom "github.com/xuperchain/xuperos/service/common"
+	"github.com/xuperchain/xuperos/service/index"
 )
 
 // rpc server启停控制管理
@@ -32,12 +32,15 @@ type RpcServMG struct {
 	engine   ecom.Engine
 	log      logs.Logger
 	rpcServ  *RpcServ
-	servHD   *grpc.Server
+	servHDs  []*grpc.Server
+	lock     sync.Mutex
+	isExit   bool
 	isInit   bool
 	exitOnce *sync.Once
 }
 
-func NewRpcServMG(scfg *sconf.ServConf, engine engines.BCEngine) (*RpcServMG, error) {
+func NewRpcServMG(scfg *sconf.ServConf, engine engines.BCEngine,
+	indexer *index.Indexer) (*RpcServMG, error) {
 	if scfg == nil || engine == nil {
 		return nil, fmt.Errorf("param error")
 	}
@@ -46,12 +49,12 @@ func NewRpcServMG(scfg *sconf.ServConf, engine engines.BCEngine) (*RpcServMG, er
 		return nil, fmt.Errorf("not xuperos engine")
 	}
 
-	log, _ := logs.NewLogger("", def.SubModName)
+	log, _ := loglevel.NewLogger("", def.SubModName)
 	obj := &RpcServMG{
 		scfg:     scfg,
 		engine:   xosEngine,
 		log:      log,
-		rpcServ:  NewRpcServ(engine.(ecom.Engine), log),
+		rpcServ:  NewRpcServ(scfg, engine.(ecom.Engine), indexer, log),
 		isInit:   true,
 		exitOnce: &sync.Once{},
 	}
@@ -65,8 +68,6 @@ func (t *RpcServMG) Run() error {
 		return errors.New("RpcServMG not init")
 	}
 
-	t.log.Trace("run grpc server", "isTls", t.scfg.EnableTls)
-
 	// 启动rpc server，阻塞直到退出
 	err := t.runRpcServ()
 	if err != nil {
@@ -89,42 +90,73 @@ func (t *RpcServMG) Exit() {
 	})
 }
 
-// 启动rpc服务，阻塞直到退出
+// 启动rpc服务，每个监听配置对应一个grpc server，阻塞直到退出
 func (t *RpcServMG) runRpcServ() error {
-	unaryInterceptors := []grpc.UnaryServerInterceptor{
-		t.rpcServ.UnaryInterceptor(),
-		gpromeus.UnaryServerInterceptor,
-	}
-
-	rpcOptions := []grpc.ServerOption{
-		middleware.WithUnaryServerChain(unaryInterceptors...),
-		grpc.MaxRecvMsgSize(t.scfg.MaxMsgSize),
-		grpc.ReadBufferSize(t.scfg.ReadBufSize),
-		grpc.InitialWindowSize(t.scfg.InitWindowSize),
-		grpc.InitialConnWindowSize(t.scfg.InitConnWindowSize),
-		grpc.WriteBufferSize(t.scfg.WriteBufSize),
+	listeners := make([]net.Listener, 0, len(t.scfg.AdapterRpcListeners)+1)
+	servHDs := make([]*grpc.Server, 0, len(t.scfg.AdapterRpcListeners)+1)
+	closeListeners := func() {
+		for _, lis := range listeners {
+			lis.Close()
+		}
 	}
 
-	if t.scfg.EnableTls {
-		creds, err := t.newTls()
+	for _, lc := range t.scfg.AdapterRpcListeners {
+		servHD, err := t.newServHD(lc.Tls, lc.AllowMethods)
 		if err != nil {
+			closeListeners()
+			t.log.Error("failed to create grpc server", "addr", lc.Addr(), "err", err)
 			return err
 		}
-		rpcOptions = append(rpcOptions, grpc.Creds(creds))
+		lis, err := net.Listen("tcp", lc.Addr())
+		if err != nil {
+			closeListeners()
+			t.log.Error("failed to listen", "addr", lc.Addr(), "err", err.Error())
+			return fmt.Errorf("failed to listen")
+		}
+		listeners = append(listeners, lis)
+		servHDs = append(servHDs, servHD)
+		t.log.Trace("rpc server listen", "addr", lc.Addr(), "isTls", lc.Tls)
 	}
 
-	t.servHD = grpc.NewServer(rpcOptions...)
-	pb.RegisterXchainServer(t.servHD, t.rpcServ)
+	// 按配置监听unix socket，供本机工具访问
+	if t.scfg.AdapterRpcUnixSocket != "" {
+		sockPath := t.scfg.AdapterRpcUnixSocket
+		if !filepath.IsAbs(sockPath) {
+			sockPath = t.engine.Context().EnvCfg.GenDirAbsPath(sockPath)
+		}
+		servHD, _ := t.newServHD(false, nil)
+		lis, err := scom.ListenUnix(sockPath, t.scfg.UnixSocketPerm)
+		if err != nil {
+			closeListeners()
+			t.log.Error("failed to listen unix socket", "path", sockPath, "err", err)
+			return fmt.Errorf("failed to listen unix socket")
+		}
+		listeners = append(listeners, lis)
+		servHDs = append(servHDs, servHD)
+		t.log.Trace("rpc server listen unix socket", "path", sockPath)
+	}
 
-	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", t.scfg.AdapterRpcPort))
-	if err != nil {
-		t.log.Error("failed to listen", "err", err)
-		return fmt.Errorf("failed to listen")
+	if len(listeners) == 0 {
+		return fmt.Errorf("no rpc listener configured")
+	}
+	if !t.setServHDs(servHDs) {
+		closeListeners()
+		return nil
 	}
 
-	reflection.Register(t.servHD)
-	if err := t.servHD.Serve(lis); err != nil {
-		t.log.Error("failed to serve", "err", err)
+	serves := make([]func() error, 0, len(listeners))
+	for i := range listeners {
+		lis, servHD := listeners[i], servHDs[i]
+		serves = append(serves, func() error {
+			err := servHD.Serve(lis)
+			if err != nil && err != grpc.ErrServerStopped {
+				t.log.Error("failed to serve", "addr", lis.Addr().String(), "err", err.Error())
+				return err
+			}
+			return nil
+		})
+	}
+	if err := scom.ServeAll(serves, t.closeServHDs); err != nil {
 		return err
 	}
 
@@ -132,39 +164,66 @@ func (t *RpcServMG) runRpcServ() error {
 	return nil
 }
 
-func (t *RpcServMG) newTls() (credentials.TransportCredentials, error) {
-	envConf := t.engine.Context().EnvCfg
-	tlsPath := envConf.GenDataAbsPath(envConf.TlsDir)
-	bs, err := ioutil.ReadFile(filepath.Join(tlsPath, "cert.crt"))
-	if err != nil {
-		return nil, err
+func (t *RpcServMG) newServHD(isTls bool, allowMethods []string) (*grpc.Server, error) {
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		scom.AllowMethodsInterceptor(allowMethods),
+		scom.UnixSocketOnlyInterceptor(t.scfg.UnixSocketOnlyMethods),
+		t.rpcServ.UnaryInterceptor(),
+		gpromeus.UnaryServerInterceptor,
 	}
-	certPool := x509.NewCertPool()
-	ok := certPool.AppendCertsFromPEM(bs)
-	if !ok {
-		return nil, err
+	rpcOptions := []grpc.ServerOption{
+		middleware.WithUnaryServerChain(unaryInterceptors...),
+		grpc.MaxRecvMsgSize(t.scfg.MaxMsgSize),
+		grpc.ReadBufferSize(t.scfg.ReadBufSize),
+		grpc.InitialWindowSize(t.scfg.InitWindowSize),
+		grpc.InitialConnWindowSize(t.scfg.InitConnWindowSize),
+		grpc.WriteBufferSize(t.scfg.WriteBufSize),
 	}
-	certificate, err := tls.LoadX509KeyPair(filepath.Join(tlsPath, "key.pem"),
-		filepath.Join(tlsPath, "private.key"))
-	if err != nil {
-		return nil, err
+	if isTls {
+		tlsConf, err := scom.NewTlsConfig(t.engine.Context().EnvCfg, t.scfg.TlsServerName)
+		if err != nil {
+			return nil, err
+		}
+		rpcOptions = append(rpcOptions, grpc.Creds(credentials.NewTLS(tlsConf)))
 	}
 
-	creds := credentials.NewTLS(&tls.Config{
-		ServerName:   t.scfg.TlsServerName,
-		Certificates: []tls.Certificate{certificate},
-		RootCAs:      certPool,
-		ClientCAs:    certPool,
-		ClientAuth:   tls.RequireAndVerifyClientCert,
-	})
+	servHD := grpc.NewServer(rpcOptions...)
+	pb.RegisterXchainServer(servHD, t.rpcServ)
+	reflection.Register(servHD)
+	return servHD, nil
+}
+
+// 记录运行中的grpc server，已退出时返回false
+func (t *RpcServMG) setServHDs(servHDs []*grpc.Server) bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
 
-	return creds, nil
+	if t.isExit {
+		return false
+	}
+	t.servHDs = servHDs
+	return true
+}
+
+// 监听异常退出时关闭本次运行的grpc server，不标记退出，重启时可以重新Run
+func (t *RpcServMG) closeServHDs() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	for _, servHD := range t.servHDs {
+		servHD.Stop()
+	}
+	t.servHDs = nil
 }
 
 // 需要幂等
 func (t *RpcServMG) stopRpcServ() {
-	if t.servHD != nil {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.isExit = true
+	for _, servHD := range t.servHDs {
 		// 优雅关闭grpc server
-		t.servHD.GracefulStop()
+		servHD.GracefulStop()
 	}
 }
@@ -17,19 +17,32 @@ import (
 	"github.com/xuperchain/xupercore/lib/logs"
 	"github.com/xuperchain/xupercore/lib/utils"
 
+	sconf "github.com/xuperchain/xuperos/common/config"
 	"github.com/xuperchain/xuperos/common/xupospb/pb"
 	acom "github.com/xuperchain/xuperos/service/adapter/common"
+	scom "github.com/xuperchain/xuperos/service/common"
+	"github.com/xuperchain/xuperos/service/index"
 )
 
 type RpcServ struct {
-	engine ecom.Engine
-	log    logs.Logger
+	engine  ecom.Engine
+	indexer *index.Indexer
+	log     logs.Logger
+	// 主干深处已转换的区块和交易，未开启时为nil
+	blockCache *scom.QueryCache
+	txCache    *scom.QueryCache
 }
 
-func NewRpcServ(engine ecom.Engine, log logs.Logger) *RpcServ {
+func NewRpcServ(scfg *sconf.ServConf, engine ecom.Engine, indexer *index.Indexer,
+	log logs.Logger) *RpcServ {
 	return &RpcServ{
-		engine: engine,
-		log:    log,
+		engine:  engine,
+		indexer: indexer,
+		log:     log,
+		blockCache: scom.NewQueryCache("block", scfg.QueryCacheSize, scfg.QueryCacheTTL,
+			scfg.QueryCacheSafeDepth),
+		txCache: scom.NewQueryCache("tx", scfg.QueryCacheSize, scfg.QueryCacheTTL,
+			scfg.QueryCacheSafeDepth),
 	}
 }
 
@@ -137,6 +150,10 @@ func (t *RpcServ) getClietIP(gctx context.Context) (string, error) {
 	if pr.Addr == nil || pr.Addr == net.Addr(nil) {
 		return "", fmt.Errorf("get client_ip failed because peer.Addr is nil")
 	}
+	// unix socket对端地址为空，统一记为unix
+	if pr.Addr.Network() == "unix" {
+		return "unix", nil
+	}
 
 	addrSlice := strings.Split(pr.Addr.String(), ":")
 	return addrSlice[0], nil
@@ -0,0 +1,133 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+	"github.com/xuperchain/xupercore/lib/logs"
+	"github.com/xuperchain/xupercore/lib/utils"
+	"google.golang.org/grpc"
+
+	sconf "github.com/xuperchain/xuperos/common/config"
+	sctx "github.com/xuperchain/xuperos/common/context"
+	"github.com/xuperchain/xuperos/common/loglevel"
+	pb "github.com/xuperchain/xuperos/common/xupospb"
+	scom "github.com/xuperchain/xuperos/service/common"
+)
+
+// 节点管理接口实现
+type AdminServ struct {
+	engine    ecom.Engine
+	logLevels *loglevel.Levels
+	chainMG   *ChainManager
+	log       logs.Logger
+	// 最近一次加载的服务配置，只用于重新加载时对比变更
+	scfg     *sconf.ServConf
+	confLock sync.Mutex
+}
+
+func NewAdminServ(scfg *sconf.ServConf, engine ecom.Engine, logLevels *loglevel.Levels,
+	chainMG *ChainManager, log logs.Logger) *AdminServ {
+	return &AdminServ{
+		engine:    engine,
+		logLevels: logLevels,
+		chainMG:   chainMG,
+		log:       log,
+		scfg:      scfg,
+	}
+}
+
+// UnaryInterceptor 设置请求上下文和响应header，输出访问日志
+func (t *AdminServ) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (resp interface{}, err error) {
+
+		// panic recover
+		defer func() {
+			if e := recover(); e != nil {
+				t.log.Error("Admin server happen panic.", "error", e, "rpc_method", info.FullMethod)
+			}
+		}()
+
+		type HeaderInterface interface {
+			GetHeader() *pb.ReqHeader
+		}
+		reqHeader := req.(HeaderInterface).GetHeader()
+		logId := reqHeader.GetLogId()
+		if logId == "" {
+			logId = utils.GenLogId()
+		}
+
+		clientIp := "unix"
+		if !scom.IsUnixPeer(ctx) {
+			clientIp = "127.0.0.1"
+		}
+		reqCtx, err := sctx.NewReqCtx(t.engine, logId, clientIp)
+		if err != nil {
+			t.log.Error("access proc failed because create request context failed", "error", err)
+			return nil, fmt.Errorf("create request context failed")
+		}
+		ctx = sctx.WithReqCtx(ctx, reqCtx)
+
+		logFields := []interface{}{"from", reqHeader.GetSelfName(), "rpc_method", info.FullMethod}
+		reqCtx.GetLog().Trace("access admin request", logFields...)
+
+		// 根据err设置响应错误码，对外统一响应err=nil
+		stdErr := ecom.ErrSuccess
+		respRes, err := handler(ctx, req)
+		if err != nil {
+			stdErr = ecom.CastError(err)
+		}
+		header := reflect.ValueOf(respRes).Elem().FieldByName("Header")
+		if header.IsValid() && header.IsNil() && header.CanSet() {
+			header.Set(reflect.ValueOf(&pb.RespHeader{
+				LogId:   logId,
+				ErrCode: int64(stdErr.Code),
+				ErrMsg:  stdErr.Msg,
+			}))
+		}
+
+		logFields = append(logFields, "status", stdErr.Status, "err_code", stdErr.Code,
+			"err_msg", stdErr.Msg, "cost_time", reqCtx.GetTimer().Print())
+		reqCtx.GetLog().Info("admin request done", logFields...)
+
+		return respRes, nil
+	}
+}
+
+// 对比新旧配置，返回已生效和需要重启生效的配置项
+// 目前只有模块日志级别支持运行时生效
+func (t *AdminServ) applyConf(scfg *sconf.ServConf) ([]string, []string, error) {
+	t.confLock.Lock()
+	defer t.confLock.Unlock()
+
+	applied := make([]string, 0)
+	needRestart := make([]string, 0)
+	oldVal, newVal := reflect.ValueOf(t.scfg).Elem(), reflect.ValueOf(scfg).Elem()
+	for i := 0; i < newVal.NumField(); i++ {
+		field := newVal.Type().Field(i)
+		if reflect.DeepEqual(oldVal.Field(i).Interface(), newVal.Field(i).Interface()) {
+			continue
+		}
+
+		name := strings.Split(field.Tag.Get("yaml"), ",")[0]
+		if field.Name != "LogLevels" {
+			needRestart = append(needRestart, name)
+			continue
+		}
+		if t.logLevels == nil {
+			return nil, nil, fmt.Errorf("log filter not installed")
+		}
+		if err := t.logLevels.Reset(scfg.LogLevels); err != nil {
+			return nil, nil, err
+		}
+		applied = append(applied, name)
+	}
+
+	t.scfg = scfg
+	return applied, needRestart, nil
+}
@@ -0,0 +1,273 @@
+package admin
+
+import (
+	"bytes"
+	"context"
+	"runtime"
+	"runtime/pprof"
+	"sort"
+
+	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+
+	sconf "github.com/xuperchain/xuperos/common/config"
+	sctx "github.com/xuperchain/xuperos/common/context"
+	"github.com/xuperchain/xuperos/common/version"
+	pb "github.com/xuperchain/xuperos/common/xupospb"
+)
+
+// 注意：与rpc服务约定一致，响应resp不能为nil，err必须为ecom.Error类型的标准错误
+
+// 查询模块日志级别
+func (t *AdminServ) ListLogLevels(gctx context.Context, req *pb.BaseReq) (*pb.LogLevelsResp, error) {
+	resp := &pb.LogLevelsResp{}
+	rctx := sctx.ValueReqCtx(gctx)
+
+	if t.logLevels == nil {
+		rctx.GetLog().Warn("log filter not installed")
+		return resp, ecom.ErrForbidden.More("log filter not installed")
+	}
+
+	levels := t.logLevels.List()
+	modules := make([]string, 0, len(levels))
+	for module := range levels {
+		modules = append(modules, module)
+	}
+	sort.Strings(modules)
+
+	resp.DefaultLevel = t.logLevels.DefaultLevel()
+	resp.Levels = make([]*pb.LogLevel, 0, len(modules))
+	for _, module := range modules {
+		resp.Levels = append(resp.Levels, &pb.LogLevel{Module: module, Level: levels[module]})
+	}
+	return resp, nil
+}
+
+// 设置模块日志级别，重启或重新加载配置后恢复为配置值
+func (t *AdminServ) SetLogLevel(gctx context.Context, req *pb.SetLogLevelReq) (*pb.BaseResp, error) {
+	resp := &pb.BaseResp{}
+	rctx := sctx.ValueReqCtx(gctx)
+
+	if req == nil || req.GetModule() == "" {
+		rctx.GetLog().Warn("param error,some param unset")
+		return resp, ecom.ErrParameter
+	}
+	if t.logLevels == nil {
+		rctx.GetLog().Warn("log filter not installed")
+		return resp, ecom.ErrForbidden.More("log filter not installed")
+	}
+
+	if err := t.logLevels.Set(req.GetModule(), req.GetLevel()); err != nil {
+		rctx.GetLog().Warn("set log level failed", "err", err)
+		return resp, ecom.ErrParameter.More("%v", err)
+	}
+
+	rctx.GetLog().SetInfoField("module", req.GetModule())
+	rctx.GetLog().SetInfoField("level", req.GetLevel())
+	return resp, nil
+}
+
+// 导出运行时profile
+func (t *AdminServ) DumpProfile(gctx context.Context, req *pb.ProfileReq) (*pb.ProfileResp, error) {
+	resp := &pb.ProfileResp{}
+	rctx := sctx.ValueReqCtx(gctx)
+
+	if req == nil || req.GetName() == "" {
+		rctx.GetLog().Warn("param error,some param unset")
+		return resp, ecom.ErrParameter
+	}
+	profile := pprof.Lookup(req.GetName())
+	if profile == nil {
+		rctx.GetLog().Warn("profile not exist", "name", req.GetName())
+		return resp, ecom.ErrParameter.More("profile not exist")
+	}
+
+	var buf bytes.Buffer
+	if err := profile.WriteTo(&buf, int(req.GetDebug())); err != nil {
+		rctx.GetLog().Warn("write profile failed", "name", req.GetName(), "err", err)
+		return resp, ecom.ErrInternal
+	}
+	resp.Data = buf.Bytes()
+
+	rctx.GetLog().SetInfoField("name", req.GetName())
+	rctx.GetLog().SetInfoField("size", len(resp.Data))
+	return resp, nil
+}
+
+// 查询本节点和已连接节点
+func (t *AdminServ) ListPeers(gctx context.Context, req *pb.BaseReq) (*pb.PeersResp, error) {
+	resp := &pb.PeersResp{}
+	rctx := sctx.ValueReqCtx(gctx)
+
+	netHD := t.engine.Context().Net
+	if netHD == nil {
+		rctx.GetLog().Warn("network not init")
+		return resp, ecom.ErrInternal
+	}
+
+	info := netHD.PeerInfo()
+	resp.Local = &pb.PeerInfo{Id: info.Id, Address: info.Address, Account: info.Account}
+	resp.Peers = make([]*pb.PeerInfo, 0, len(info.Peer))
+	for _, peer := range info.Peer {
+		resp.Peers = append(resp.Peers, &pb.PeerInfo{
+			Id:      peer.GetId(),
+			Address: peer.GetAddress(),
+			Account: peer.GetAccount(),
+		})
+	}
+
+	rctx.GetLog().SetInfoField("peer_cnt", len(resp.Peers))
+	return resp, nil
+}
+
+// 连接指定地址的节点，通过网络组件向对端发送握手消息建立连接
+func (t *AdminServ) ConnectPeer(gctx context.Context, req *pb.PeerReq) (*pb.PeersResp, error) {
+	resp := &pb.PeersResp{}
+	rctx := sctx.ValueReqCtx(gctx)
+
+	if req == nil || req.GetPeer() == "" {
+		rctx.GetLog().Warn("param error,some param unset")
+		return resp, ecom.ErrParameter
+	}
+	netHD := t.engine.Context().Net
+	if netHD == nil {
+		rctx.GetLog().Warn("network not init")
+		return resp, ecom.ErrInternal
+	}
+
+	peers, err := connectPeer(rctx, netHD, t.engine.Context().EngCfg.RootChain, req.GetPeer())
+	if err != nil {
+		rctx.GetLog().Warn("connect peer failed", "peer", req.GetPeer(), "err", err)
+		return resp, ecom.ErrInternal.More("%v", err)
+	}
+	info := netHD.PeerInfo()
+	resp.Local = &pb.PeerInfo{Id: info.Id, Address: info.Address, Account: info.Account}
+	resp.Peers = peers
+
+	rctx.GetLog().SetInfoField("peer", req.GetPeer())
+	return resp, nil
+}
+
+// 断开指定id的节点，需要网络组件实现PeerDisconnector
+func (t *AdminServ) DisconnectPeer(gctx context.Context, req *pb.PeerReq) (*pb.BaseResp, error) {
+	resp := &pb.BaseResp{}
+	rctx := sctx.ValueReqCtx(gctx)
+
+	if req == nil || req.GetPeer() == "" {
+		rctx.GetLog().Warn("param error,some param unset")
+		return resp, ecom.ErrParameter
+	}
+	disconnector, ok := t.engine.Context().Net.(PeerDisconnector)
+	if !ok {
+		rctx.GetLog().Warn("network not support disconnect peer")
+		return resp, ecom.ErrForbidden.More("network not support disconnect peer")
+	}
+	if err := disconnector.DisconnectPeer(req.GetPeer()); err != nil {
+		rctx.GetLog().Warn("disconnect peer failed", "peer", req.GetPeer(), "err", err)
+		return resp, ecom.ErrInternal.More("%v", err)
+	}
+
+	rctx.GetLog().SetInfoField("peer", req.GetPeer())
+	return resp, nil
+}
+
+// 重新加载服务配置，不支持运行时生效的变更通过need_restart返回
+func (t *AdminServ) ReloadConfig(gctx context.Context, req *pb.BaseReq) (*pb.ReloadConfigResp, error) {
+	resp := &pb.ReloadConfigResp{}
+	rctx := sctx.ValueReqCtx(gctx)
+
+	envCfg := t.engine.Context().EnvCfg
+	scfg, err := sconf.LoadServConf(envCfg.GenConfFilePath(envCfg.ServConf))
+	if err != nil {
+		rctx.GetLog().Warn("load server config failed", "err", err)
+		return resp, ecom.ErrParameter.More("%v", err)
+	}
+
+	resp.Applied, resp.NeedRestart, err = t.applyConf(scfg)
+	if err != nil {
+		rctx.GetLog().Warn("apply server config failed", "err", err)
+		return resp, ecom.ErrParameter.More("%v", err)
+	}
+
+	rctx.GetLog().SetInfoField("applied", resp.Applied)
+	rctx.GetLog().SetInfoField("need_restart", resp.NeedRestart)
+	return resp, nil
+}
+
+// 查询编译信息
+func (t *AdminServ) GetBuildInfo(gctx context.Context, req *pb.BaseReq) (*pb.BuildInfoResp, error) {
+	resp := &pb.BuildInfoResp{
+		Version:   version.GetVersion(),
+		CommitId:  version.CommitID,
+		BuildTime: version.BuildTime,
+		GoVersion: runtime.Version(),
+	}
+	return resp, nil
+}
+
+// 查询数据目录下所有链的状态
+func (t *AdminServ) ListChains(gctx context.Context, req *pb.BaseReq) (*pb.ChainsResp, error) {
+	resp := &pb.ChainsResp{}
+	rctx := sctx.ValueReqCtx(gctx)
+
+	if t.chainMG == nil {
+		rctx.GetLog().Warn("chain manager not init")
+		return resp, ecom.ErrForbidden.More("chain manager not init")
+	}
+
+	chains, err := t.chainMG.List()
+	if err != nil {
+		rctx.GetLog().Warn("list chains failed", "err", err)
+		return resp, ecom.ErrInternal
+	}
+	resp.Chains = chains
+
+	rctx.GetLog().SetInfoField("chain_cnt", len(resp.Chains))
+	return resp, nil
+}
+
+// 加载新创建的链
+func (t *AdminServ) LoadChain(gctx context.Context, req *pb.ChainReq) (*pb.BaseResp, error) {
+	return t.manageChain(gctx, req, "load")
+}
+
+// 停止链的同步和出块
+func (t *AdminServ) StopChain(gctx context.Context, req *pb.ChainReq) (*pb.BaseResp, error) {
+	return t.manageChain(gctx, req, "stop")
+}
+
+// 停止并卸载链
+func (t *AdminServ) UnloadChain(gctx context.Context, req *pb.ChainReq) (*pb.BaseResp, error) {
+	return t.manageChain(gctx, req, "unload")
+}
+
+func (t *AdminServ) manageChain(gctx context.Context, req *pb.ChainReq, op string) (*pb.BaseResp, error) {
+	resp := &pb.BaseResp{}
+	rctx := sctx.ValueReqCtx(gctx)
+
+	if req == nil || req.GetBcName() == "" {
+		rctx.GetLog().Warn("param error,some param unset")
+		return resp, ecom.ErrParameter
+	}
+	if t.chainMG == nil {
+		rctx.GetLog().Warn("chain manager not init")
+		return resp, ecom.ErrForbidden.More("chain manager not init")
+	}
+
+	var err error
+	switch op {
+	case "load":
+		err = t.chainMG.Load(req.GetBcName())
+	case "stop":
+		err = t.chainMG.Stop(req.GetBcName())
+	case "unload":
+		err = t.chainMG.Unload(req.GetBcName())
+	}
+	if err != nil {
+		rctx.GetLog().Warn("manage chain failed", "bc_name", req.GetBcName(), "op", op, "err", err)
+		return resp, err
+	}
+
+	rctx.GetLog().SetInfoField("bc_name", req.GetBcName())
+	rctx.GetLog().SetInfoField("op", op)
+	return resp, nil
+}
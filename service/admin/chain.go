@@ -0,0 +1,129 @@
+package admin
+
+import (
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"sync"
+	"time"
+
+	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+	"github.com/xuperchain/xupercore/lib/logs"
+
+	"github.com/xuperchain/xuperos/common/chainmgr"
+	pb "github.com/xuperchain/xuperos/common/xupospb"
+)
+
+// 卸载链时等待使用中的请求结束的最长时间，超时后存储在请求结束后关闭
+var unloadTimeout = 30 * time.Second
+
+// ChainManager 运行时加载、停止和卸载平行链
+type ChainManager struct {
+	engine *chainmgr.Engine
+	log    logs.Logger
+	lock   sync.Mutex
+	// 已停止的链
+	stopped map[string]bool
+}
+
+func NewChainManager(engine *chainmgr.Engine, log logs.Logger) *ChainManager {
+	return &ChainManager{
+		engine:  engine,
+		log:     log,
+		stopped: make(map[string]bool),
+	}
+}
+
+// List 返回数据目录下和已加载的链，按链名排序
+func (t *ChainManager) List() ([]*pb.ChainStatus, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	names := make(map[string]bool)
+	envCfg := t.engine.Context().EnvCfg
+	dir, err := ioutil.ReadDir(envCfg.GenDataAbsPath(envCfg.ChainDir))
+	if err != nil {
+		return nil, fmt.Errorf("read blockchain data dir failed.err:%v", err)
+	}
+	for _, fInfo := range dir {
+		if fInfo.IsDir() {
+			names[fInfo.Name()] = true
+		}
+	}
+	for _, name := range t.engine.GetChains() {
+		names[name] = true
+	}
+
+	res := make([]*pb.ChainStatus, 0, len(names))
+	for name := range names {
+		status := &pb.ChainStatus{
+			BcName: name,
+			State:  pb.ChainState_CHAIN_UNLOADED,
+			IsRoot: name == t.rootChain(),
+		}
+		if chain, release, err := t.engine.AcquireChain(name); err == nil {
+			status.State = pb.ChainState_CHAIN_RUNNING
+			if t.stopped[name] {
+				status.State = pb.ChainState_CHAIN_STOPPED
+			}
+			meta := chain.Context().Ledger.GetMeta()
+			status.TrunkHeight = meta.GetTrunkHeight()
+			status.TipBlockid = meta.GetTipBlockid()
+			release()
+		}
+		res = append(res, status)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].BcName < res[j].BcName
+	})
+
+	return res, nil
+}
+
+// Load 加载数据目录下的链并启动
+func (t *ChainManager) Load(bcName string) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	return t.engine.LoadChain(bcName)
+}
+
+// Stop 停止链的同步和出块，链仍然可以查询
+func (t *ChainManager) Stop(bcName string) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if bcName == t.rootChain() {
+		return ecom.ErrForbidden.More("root chain can not be stopped")
+	}
+	chain, release, err := t.engine.AcquireChain(bcName)
+	if err != nil {
+		return err
+	}
+	defer release()
+	if t.stopped[bcName] {
+		return nil
+	}
+
+	chain.Stop()
+	t.stopped[bcName] = true
+	t.log.Info("stop chain succ", "bcName", bcName)
+	return nil
+}
+
+// Unload 从引擎移除链，等待使用中的请求结束后关闭账本和状态机存储
+func (t *ChainManager) Unload(bcName string) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	err := t.engine.UnloadChain(bcName, unloadTimeout)
+	if err != nil && err != chainmgr.ErrChainInUse {
+		return err
+	}
+	delete(t.stopped, bcName)
+	return err
+}
+
+func (t *ChainManager) rootChain() string {
+	return t.engine.Context().EngCfg.RootChain
+}
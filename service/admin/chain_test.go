@@ -0,0 +1,137 @@
+package admin
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/utils"
+	"github.com/xuperchain/xupercore/kernel/common/xconfig"
+	"github.com/xuperchain/xupercore/kernel/engines"
+	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+	"github.com/xuperchain/xupercore/lib/logs"
+
+	"github.com/xuperchain/xuperos/common/chainmgr"
+	"github.com/xuperchain/xuperos/common/scaffold"
+	pb "github.com/xuperchain/xuperos/common/xupospb"
+
+	_ "github.com/xuperchain/xupercore/bcs/consensus/single"
+	_ "github.com/xuperchain/xupercore/bcs/contract/native"
+	_ "github.com/xuperchain/xupercore/bcs/contract/xvm"
+	_ "github.com/xuperchain/xupercore/bcs/network/p2pv1"
+	_ "github.com/xuperchain/xupercore/bcs/network/p2pv2"
+	_ "github.com/xuperchain/xupercore/kernel/contract/kernel"
+	_ "github.com/xuperchain/xupercore/kernel/contract/manager"
+	_ "github.com/xuperchain/xupercore/lib/crypto/client"
+	_ "github.com/xuperchain/xupercore/lib/storage/kvdb/leveldb"
+)
+
+func TestChainManager(t *testing.T) {
+	root, err := ioutil.TempDir("", "admin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	conf := scaffold.GetDefNodeConf()
+	conf.RootPath = root
+	if _, err := scaffold.InitNode(conf); err != nil {
+		t.Fatal(err)
+	}
+	envConf, err := xconfig.LoadEnvConf(filepath.Join(root, "conf", "env.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 加载链时xvm合约驱动需要找到wasm2c，测试不部署合约，使用空脚本代替
+	binDir := filepath.Join(root, "bin")
+	os.MkdirAll(binDir, 0755)
+	ioutil.WriteFile(filepath.Join(binDir, "wasm2c"), []byte("#!/bin/sh\nexit 1\n"), 0755)
+	oldPath := os.Getenv("PATH")
+	os.Setenv("PATH", binDir+string(os.PathListSeparator)+oldPath)
+	defer os.Setenv("PATH", oldPath)
+	genesis := scaffold.GenesisPath(root, "xuper")
+	for _, bcName := range []string{"xuper", "hello"} {
+		if err := utils.CreateLedger(bcName, genesis, envConf); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	bcEngine, err := engines.CreateBCEngine(ecom.BCEngineName, envConf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	engine, err := chainmgr.NewEngine(bcEngine)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer engine.Exit()
+	log, _ := logs.NewLogger("", "admin")
+	chainMG := NewChainManager(engine, log)
+
+	checkState := func(expect map[string]pb.ChainState) {
+		chains, err := chainMG.List()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, chain := range chains {
+			if chain.State != expect[chain.BcName] {
+				t.Fatalf("chain state error.bcName:%s,state:%v", chain.BcName, chain.State)
+			}
+		}
+	}
+	checkState(map[string]pb.ChainState{"xuper": pb.ChainState_CHAIN_RUNNING, "hello": pb.ChainState_CHAIN_UNLOADED})
+
+	if err := chainMG.Load("hello"); err != nil {
+		t.Fatal(err)
+	}
+	if err := chainMG.Load("hello"); err != ecom.ErrChainExist {
+		t.Fatalf("load loaded chain error.err:%v", err)
+	}
+	checkState(map[string]pb.ChainState{"xuper": pb.ChainState_CHAIN_RUNNING, "hello": pb.ChainState_CHAIN_RUNNING})
+	if err := chainMG.Unload("xuper"); err == nil {
+		t.Fatal("unload root chain should fail")
+	}
+
+	// 使用中的链卸载后不能再获取，存储在使用结束后关闭
+	unloadTimeout = 100 * time.Millisecond
+	chain, release, err := engine.AcquireChain("hello")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := chainMG.Unload("hello"); err != chainmgr.ErrChainInUse {
+		t.Fatalf("unload chain in use error.err:%v", err)
+	}
+	if _, _, err := engine.AcquireChain("hello"); err != ecom.ErrChainNotExist {
+		t.Fatalf("acquire unloaded chain error.err:%v", err)
+	}
+	ledger := chain.Context().Ledger
+	if _, err := ledger.QueryBlockByHeight(0); err != nil {
+		t.Fatalf("ledger closed while chain in use.err:%v", err)
+	}
+	release()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		if _, err := ledger.QueryBlockByHeight(0); err != nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("ledger not closed after chain released")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	checkState(map[string]pb.ChainState{"xuper": pb.ChainState_CHAIN_RUNNING, "hello": pb.ChainState_CHAIN_UNLOADED})
+
+	// 关闭后可以重新加载
+	if err := chainMG.Load("hello"); err != nil {
+		t.Fatal(err)
+	}
+	if err := chainMG.Stop("hello"); err != nil {
+		t.Fatal(err)
+	}
+	checkState(map[string]pb.ChainState{"xuper": pb.ChainState_CHAIN_RUNNING, "hello": pb.ChainState_CHAIN_STOPPED})
+	if err := chainMG.Unload("hello"); err != nil {
+		t.Fatal(err)
+	}
+}
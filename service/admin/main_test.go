@@ -0,0 +1,28 @@
+package admin
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/xuperchain/xupercore/lib/logs"
+)
+
+// 日志只能初始化一次，包内测试共用
+func TestMain(m *testing.M) {
+	dir, err := ioutil.TempDir("", "admin")
+	if err != nil {
+		panic(err)
+	}
+	logConfFile := filepath.Join(dir, "log.yaml")
+	err = ioutil.WriteFile(logConfFile, []byte("level: trace\nconsole: false\nfilename: test\n"), 0644)
+	if err != nil {
+		panic(err)
+	}
+	logs.InitLog(logConfFile, dir)
+
+	code := m.Run()
+	os.RemoveAll(dir)
+	os.Exit(code)
+}
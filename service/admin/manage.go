@@ -0,0 +1,212 @@
+package admin
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"path/filepath"
+	"sync"
+
+	"github.com/xuperchain/xupercore/kernel/engines"
+	"github.com/xuperchain/xupercore/kernel/engines/xuperos"
+	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+	"github.com/xuperchain/xupercore/lib/logs"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+
+	"github.com/xuperchain/xuperos/common/chainmgr"
+	sconf "github.com/xuperchain/xuperos/common/config"
+	"github.com/xuperchain/xuperos/common/def"
+	"github.com/xuperchain/xuperos/common/loglevel"
+	pb "github.com/xuperchain/xuperos/common/xupospb"
+	scom "github.com/xuperchain/xuperos/service/common"
+)
+
+// 管理服务启停控制
+type AdminServMG struct {
+	scfg      *sconf.ServConf
+	engine    ecom.Engine
+	log       logs.Logger
+	adminServ *AdminServ
+	servHD    *grpc.Server
+	lock      sync.Mutex
+	isExit    bool
+	isInit    bool
+	exitOnce  *sync.Once
+}
+
+func NewAdminServMG(scfg *sconf.ServConf, engine engines.BCEngine) (*AdminServMG, error) {
+	if scfg == nil || engine == nil {
+		return nil, fmt.Errorf("param error")
+	}
+	if scfg.AdminListenAddr != "" {
+		if err := CheckLoopbackAddr(scfg.AdminListenAddr); err != nil {
+			return nil, err
+		}
+	}
+	xosEngine, err := xuperos.EngineConvert(engine)
+	if err != nil {
+		return nil, fmt.Errorf("not xuperos engine")
+	}
+
+	log, _ := loglevel.NewLogger("", def.SubModName)
+	// 日志过滤安装失败不影响其他管理接口
+	envCfg := xosEngine.Context().EnvCfg
+	logLevels, err := loglevel.Install(envCfg.GenConfFilePath(envCfg.LogConf))
+	if err != nil {
+		log.Warn("install log filter failed", "err", err)
+	} else if err := logLevels.Reset(scfg.LogLevels); err != nil {
+		return nil, fmt.Errorf("log levels config error.err:%v", err)
+	}
+
+	mgEngine, ok := xosEngine.(*chainmgr.Engine)
+	if !ok {
+		return nil, fmt.Errorf("engine not support chain manage")
+	}
+	chainMG := NewChainManager(mgEngine, log)
+
+	obj := &AdminServMG{
+		scfg:      scfg,
+		engine:    xosEngine,
+		log:       log,
+		adminServ: NewAdminServ(scfg, xosEngine, logLevels, chainMG, log),
+		isInit:    true,
+		exitOnce:  &sync.Once{},
+	}
+
+	return obj, nil
+}
+
+// 启动管理服务
+func (t *AdminServMG) Run() error {
+	if !t.isInit {
+		return errors.New("AdminServMG not init")
+	}
+
+	err := t.runAdminServ()
+	if err != nil {
+		t.log.Error("admin server abnormal exit", "err", err)
+		return err
+	}
+
+	t.log.Trace("admin server exit")
+	return nil
+}
+
+// 退出管理服务，需要幂等
+func (t *AdminServMG) Exit() {
+	if !t.isInit {
+		return
+	}
+
+	t.exitOnce.Do(func() {
+		t.stopAdminServ()
+	})
+}
+
+// 所有监听共用一个grpc server，阻塞直到退出
+func (t *AdminServMG) runAdminServ() error {
+	listeners := make([]net.Listener, 0, 2)
+	closeListeners := func() {
+		for _, lis := range listeners {
+			lis.Close()
+		}
+	}
+
+	if t.scfg.AdminListenAddr != "" {
+		lis, err := net.Listen("tcp", t.scfg.AdminListenAddr)
+		if err != nil {
+			t.log.Error("failed to listen", "addr", t.scfg.AdminListenAddr, "err", err)
+			return fmt.Errorf("failed to listen")
+		}
+		listeners = append(listeners, lis)
+		t.log.Trace("admin server listen", "addr", t.scfg.AdminListenAddr)
+	}
+	if t.scfg.AdminUnixSocket != "" {
+		sockPath := t.scfg.AdminUnixSocket
+		if !filepath.IsAbs(sockPath) {
+			sockPath = t.engine.Context().EnvCfg.GenDirAbsPath(sockPath)
+		}
+		lis, err := scom.ListenUnix(sockPath, t.scfg.UnixSocketPerm)
+		if err != nil {
+			closeListeners()
+			t.log.Error("failed to listen unix socket", "path", sockPath, "err", err)
+			return fmt.Errorf("failed to listen unix socket")
+		}
+		listeners = append(listeners, lis)
+		t.log.Trace("admin server listen unix socket", "path", sockPath)
+	}
+	if len(listeners) == 0 {
+		return fmt.Errorf("no admin listener configured")
+	}
+
+	servHD := grpc.NewServer(grpc.UnaryInterceptor(t.adminServ.UnaryInterceptor()))
+	pb.RegisterXuperAdminServer(servHD, t.adminServ)
+	reflection.Register(servHD)
+	if !t.setServHD(servHD) {
+		closeListeners()
+		return nil
+	}
+
+	serves := make([]func() error, 0, len(listeners))
+	for i := range listeners {
+		lis := listeners[i]
+		serves = append(serves, func() error {
+			err := servHD.Serve(lis)
+			if err != nil && err != grpc.ErrServerStopped {
+				t.log.Error("failed to serve", "addr", lis.Addr().String(), "err", err.Error())
+				return err
+			}
+			return nil
+		})
+	}
+	return scom.ServeAll(serves, t.closeServHD)
+}
+
+func (t *AdminServMG) setServHD(servHD *grpc.Server) bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if t.isExit {
+		return false
+	}
+	t.servHD = servHD
+	return true
+}
+
+// 监听异常退出时关闭本次运行的grpc server，不标记退出，重启时可以重新Run
+func (t *AdminServMG) closeServHD() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if t.servHD != nil {
+		t.servHD.Stop()
+		t.servHD = nil
+	}
+}
+
+// 需要幂等
+func (t *AdminServMG) stopAdminServ() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.isExit = true
+	if t.servHD != nil {
+		t.servHD.GracefulStop()
+	}
+}
+
+// CheckLoopbackAddr 管理服务只允许监听本机回环地址
+func CheckLoopbackAddr(addr string) error {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return fmt.Errorf("admin listen address error.addr:%s,err:%v", addr, err)
+	}
+	if host == "localhost" {
+		return nil
+	}
+	if ip := net.ParseIP(host); ip == nil || !ip.IsLoopback() {
+		return fmt.Errorf("admin listen address must be loopback.addr:%s", addr)
+	}
+	return nil
+}
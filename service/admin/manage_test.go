@@ -0,0 +1,21 @@
+package admin
+
+import (
+	"testing"
+)
+
+func TestCheckLoopbackAddr(t *testing.T) {
+	for addr, ok := range map[string]bool{
+		"127.0.0.1:36901": true,
+		"localhost:36901": true,
+		"[::1]:36901":     true,
+		":36901":          false,
+		"0.0.0.0:36901":   false,
+		"10.0.0.1:36901":  false,
+		"127.0.0.1":       false,
+	} {
+		if err := CheckLoopbackAddr(addr); (err == nil) != ok {
+			t.Errorf("addr %s expect ok %v, err %v", addr, ok, err)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package admin
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/xuperchain/xupercore/bcs/network/p2pv1"
+	xctx "github.com/xuperchain/xupercore/kernel/common/xcontext"
+	"github.com/xuperchain/xupercore/kernel/network"
+	"github.com/xuperchain/xupercore/kernel/network/p2p"
+	npb "github.com/xuperchain/xupercore/protos"
+
+	pb "github.com/xuperchain/xuperos/common/xupospb"
+)
+
+// PeerDisconnector 网络组件可选实现的断开连接接口，内核网络组件目前没有提供，未实现时返回不支持
+type PeerDisconnector interface {
+	DisconnectPeer(peerId string) error
+}
+
+const (
+	// p2pv2按地址异步建立连接，首次发送时可能还没有完成连接
+	connectRetry    = 3
+	connectInterval = time.Second
+)
+
+// 向指定地址发送握手消息，网络组件发送时按地址建立连接
+// p2pv1与种子节点相同发送本节点信息，对端会把本节点加入动态节点
+// p2pv2连接后对端加入路由表，通过查询根链状态确认连接可用
+func connectPeer(ctx xctx.XContext, netHD network.Network, rootChain, addr string) ([]*pb.PeerInfo, error) {
+	if netHD.Context().P2PConf.Module == p2pv1.ServerName {
+		local := netHD.PeerInfo()
+		msg := p2p.NewMessage(npb.XuperMessage_GET_PEER_INFO, &local)
+		resps, err := netHD.SendMessageWithResponse(ctx, msg, p2p.WithAddresses([]string{addr}))
+		if err != nil {
+			return nil, err
+		}
+
+		peers := make([]*pb.PeerInfo, 0, len(resps))
+		for _, resp := range resps {
+			var info npb.PeerInfo
+			if err := p2p.Unmarshal(resp, &info); err != nil {
+				return nil, fmt.Errorf("unmarshal peer info failed.err:%v", err)
+			}
+			peers = append(peers, &pb.PeerInfo{Id: info.Id, Address: info.Address, Account: info.Account})
+		}
+		return peers, nil
+	}
+
+	peerId, err := p2p.GetPeerIDByAddress(addr)
+	if err != nil {
+		return nil, fmt.Errorf("peer address error.addr:%s,err:%v", addr, err)
+	}
+	msg := p2p.NewMessage(npb.XuperMessage_GET_BLOCKCHAINSTATUS, nil, p2p.WithBCName(rootChain))
+	for i := 0; ; i++ {
+		_, err = netHD.SendMessageWithResponse(ctx, msg, p2p.WithAddresses([]string{addr}))
+		if err == nil || i+1 >= connectRetry {
+			break
+		}
+		time.Sleep(connectInterval)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return []*pb.PeerInfo{{Id: peerId.Pretty(), Address: addr}}, nil
+}
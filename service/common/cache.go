@@ -0,0 +1,100 @@
+package common
+
+import (
+	"time"
+
+	prom "github.com/prometheus/client_golang/prometheus"
+	"github.com/xuperchain/xupercore/lib/cache"
+)
+
+// 查询缓存命中统计，命中率为hit/(hit+miss)
+var queryCacheCounter = prom.NewCounterVec(
+	prom.CounterOpts{
+		Name: "xuperos_query_cache_total",
+		Help: "service query cache lookups",
+	},
+	[]string{"cache", "result"})
+
+func init() {
+	prom.MustRegister(queryCacheCounter)
+}
+
+// QueryCache 缓存已转换的不可变查询结果
+// 距离主干顶端不超过safeDepth的数据可能被分叉回滚，不缓存，命中时也会重新校验
+type QueryCache struct {
+	name      string
+	lru       *cache.LRUCache
+	ttl       time.Duration
+	safeDepth int64
+}
+
+type queryCacheEntry struct {
+	value    interface{}
+	height   int64
+	expireAt time.Time
+}
+
+// NewQueryCache size不大于0时返回nil，nil缓存的所有操作都是空操作
+func NewQueryCache(name string, size int, ttl time.Duration, safeDepth int64) *QueryCache {
+	if size <= 0 {
+		return nil
+	}
+	if safeDepth < 0 {
+		safeDepth = 0
+	}
+
+	return &QueryCache{
+		name:      name,
+		lru:       cache.NewLRUCache(size),
+		ttl:       ttl,
+		safeDepth: safeDepth,
+	}
+}
+
+// Get 返回缓存值和对应区块高度，tipHeight为当前主干高度
+func (t *QueryCache) Get(key string, tipHeight int64) (interface{}, int64, bool) {
+	if t == nil {
+		return nil, 0, false
+	}
+
+	val, ok := t.lru.Get(key)
+	if !ok {
+		queryCacheCounter.WithLabelValues(t.name, "miss").Inc()
+		return nil, 0, false
+	}
+	entry := val.(*queryCacheEntry)
+	// 过期或者主干回退到缓存数据附近，淘汰
+	if (t.ttl > 0 && time.Now().After(entry.expireAt)) || !t.isSafe(entry.height, tipHeight) {
+		t.lru.Del(key)
+		queryCacheCounter.WithLabelValues(t.name, "miss").Inc()
+		return nil, 0, false
+	}
+
+	queryCacheCounter.WithLabelValues(t.name, "hit").Inc()
+	return entry.value, entry.height, true
+}
+
+// Add 只缓存已经足够深的数据，返回是否缓存
+func (t *QueryCache) Add(key string, value interface{}, height, tipHeight int64) bool {
+	if t == nil || !t.isSafe(height, tipHeight) {
+		return false
+	}
+
+	t.lru.Add(key, &queryCacheEntry{
+		value:    value,
+		height:   height,
+		expireAt: time.Now().Add(t.ttl),
+	})
+	return true
+}
+
+func (t *QueryCache) Len() int {
+	if t == nil {
+		return 0
+	}
+	return t.lru.Len()
+}
+
+func (t *QueryCache) isSafe(height, tipHeight int64) bool {
+	return height >= 0 && height <= tipHeight-t.safeDepth
+}
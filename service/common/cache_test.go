@@ -0,0 +1,55 @@
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestQueryCache(t *testing.T) {
+	var nilCache *QueryCache = NewQueryCache("test", 0, 0, 0)
+	if nilCache != nil || nilCache.Add("k", 1, 0, 100) || nilCache.Len() != 0 {
+		t.Fatal("expect disabled cache")
+	}
+	if _, _, ok := nilCache.Get("k", 100); ok {
+		t.Fatal("expect disabled cache miss")
+	}
+
+	qc := NewQueryCache("test", 2, time.Minute, 3)
+	// 距离顶端不足安全深度的数据不缓存
+	if qc.Add("near", "v", 98, 100) {
+		t.Error("expect near tip data not cached")
+	}
+	if !qc.Add("a", "va", 97, 100) || !qc.Add("b", "vb", 10, 100) {
+		t.Fatal("add failed")
+	}
+	if val, height, ok := qc.Get("a", 100); !ok || val != "va" || height != 97 {
+		t.Errorf("unexpected get result %v %d %v", val, height, ok)
+	}
+
+	// 容量满后淘汰最久未使用的数据
+	qc.Add("c", "vc", 11, 100)
+	if _, _, ok := qc.Get("b", 100); ok {
+		t.Error("expect b evicted")
+	}
+
+	// 主干回退后靠近顶端的数据失效
+	if _, _, ok := qc.Get("a", 99); ok || qc.Len() != 1 {
+		t.Errorf("expect a invalidated, len %d", qc.Len())
+	}
+
+	// 过期数据失效
+	qc.ttl = time.Nanosecond
+	qc.Add("d", "vd", 1, 100)
+	time.Sleep(time.Millisecond)
+	if _, _, ok := qc.Get("d", 100); ok {
+		t.Error("expect d expired")
+	}
+
+	hit := testutil.ToFloat64(queryCacheCounter.WithLabelValues("test", "hit"))
+	miss := testutil.ToFloat64(queryCacheCounter.WithLabelValues("test", "miss"))
+	if hit != 1 || miss != 3 {
+		t.Errorf("unexpected metrics hit %v miss %v", hit, miss)
+	}
+}
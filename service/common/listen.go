@@ -0,0 +1,95 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	UnixNetwork = "unix"
+)
+
+// ListenUnix 监听unix socket，并设置socket文件权限
+// 上次异常退出残留的socket文件会被清理
+func ListenUnix(path, perm string) (net.Listener, error) {
+	mode, err := strconv.ParseUint(perm, 8, 32)
+	if err != nil {
+		return nil, fmt.Errorf("unix socket perm set error.perm:%s", perm)
+	}
+
+	if fi, err := os.Stat(path); err == nil {
+		if fi.Mode()&os.ModeSocket == 0 {
+			return nil, fmt.Errorf("unix socket path exist and not a socket.path:%s", path)
+		}
+		if err := os.Remove(path); err != nil {
+			return nil, fmt.Errorf("remove stale unix socket failed.path:%s,err:%v", path, err)
+		}
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("create unix socket dir failed.path:%s,err:%v", path, err)
+	}
+
+	lis, err := net.Listen(UnixNetwork, path)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Chmod(path, os.FileMode(mode)); err != nil {
+		lis.Close()
+		return nil, fmt.Errorf("chmod unix socket failed.path:%s,err:%v", path, err)
+	}
+
+	return lis, nil
+}
+
+// IsUnixPeer 判断请求是否来自unix socket
+func IsUnixPeer(ctx context.Context) bool {
+	pr, ok := peer.FromContext(ctx)
+	if !ok || pr.Addr == nil {
+		return false
+	}
+	return pr.Addr.Network() == UnixNetwork
+}
+
+// UnixSocketOnlyInterceptor 限制指定方法只能通过unix socket访问
+// methods为grpc完整方法名，如/pb.Xchain/GetSystemStatus
+func UnixSocketOnlyInterceptor(methods []string) grpc.UnaryServerInterceptor {
+	unixOnly := make(map[string]bool, len(methods))
+	for _, method := range methods {
+		unixOnly[method] = true
+	}
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+		if unixOnly[info.FullMethod] && !IsUnixPeer(ctx) {
+			return nil, status.Errorf(codes.PermissionDenied,
+				"method %s only allowed through unix socket", info.FullMethod)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// UnixSocketOnlyStreamInterceptor 同UnixSocketOnlyInterceptor，用于流式接口
+func UnixSocketOnlyStreamInterceptor(methods []string) grpc.StreamServerInterceptor {
+	unixOnly := make(map[string]bool, len(methods))
+	for _, method := range methods {
+		unixOnly[method] = true
+	}
+
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler) error {
+		if unixOnly[info.FullMethod] && !IsUnixPeer(ss.Context()) {
+			return status.Errorf(codes.PermissionDenied,
+				"method %s only allowed through unix socket", info.FullMethod)
+		}
+		return handler(srv, ss)
+	}
+}
@@ -0,0 +1,104 @@
+package common
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnixSocket(t *testing.T) {
+	dir, err := ioutil.TempDir("", "unixsock")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	sockPath := filepath.Join(dir, "sock", "xchain.sock")
+	// 残留的socket文件可以被重新监听
+	for i := 0; i < 2; i++ {
+		lis, err := ListenUnix(sockPath, "0600")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if i == 0 {
+			lis.(interface{ SetUnlinkOnClose(bool) }).SetUnlinkOnClose(false)
+		}
+		lis.Close()
+	}
+
+	lis, err := ListenUnix(sockPath, "0600")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fi, err := os.Stat(sockPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fi.Mode().Perm() != 0600 {
+		t.Errorf("unexpected socket perm %o", fi.Mode().Perm())
+	}
+
+	method := "/grpc.health.v1.Health/Check"
+	streamMethod := "/grpc.health.v1.Health/Watch"
+	servHD := grpc.NewServer(grpc.UnaryInterceptor(UnixSocketOnlyInterceptor([]string{method})),
+		grpc.StreamInterceptor(UnixSocketOnlyStreamInterceptor([]string{streamMethod})))
+	healthpb.RegisterHealthServer(servHD, health.NewServer())
+	go servHD.Serve(lis)
+	defer servHD.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, "unix://"+sockPath, grpc.WithInsecure(), grpc.WithBlock())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	_, err = healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	watch, err := healthpb.NewHealthClient(conn).Watch(ctx, &healthpb.HealthCheckRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := watch.Recv(); err != nil {
+		t.Fatal(err)
+	}
+
+	// 非unix socket请求被拒绝
+	interceptor := UnixSocketOnlyInterceptor([]string{method})
+	_, err = interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: method},
+		func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil })
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("expect permission denied, actual %v", err)
+	}
+	streamInterceptor := UnixSocketOnlyStreamInterceptor([]string{streamMethod})
+	err = streamInterceptor(nil, &tcpStream{}, &grpc.StreamServerInfo{FullMethod: streamMethod},
+		func(srv interface{}, ss grpc.ServerStream) error { return nil })
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("expect stream permission denied, actual %v", err)
+	}
+
+	if _, err := ListenUnix(sockPath, "rw"); err == nil {
+		t.Errorf("expect perm error")
+	}
+}
+
+// 非unix socket的流
+type tcpStream struct {
+	grpc.ServerStream
+}
+
+func (s *tcpStream) Context() context.Context {
+	return context.Background()
+}
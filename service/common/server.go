@@ -0,0 +1,100 @@
+package common
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sync"
+
+	"github.com/xuperchain/xupercore/kernel/common/xconfig"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AllowMethodsInterceptor 限制监听只能访问指定方法，methods为空不限制
+func AllowMethodsInterceptor(methods []string) grpc.UnaryServerInterceptor {
+	allowed := make(map[string]bool, len(methods))
+	for _, method := range methods {
+		allowed[method] = true
+	}
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+		if len(allowed) > 0 && !allowed[info.FullMethod] {
+			return nil, status.Errorf(codes.PermissionDenied,
+				"method %s not allowed on this listener", info.FullMethod)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// AllowMethodsStreamInterceptor 同AllowMethodsInterceptor，用于流式接口
+func AllowMethodsStreamInterceptor(methods []string) grpc.StreamServerInterceptor {
+	allowed := make(map[string]bool, len(methods))
+	for _, method := range methods {
+		allowed[method] = true
+	}
+
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler) error {
+		if len(allowed) > 0 && !allowed[info.FullMethod] {
+			return status.Errorf(codes.PermissionDenied,
+				"method %s not allowed on this listener", info.FullMethod)
+		}
+		return handler(srv, ss)
+	}
+}
+
+// NewTlsConfig 加载节点tls证书，要求双向认证
+func NewTlsConfig(envConf *xconfig.EnvConf, serverName string) (*tls.Config, error) {
+	tlsPath := envConf.GenDataAbsPath(envConf.TlsDir)
+	bs, err := ioutil.ReadFile(filepath.Join(tlsPath, "cert.crt"))
+	if err != nil {
+		return nil, err
+	}
+	certPool := x509.NewCertPool()
+	ok := certPool.AppendCertsFromPEM(bs)
+	if !ok {
+		return nil, fmt.Errorf("append cert failed.path:%s", tlsPath)
+	}
+	certificate, err := tls.LoadX509KeyPair(filepath.Join(tlsPath, "key.pem"),
+		filepath.Join(tlsPath, "private.key"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		ServerName:   serverName,
+		Certificates: []tls.Certificate{certificate},
+		RootCAs:      certPool,
+		ClientCAs:    certPool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+	}, nil
+}
+
+// ServeAll 并发运行同一服务组件的所有监听，阻塞直到全部退出
+// 任一监听异常退出时调用stop关闭其余监听，返回第一个错误
+func ServeAll(serves []func() error, stop func()) error {
+	var wg sync.WaitGroup
+	var once sync.Once
+	var firstErr error
+	for _, serve := range serves {
+		wg.Add(1)
+		go func(serve func() error) {
+			defer wg.Done()
+			if err := serve(); err != nil {
+				once.Do(func() {
+					firstErr = err
+					stop()
+				})
+			}
+		}(serve)
+	}
+	wg.Wait()
+
+	return firstErr
+}
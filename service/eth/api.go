@@ -0,0 +1,478 @@
+package eth
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/burrow/crypto"
+	lpb "github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
+	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+	"github.com/xuperchain/xupercore/lib/utils"
+	"github.com/xuperchain/xupercore/protos"
+
+	sctx "github.com/xuperchain/xuperos/common/context"
+	"github.com/xuperchain/xuperos/common/evm"
+	"github.com/xuperchain/xuperos/models"
+)
+
+// 注意：
+// 1.地址统一按common/evm的规则在xchain地址、合约名、合约账户与EVM地址间转换
+// 2.区块哈希和交易哈希直接使用xchain的blockid和txid
+// 3.合约事件映射为log：address为合约名对应的EVM地址，topics[0]为keccak256(事件名)，data为事件内容
+
+const (
+	evmModuleName = "evm"
+	// eth_getLogs单次最多扫描的区块数
+	maxLogsBlockRange = 1000
+)
+
+// ChainId 返回配置的链ID
+func (t *EthServ) ChainId(rctx sctx.ReqCtx, params []json.RawMessage) (interface{}, error) {
+	return hexUint64(uint64(t.scfg.EthChainId)), nil
+}
+
+// NetVersion 返回网络ID，与链ID一致
+func (t *EthServ) NetVersion(rctx sctx.ReqCtx, params []json.RawMessage) (interface{}, error) {
+	return strconv.FormatInt(t.scfg.EthChainId, 10), nil
+}
+
+// Call 通过预执行调用evm合约，不上链
+func (t *EthServ) Call(rctx sctx.ReqCtx, params []json.RawMessage) (interface{}, error) {
+	// 区块参数忽略，始终基于最新状态预执行
+	var args callArgs
+	if len(params) < 1 || json.Unmarshal(params[0], &args) != nil {
+		return nil, newRpcError(errCodeInvalidParams, "invalid call arguments")
+	}
+	contractName, err := t.toContractName(args.To)
+	if err != nil {
+		return nil, newRpcError(errCodeInvalidParams, "invalid to address: %v", err)
+	}
+	if args.Data == "" {
+		args.Data = args.Input
+	}
+	input, err := decodeHex(args.Data)
+	if err != nil {
+		return nil, newRpcError(errCodeInvalidParams, "invalid call data")
+	}
+	initiator := ""
+	if args.From != "" {
+		initiator, err = t.toXchainAddress(args.From)
+		if err != nil {
+			return nil, newRpcError(errCodeInvalidParams, "invalid from address: %v", err)
+		}
+	}
+
+	req := &protos.InvokeRequest{
+		ModuleName:   evmModuleName,
+		ContractName: contractName,
+		MethodName:   t.methodName(input),
+		Args: map[string][]byte{
+			"input":       input,
+			"jsonEncoded": []byte("false"),
+		},
+	}
+	handle, err := models.NewChainHandle(t.scfg.EthBcName, rctx)
+	if err != nil {
+		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
+		return nil, err
+	}
+	defer handle.Release()
+	res, err := handle.PreExec([]*protos.InvokeRequest{req}, initiator, nil)
+	rctx.GetLog().SetInfoField("contract_name", contractName)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := res.GetResponses()
+	if len(responses) == 0 {
+		return hexBytes(nil), nil
+	}
+	last := responses[len(responses)-1]
+	if last.GetStatus() >= ecom.ErrStatusRefused {
+		return nil, &rpcError{
+			Code:    errCodeReverted,
+			Message: "execution reverted: " + last.GetMessage(),
+			Data:    hexBytes(last.GetBody()),
+		}
+	}
+	return hexBytes(last.GetBody()), nil
+}
+
+// GetTransactionReceipt 查询交易回执，交易未确认时返回null
+func (t *EthServ) GetTransactionReceipt(rctx sctx.ReqCtx, params []json.RawMessage) (interface{}, error) {
+	txId, err := t.parseHash(params)
+	if err != nil {
+		return nil, err
+	}
+
+	handle, err := models.NewChainHandle(t.scfg.EthBcName, rctx)
+	if err != nil {
+		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
+		return nil, err
+	}
+	defer handle.Release()
+	txInfo, err := handle.QueryTx(txId)
+	if err == ecom.ErrTxNotExist {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if txInfo.GetStatus() != lpb.TransactionStatus_TX_CONFIRM {
+		return nil, nil
+	}
+	blkInfo, err := handle.QueryBlock(txInfo.GetTx().GetBlockid(), true)
+	if err != nil {
+		return nil, err
+	}
+
+	blk := blkInfo.GetBlock()
+	for idx, tx := range blk.GetTransactions() {
+		if string(tx.GetTxid()) != string(txId) {
+			continue
+		}
+
+		logs := make([]*rpcLog, 0)
+		blkLogs, err := t.blockLogs(blk)
+		if err != nil {
+			return nil, err
+		}
+		for _, log := range blkLogs {
+			if log.TransactionHash == hexBytes(txId) {
+				logs = append(logs, log)
+			}
+		}
+
+		status := "0x1"
+		if _, ok := blk.GetFailedTxs()[utils.F(txId)]; ok {
+			status = "0x0"
+		}
+		to, _, _ := t.txTo(tx)
+		return &rpcReceipt{
+			TransactionHash:   hexBytes(txId),
+			TransactionIndex:  hexUint64(uint64(idx)),
+			BlockHash:         hexBytes(blk.GetBlockid()),
+			BlockNumber:       hexUint64(uint64(blk.GetHeight())),
+			From:              t.toEVMAddress(tx.GetInitiator()),
+			To:                to,
+			CumulativeGasUsed: hexUint64(0),
+			GasUsed:           hexUint64(0),
+			Logs:              logs,
+			LogsBloom:         zeroBloom,
+			Status:            status,
+		}, nil
+	}
+
+	return nil, nil
+}
+
+// GetBlockByNumber 按高度查询主干区块，区块不存在时返回null
+func (t *EthServ) GetBlockByNumber(rctx sctx.ReqCtx, params []json.RawMessage) (interface{}, error) {
+	var tag string
+	var fullTx bool
+	if len(params) < 1 || json.Unmarshal(params[0], &tag) != nil {
+		return nil, newRpcError(errCodeInvalidParams, "invalid block number")
+	}
+	if len(params) > 1 && json.Unmarshal(params[1], &fullTx) != nil {
+		return nil, newRpcError(errCodeInvalidParams, "invalid full transactions flag")
+	}
+
+	handle, err := models.NewChainHandle(t.scfg.EthBcName, rctx)
+	if err != nil {
+		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
+		return nil, err
+	}
+	defer handle.Release()
+	height, err := t.parseBlockNumber(handle, tag)
+	if err != nil {
+		return nil, err
+	}
+	blkInfo, err := handle.QueryBlockByHeight(height, true)
+	if err != nil {
+		return nil, err
+	}
+	if blkInfo.GetStatus() == lpb.BlockStatus_BLOCK_NOEXIST || blkInfo.GetBlock() == nil {
+		return nil, nil
+	}
+
+	return t.convertBlock(blkInfo.GetBlock(), fullTx), nil
+}
+
+// GetLogs 按区块范围扫描合约事件
+func (t *EthServ) GetLogs(rctx sctx.ReqCtx, params []json.RawMessage) (interface{}, error) {
+	var args filterArgs
+	if len(params) < 1 || json.Unmarshal(params[0], &args) != nil {
+		return nil, newRpcError(errCodeInvalidParams, "invalid filter")
+	}
+	addrs, err := parseStringOrArray(args.Address)
+	if err != nil {
+		return nil, newRpcError(errCodeInvalidParams, "invalid filter address")
+	}
+	addrSet := make(map[string]bool, len(addrs))
+	for _, addr := range addrs {
+		addrSet[strings.ToLower(addr)] = true
+	}
+	topicSet := make(map[string]bool)
+	for i, raw := range args.Topics {
+		topics, err := parseStringOrArray(raw)
+		if err != nil {
+			return nil, newRpcError(errCodeInvalidParams, "invalid filter topics")
+		}
+		// 事件只映射出一个topic，其余位置有过滤条件时不可能命中
+		if i > 0 && len(topics) > 0 {
+			return []*rpcLog{}, nil
+		}
+		for _, topic := range topics {
+			topicSet[strings.ToLower(topic)] = true
+		}
+	}
+
+	handle, err := models.NewChainHandle(t.scfg.EthBcName, rctx)
+	if err != nil {
+		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
+		return nil, err
+	}
+	defer handle.Release()
+	blocks := make([]*lpb.InternalBlock, 0)
+	if args.BlockHash != "" {
+		blkId, err := decodeHex(args.BlockHash)
+		if err != nil {
+			return nil, newRpcError(errCodeInvalidParams, "invalid block hash")
+		}
+		blkInfo, err := handle.QueryBlock(blkId, true)
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, blkInfo.GetBlock())
+	} else {
+		from, err := t.parseBlockNumber(handle, args.FromBlock)
+		if err != nil {
+			return nil, err
+		}
+		to, err := t.parseBlockNumber(handle, args.ToBlock)
+		if err != nil {
+			return nil, err
+		}
+		if to-from+1 > maxLogsBlockRange {
+			return nil, newRpcError(errCodeInvalidParams, "block range exceeds limit %d", maxLogsBlockRange)
+		}
+		for height := from; height <= to; height++ {
+			blkInfo, err := handle.QueryBlockByHeight(height, true)
+			if err != nil {
+				return nil, err
+			}
+			if blkInfo.GetStatus() == lpb.BlockStatus_BLOCK_NOEXIST {
+				break
+			}
+			blocks = append(blocks, blkInfo.GetBlock())
+		}
+	}
+
+	logs := make([]*rpcLog, 0)
+	for _, blk := range blocks {
+		blkLogs, err := t.blockLogs(blk)
+		if err != nil {
+			return nil, err
+		}
+		for _, log := range blkLogs {
+			if len(addrSet) > 0 && !addrSet[log.Address] {
+				continue
+			}
+			if len(topicSet) > 0 && !topicSet[log.Topics[0]] {
+				continue
+			}
+			logs = append(logs, log)
+		}
+	}
+	rctx.GetLog().SetInfoField("block_count", len(blocks))
+	return logs, nil
+}
+
+// 解析区块高度参数，支持latest/pending/earliest和hex高度
+func (t *EthServ) parseBlockNumber(handle *models.ChainHandle, tag string) (int64, error) {
+	switch tag {
+	case "", "latest", "pending":
+		status, err := handle.QueryChainStatus()
+		if err != nil {
+			return 0, err
+		}
+		return status.GetLedgerMeta().GetTrunkHeight(), nil
+	case "earliest":
+		return 0, nil
+	}
+
+	height, err := decodeHexUint64(tag)
+	if err != nil || height > math.MaxInt64 {
+		return 0, newRpcError(errCodeInvalidParams, "invalid block number %s", tag)
+	}
+	return int64(height), nil
+}
+
+func (t *EthServ) parseHash(params []json.RawMessage) ([]byte, error) {
+	var str string
+	if len(params) < 1 || json.Unmarshal(params[0], &str) != nil {
+		return nil, newRpcError(errCodeInvalidParams, "invalid hash")
+	}
+	hash, err := decodeHex(str)
+	if err != nil || len(hash) == 0 {
+		return nil, newRpcError(errCodeInvalidParams, "invalid hash")
+	}
+	return hash, nil
+}
+
+func (t *EthServ) convertBlock(blk *lpb.InternalBlock, fullTx bool) *rpcBlock {
+	txs := make([]interface{}, 0, len(blk.GetTransactions()))
+	for idx, tx := range blk.GetTransactions() {
+		if fullTx {
+			txs = append(txs, t.convertTx(blk, idx, tx))
+		} else {
+			txs = append(txs, hexBytes(tx.GetTxid()))
+		}
+	}
+
+	return &rpcBlock{
+		Number:           hexUint64(uint64(blk.GetHeight())),
+		Hash:             hexBytes(blk.GetBlockid()),
+		ParentHash:       hexBytes(blk.GetPreHash()),
+		Nonce:            hexBytes(make([]byte, 8)),
+		Sha3Uncles:       emptyUncleHash,
+		LogsBloom:        zeroBloom,
+		TransactionsRoot: hexBytes(blk.GetMerkleRoot()),
+		StateRoot:        zeroHash,
+		ReceiptsRoot:     zeroHash,
+		Miner:            t.toEVMAddress(string(blk.GetProposer())),
+		Difficulty:       hexUint64(0),
+		TotalDifficulty:  hexUint64(0),
+		ExtraData:        hexBytes(nil),
+		Size:             hexUint64(uint64(proto.Size(blk))),
+		GasLimit:         hexUint64(0),
+		GasUsed:          hexUint64(0),
+		// xchain区块时间戳单位为纳秒
+		Timestamp:    hexUint64(uint64(blk.GetTimestamp() / 1e9)),
+		Transactions: txs,
+		Uncles:       []string{},
+	}
+}
+
+func (t *EthServ) convertTx(blk *lpb.InternalBlock, idx int, tx *lpb.Transaction) *rpcTransaction {
+	to, value, input := t.txTo(tx)
+	return &rpcTransaction{
+		Hash:             hexBytes(tx.GetTxid()),
+		Nonce:            hexUint64(0),
+		BlockHash:        hexBytes(blk.GetBlockid()),
+		BlockNumber:      hexUint64(uint64(blk.GetHeight())),
+		TransactionIndex: hexUint64(uint64(idx)),
+		From:             t.toEVMAddress(tx.GetInitiator()),
+		To:               to,
+		Value:            "0x" + value.Text(16),
+		Gas:              hexUint64(0),
+		GasPrice:         hexUint64(0),
+		Input:            hexBytes(input),
+	}
+}
+
+// 交易目标地址：优先取evm合约调用，其次取转账接收方
+func (t *EthServ) txTo(tx *lpb.Transaction) (*string, *big.Int, []byte) {
+	value := big.NewInt(0)
+	for _, req := range tx.GetContractRequests() {
+		if req.GetModuleName() != evmModuleName {
+			continue
+		}
+		addr, err := evm.ContractNameToEVMAddress(req.GetContractName())
+		if err != nil {
+			continue
+		}
+		if amount, ok := new(big.Int).SetString(req.GetAmount(), 10); ok {
+			value = amount
+		}
+		to := hexBytes(addr.Bytes())
+		return &to, value, req.GetArgs()["input"]
+	}
+
+	var to *string
+	var receiver string
+	for _, out := range tx.GetTxOutputs() {
+		toAddr := string(out.GetToAddr())
+		if toAddr == tx.GetInitiator() || (receiver != "" && toAddr != receiver) {
+			continue
+		}
+		if to == nil {
+			receiver = toAddr
+			addr := t.toEVMAddress(toAddr)
+			to = &addr
+		}
+		value.Add(value, new(big.Int).SetBytes(out.GetAmount()))
+	}
+	return to, value, nil
+}
+
+// 解析区块内所有成功交易的合约事件
+func (t *EthServ) blockLogs(blk *lpb.InternalBlock) ([]*rpcLog, error) {
+	logs := make([]*rpcLog, 0)
+	for idx, tx := range blk.GetTransactions() {
+		if _, ok := blk.GetFailedTxs()[utils.F(tx.GetTxid())]; ok {
+			continue
+		}
+		events, err := models.ParseContractEvents(tx)
+		if err != nil {
+			return nil, ecom.ErrInternal.More("parse contract events failed")
+		}
+		for _, event := range events {
+			addr, err := evm.ContractNameToEVMAddress(event.GetContract())
+			if err != nil {
+				addr = crypto.ZeroAddress
+			}
+			logs = append(logs, &rpcLog{
+				Address:          hexBytes(addr.Bytes()),
+				Topics:           []string{hexBytes(crypto.Keccak256([]byte(event.GetName())))},
+				Data:             hexBytes(event.GetBody()),
+				BlockNumber:      hexUint64(uint64(blk.GetHeight())),
+				TransactionHash:  hexBytes(tx.GetTxid()),
+				TransactionIndex: hexUint64(uint64(idx)),
+				BlockHash:        hexBytes(blk.GetBlockid()),
+				LogIndex:         hexUint64(uint64(len(logs))),
+			})
+		}
+	}
+	return logs, nil
+}
+
+// EVM地址转换为合约名，只有合约名地址可以被调用
+func (t *EthServ) toContractName(hexAddr string) (string, error) {
+	addr, err := decodeAddress(hexAddr)
+	if err != nil {
+		return "", err
+	}
+	return evm.EVMAddressToContractName(addr)
+}
+
+func (t *EthServ) toXchainAddress(hexAddr string) (string, error) {
+	addr, err := decodeAddress(hexAddr)
+	if err != nil {
+		return "", err
+	}
+	xAddr, _, err := evm.DetermineEVMAddress(addr, t.scfg.EthBcName)
+	return xAddr, err
+}
+
+// xchain地址转换为小写hex格式的EVM地址，无法转换时返回零地址
+func (t *EthServ) toEVMAddress(xAddr string) string {
+	addr, _, err := evm.DetermineXchainAddress(xAddr)
+	if err != nil {
+		return hexBytes(crypto.ZeroAddress.Bytes())
+	}
+	return hexBytes(addr.Bytes())
+}
+
+// evm合约根据input中的函数选择器分发调用，方法名只用于合约方法ACL校验
+func (t *EthServ) methodName(input []byte) string {
+	if len(input) < 4 {
+		return ""
+	}
+	return hex.EncodeToString(input[:4])
+}
@@ -0,0 +1,195 @@
+package eth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"path/filepath"
+	"sync"
+
+	"github.com/xuperchain/xupercore/kernel/engines"
+	"github.com/xuperchain/xupercore/kernel/engines/xuperos"
+	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+	"github.com/xuperchain/xupercore/lib/logs"
+
+	sconf "github.com/xuperchain/xuperos/common/config"
+	"github.com/xuperchain/xuperos/common/def"
+	"github.com/xuperchain/xuperos/common/loglevel"
+	scom "github.com/xuperchain/xuperos/service/common"
+)
+
+// 以太坊兼容json-rpc服务启停控制管理
+type EthServMG struct {
+	scfg     *sconf.ServConf
+	engine   ecom.Engine
+	log      logs.Logger
+	ethServ  *EthServ
+	servers  []*http.Server
+	lock     sync.Mutex
+	isExit   bool
+	isInit   bool
+	exitOnce *sync.Once
+}
+
+func NewEthServMG(scfg *sconf.ServConf, engine engines.BCEngine) (*EthServMG, error) {
+	if scfg == nil || engine == nil {
+		return nil, fmt.Errorf("param error")
+	}
+	xosEngine, err := xuperos.EngineConvert(engine)
+	if err != nil {
+		return nil, fmt.Errorf("not xuperos engine")
+	}
+
+	log, _ := loglevel.NewLogger("", def.SubModName)
+	obj := &EthServMG{
+		scfg:     scfg,
+		engine:   xosEngine,
+		log:      log,
+		ethServ:  NewEthServ(scfg, xosEngine, log),
+		isInit:   true,
+		exitOnce: &sync.Once{},
+	}
+
+	return obj, nil
+}
+
+// 启动eth json-rpc服务，阻塞运行
+func (t *EthServMG) Run() error {
+	if !t.isInit {
+		return errors.New("EthServMG not init")
+	}
+
+	t.log.Trace("run eth json-rpc server", "bcname", t.scfg.EthBcName)
+
+	// 启动eth json-rpc server，阻塞直到退出
+	err := t.runEthServ()
+	if err != nil {
+		t.log.Error("eth json-rpc server abnormal exit", "err", err)
+		return err
+	}
+
+	t.log.Trace("eth json-rpc server exit")
+	return nil
+}
+
+// 退出eth json-rpc服务，释放相关资源，需要幂等
+func (t *EthServMG) Exit() {
+	if !t.isInit {
+		return
+	}
+
+	t.exitOnce.Do(func() {
+		t.stopEthServ()
+	})
+}
+
+func (t *EthServMG) runEthServ() error {
+	listeners := make([]net.Listener, 0, len(t.scfg.EthListeners)+1)
+	servers := make([]*http.Server, 0, len(t.scfg.EthListeners)+1)
+	closeListeners := func() {
+		for _, lis := range listeners {
+			lis.Close()
+		}
+	}
+	for _, lc := range t.scfg.EthListeners {
+		server := &http.Server{
+			Addr:    lc.Addr(),
+			Handler: t.ethServ.AllowMethods(lc.AllowMethods),
+		}
+		if lc.Tls {
+			tlsConf, err := scom.NewTlsConfig(t.engine.Context().EnvCfg, t.scfg.TlsServerName)
+			if err != nil {
+				closeListeners()
+				return err
+			}
+			server.TLSConfig = tlsConf
+		}
+		lis, err := net.Listen("tcp", lc.Addr())
+		if err != nil {
+			closeListeners()
+			return err
+		}
+		listeners = append(listeners, lis)
+		servers = append(servers, server)
+		t.log.Trace("eth json-rpc server listen", "addr", lc.Addr(), "isTls", lc.Tls)
+	}
+
+	// 按配置监听unix socket，供本机工具访问
+	if t.scfg.EthUnixSocket != "" {
+		sockPath := t.scfg.EthUnixSocket
+		if !filepath.IsAbs(sockPath) {
+			sockPath = t.engine.Context().EnvCfg.GenDirAbsPath(sockPath)
+		}
+		lis, err := scom.ListenUnix(sockPath, t.scfg.UnixSocketPerm)
+		if err != nil {
+			closeListeners()
+			t.log.Error("failed to listen unix socket", "path", sockPath, "err", err)
+			return fmt.Errorf("failed to listen unix socket")
+		}
+		listeners = append(listeners, lis)
+		servers = append(servers, &http.Server{Handler: t.ethServ})
+		t.log.Trace("eth json-rpc server listen unix socket", "path", sockPath)
+	}
+
+	if len(servers) == 0 {
+		return fmt.Errorf("no eth json-rpc listener configured")
+	}
+	if !t.setServers(servers) {
+		closeListeners()
+		return nil
+	}
+
+	serves := make([]func() error, 0, len(servers))
+	for i := range servers {
+		lis, server := listeners[i], servers[i]
+		serves = append(serves, func() error {
+			var err error
+			if server.TLSConfig != nil {
+				err = server.ServeTLS(lis, "", "")
+			} else {
+				err = server.Serve(lis)
+			}
+			if err != http.ErrServerClosed {
+				return err
+			}
+			return nil
+		})
+	}
+	return scom.ServeAll(serves, t.closeServers)
+}
+
+// 记录运行中的http server，已退出时返回false
+func (t *EthServMG) setServers(servers []*http.Server) bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if t.isExit {
+		return false
+	}
+	t.servers = servers
+	return true
+}
+
+// 监听异常退出时关闭本次运行的http server，不标记退出，重启时可以重新Run
+func (t *EthServMG) closeServers() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	for _, server := range t.servers {
+		server.Close()
+	}
+	t.servers = nil
+}
+
+// 需要幂等
+func (t *EthServMG) stopEthServ() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.isExit = true
+	for _, server := range t.servers {
+		server.Shutdown(context.Background())
+	}
+}
@@ -0,0 +1,227 @@
+package eth
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net"
+	"net/http"
+	"runtime"
+
+	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+	"github.com/xuperchain/xupercore/lib/logs"
+	"github.com/xuperchain/xupercore/lib/utils"
+
+	sconf "github.com/xuperchain/xuperos/common/config"
+	sctx "github.com/xuperchain/xuperos/common/context"
+)
+
+const (
+	jsonRpcVersion = "2.0"
+)
+
+// json-rpc标准错误码
+const (
+	errCodeParse          = -32700
+	errCodeInvalidRequest = -32600
+	errCodeMethodNotFound = -32601
+	errCodeInvalidParams  = -32602
+	errCodeInternal       = -32603
+	errCodeServer         = -32000
+	errCodeReverted       = 3
+)
+
+type rpcRequest struct {
+	Version string            `json:"jsonrpc"`
+	Id      json.RawMessage   `json:"id"`
+	Method  string            `json:"method"`
+	Params  []json.RawMessage `json:"params"`
+}
+
+type rpcResponse struct {
+	Version string          `json:"jsonrpc"`
+	Id      json.RawMessage `json:"id"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+}
+
+type rpcError struct {
+	Code    int         `json:"code"`
+	Message string      `json:"message"`
+	Data    interface{} `json:"data,omitempty"`
+}
+
+func (e *rpcError) Error() string {
+	return e.Message
+}
+
+func newRpcError(code int, format string, args ...interface{}) *rpcError {
+	return &rpcError{Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+// 各json-rpc方法处理函数，返回的err为*rpcError时原样响应，否则统一转为服务端错误
+type methodHandler func(rctx sctx.ReqCtx, params []json.RawMessage) (interface{}, error)
+
+type EthServ struct {
+	scfg    *sconf.ServConf
+	engine  ecom.Engine
+	log     logs.Logger
+	methods map[string]methodHandler
+}
+
+func NewEthServ(scfg *sconf.ServConf, engine ecom.Engine, log logs.Logger) *EthServ {
+	obj := &EthServ{
+		scfg:   scfg,
+		engine: engine,
+		log:    log,
+	}
+	obj.methods = map[string]methodHandler{
+		"eth_chainId":               obj.ChainId,
+		"net_version":               obj.NetVersion,
+		"eth_call":                  obj.Call,
+		"eth_getTransactionReceipt": obj.GetTransactionReceipt,
+		"eth_getBlockByNumber":      obj.GetBlockByNumber,
+		"eth_getLogs":               obj.GetLogs,
+	}
+
+	return obj
+}
+
+// ServeHTTP 处理json-rpc请求，支持批量请求
+func (t *EthServ) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	t.serve(w, r, nil)
+}
+
+// AllowMethods 返回只允许访问指定json-rpc方法的handler，methods为空不限制
+func (t *EthServ) AllowMethods(methods []string) http.Handler {
+	if len(methods) == 0 {
+		return t
+	}
+
+	allowed := make(map[string]bool, len(methods))
+	for _, method := range methods {
+		allowed[method] = true
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.serve(w, r, allowed)
+	})
+}
+
+// allowed为nil不限制方法
+func (t *EthServ) serve(w http.ResponseWriter, r *http.Request, allowed map[string]bool) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, int64(t.scfg.MaxMsgSize)))
+	if err != nil {
+		http.Error(w, "read request body failed", http.StatusBadRequest)
+		return
+	}
+
+	var result interface{}
+	body = bytes.TrimSpace(body)
+	if len(body) > 0 && body[0] == '[' {
+		var reqs []json.RawMessage
+		if err := json.Unmarshal(body, &reqs); err != nil || len(reqs) == 0 {
+			result = t.errResponse(nil, newRpcError(errCodeParse, "parse batch request failed"))
+		} else {
+			resps := make([]*rpcResponse, 0, len(reqs))
+			for _, req := range reqs {
+				resps = append(resps, t.handle(r, req, allowed))
+			}
+			result = resps
+		}
+	} else {
+		result = t.handle(r, body, allowed)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
+
+func (t *EthServ) handle(r *http.Request, data []byte, allowed map[string]bool) (resp *rpcResponse) {
+	var req rpcRequest
+	if err := json.Unmarshal(data, &req); err != nil {
+		return t.errResponse(nil, newRpcError(errCodeParse, "parse request failed"))
+	}
+	if req.Version != jsonRpcVersion || req.Method == "" {
+		return t.errResponse(req.Id, newRpcError(errCodeInvalidRequest, "invalid request"))
+	}
+	handler, ok := t.methods[req.Method]
+	if !ok {
+		return t.errResponse(req.Id, newRpcError(errCodeMethodNotFound,
+			"the method %s does not exist/is not available", req.Method))
+	}
+	if allowed != nil && !allowed[req.Method] {
+		return t.errResponse(req.Id, newRpcError(errCodeMethodNotFound,
+			"the method %s is not allowed on this listener", req.Method))
+	}
+
+	// 创建请求上下文
+	rctx, err := sctx.NewReqCtx(t.engine, utils.GenLogId(), t.getClientIp(r))
+	if err != nil {
+		t.log.Error("create request context failed", "err", err)
+		return t.errResponse(req.Id, newRpcError(errCodeInternal, "create request context failed"))
+	}
+
+	// panic recover
+	defer func() {
+		if e := recover(); e != nil {
+			rctx.GetLog().Error("eth json-rpc server happen panic", "error", e)
+			stack := make([]byte, 8192)
+			n := runtime.Stack(stack[:], false)
+			log.Printf("%s eth json-rpc server happen panic: %s", rctx.GetLog().GetLogId(), stack[:n])
+			resp = t.errResponse(req.Id, newRpcError(errCodeInternal, "%s log_id = %s",
+				ecom.ErrInternal, rctx.GetLog().GetLogId()))
+		}
+	}()
+
+	result, err := handler(rctx, req.Params)
+	rctx.GetLog().Info("access", "client_ip", rctx.GetClientIp(), "rpc_method", req.Method,
+		"err", err, "cost_time", rctx.GetTimer().Print())
+	if err != nil {
+		return t.errResponse(req.Id, t.convertErr(err))
+	}
+	// 成功时result可以为null，需要显式输出
+	buf, err := json.Marshal(result)
+	if err != nil {
+		return t.errResponse(req.Id, newRpcError(errCodeInternal, "marshal result failed"))
+	}
+
+	return &rpcResponse{
+		Version: jsonRpcVersion,
+		Id:      req.Id,
+		Result:  buf,
+	}
+}
+
+func (t *EthServ) errResponse(id json.RawMessage, err *rpcError) *rpcResponse {
+	return &rpcResponse{
+		Version: jsonRpcVersion,
+		Id:      id,
+		Error:   err,
+	}
+}
+
+// 转化错误类型为json-rpc错误
+func (t *EthServ) convertErr(err error) *rpcError {
+	if rpcErr, ok := err.(*rpcError); ok {
+		return rpcErr
+	}
+	if stdErr, ok := err.(*ecom.Error); ok {
+		return newRpcError(errCodeServer, stdErr.Msg)
+	}
+	return newRpcError(errCodeServer, err.Error())
+}
+
+func (t *EthServ) getClientIp(r *http.Request) string {
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return ip
+}
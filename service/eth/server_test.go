@@ -0,0 +1,67 @@
+package eth
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/xuperchain/xupercore/kernel/engines/xuperos"
+	"github.com/xuperchain/xupercore/lib/logs"
+
+	sconf "github.com/xuperchain/xuperos/common/config"
+)
+
+func TestParseBlockNumber(t *testing.T) {
+	serv := NewEthServ(sconf.GetDefServConf(), nil, nil)
+	cases := map[string]bool{
+		"earliest":           true,
+		"0x10":               true,
+		"0x7fffffffffffffff": true,
+		"0x8000000000000000": false,
+		"0xffffffffffffffff": false,
+		"16":                 false,
+	}
+	for tag, ok := range cases {
+		height, err := serv.parseBlockNumber(nil, tag)
+		if (err == nil) != ok || height < 0 {
+			t.Errorf("parse block number error.tag:%s,height:%d,err:%v", tag, height, err)
+		}
+	}
+}
+
+func TestAllowMethods(t *testing.T) {
+	dir, err := ioutil.TempDir("", "eth")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	logConfFile := filepath.Join(dir, "log.yaml")
+	ioutil.WriteFile(logConfFile, []byte("level: warn\nconsole: false\nfilename: test\n"), 0644)
+	logs.InitLog(logConfFile, dir)
+
+	engine, err := xuperos.EngineConvert(xuperos.NewEngine())
+	if err != nil {
+		t.Fatal(err)
+	}
+	serv := NewEthServ(sconf.GetDefServConf(), engine, nil)
+	handler := serv.AllowMethods([]string{"net_version"})
+
+	body := `[{"jsonrpc":"2.0","id":1,"method":"net_version"},{"jsonrpc":"2.0","id":2,"method":"eth_chainId"}]`
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body)))
+	var resps []*rpcResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resps); err != nil || len(resps) != 2 {
+		t.Fatalf("unexpected response %s", w.Body.String())
+	}
+	if resps[0].Error != nil || string(resps[0].Result) != `"3777"` {
+		t.Errorf("allowed method failed.resp:%+v", resps[0])
+	}
+	if resps[1].Error == nil || resps[1].Error.Code != errCodeMethodNotFound {
+		t.Errorf("method not allowed should fail.resp:%+v", resps[1])
+	}
+}
@@ -0,0 +1,150 @@
+package eth
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hyperledger/burrow/crypto"
+)
+
+const (
+	// 空叔块列表的keccak256哈希
+	emptyUncleHash = "0x1dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347"
+	// 以太坊logsBloom长度
+	bloomLength = 256
+)
+
+var (
+	zeroHash  = hexBytes(make([]byte, 32))
+	zeroBloom = hexBytes(make([]byte, bloomLength))
+)
+
+type rpcBlock struct {
+	Number           string        `json:"number"`
+	Hash             string        `json:"hash"`
+	ParentHash       string        `json:"parentHash"`
+	Nonce            string        `json:"nonce"`
+	Sha3Uncles       string        `json:"sha3Uncles"`
+	LogsBloom        string        `json:"logsBloom"`
+	TransactionsRoot string        `json:"transactionsRoot"`
+	StateRoot        string        `json:"stateRoot"`
+	ReceiptsRoot     string        `json:"receiptsRoot"`
+	Miner            string        `json:"miner"`
+	Difficulty       string        `json:"difficulty"`
+	TotalDifficulty  string        `json:"totalDifficulty"`
+	ExtraData        string        `json:"extraData"`
+	Size             string        `json:"size"`
+	GasLimit         string        `json:"gasLimit"`
+	GasUsed          string        `json:"gasUsed"`
+	Timestamp        string        `json:"timestamp"`
+	Transactions     []interface{} `json:"transactions"`
+	Uncles           []string      `json:"uncles"`
+}
+
+type rpcTransaction struct {
+	Hash             string  `json:"hash"`
+	Nonce            string  `json:"nonce"`
+	BlockHash        string  `json:"blockHash"`
+	BlockNumber      string  `json:"blockNumber"`
+	TransactionIndex string  `json:"transactionIndex"`
+	From             string  `json:"from"`
+	To               *string `json:"to"`
+	Value            string  `json:"value"`
+	Gas              string  `json:"gas"`
+	GasPrice         string  `json:"gasPrice"`
+	Input            string  `json:"input"`
+}
+
+type rpcReceipt struct {
+	TransactionHash   string    `json:"transactionHash"`
+	TransactionIndex  string    `json:"transactionIndex"`
+	BlockHash         string    `json:"blockHash"`
+	BlockNumber       string    `json:"blockNumber"`
+	From              string    `json:"from"`
+	To                *string   `json:"to"`
+	ContractAddress   *string   `json:"contractAddress"`
+	CumulativeGasUsed string    `json:"cumulativeGasUsed"`
+	GasUsed           string    `json:"gasUsed"`
+	Logs              []*rpcLog `json:"logs"`
+	LogsBloom         string    `json:"logsBloom"`
+	Status            string    `json:"status"`
+}
+
+type rpcLog struct {
+	Address          string   `json:"address"`
+	Topics           []string `json:"topics"`
+	Data             string   `json:"data"`
+	BlockNumber      string   `json:"blockNumber"`
+	TransactionHash  string   `json:"transactionHash"`
+	TransactionIndex string   `json:"transactionIndex"`
+	BlockHash        string   `json:"blockHash"`
+	LogIndex         string   `json:"logIndex"`
+	Removed          bool     `json:"removed"`
+}
+
+type callArgs struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	Data string `json:"data"`
+	// 部分客户端使用input代替data
+	Input string `json:"input"`
+}
+
+type filterArgs struct {
+	FromBlock string            `json:"fromBlock"`
+	ToBlock   string            `json:"toBlock"`
+	BlockHash string            `json:"blockHash"`
+	Address   json.RawMessage   `json:"address"`
+	Topics    []json.RawMessage `json:"topics"`
+}
+
+func hexUint64(n uint64) string {
+	return "0x" + strconv.FormatUint(n, 16)
+}
+
+func hexBytes(b []byte) string {
+	return "0x" + hex.EncodeToString(b)
+}
+
+func decodeHex(str string) ([]byte, error) {
+	str = strings.TrimPrefix(strings.TrimPrefix(str, "0x"), "0X")
+	if len(str)%2 == 1 {
+		str = "0" + str
+	}
+	return hex.DecodeString(str)
+}
+
+func decodeHexUint64(str string) (uint64, error) {
+	if !strings.HasPrefix(str, "0x") && !strings.HasPrefix(str, "0X") {
+		return 0, fmt.Errorf("hex string without 0x prefix")
+	}
+	return strconv.ParseUint(str[2:], 16, 64)
+}
+
+func decodeAddress(str string) (crypto.Address, error) {
+	b, err := decodeHex(str)
+	if err != nil {
+		return crypto.ZeroAddress, err
+	}
+	return crypto.AddressFromBytes(b)
+}
+
+// 解析字符串或字符串数组类型的参数，null表示不过滤
+func parseStringOrArray(raw json.RawMessage) ([]string, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+
+	var one string
+	if err := json.Unmarshal(raw, &one); err == nil {
+		return []string{one}, nil
+	}
+	var list []string
+	if err := json.Unmarshal(raw, &list); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
@@ -0,0 +1,183 @@
+package gql
+
+import (
+	"encoding/hex"
+	"strconv"
+	"strings"
+
+	lpb "github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
+	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+	"github.com/xuperchain/xupercore/kernel/engines/xuperos/xpb"
+	aclUtils "github.com/xuperchain/xupercore/kernel/permission/acl/utils"
+	"github.com/xuperchain/xupercore/protos"
+
+	"github.com/xuperchain/xuperos/models"
+)
+
+const (
+	// 合约方法ACL的key分隔符
+	methodKeySep = "/"
+)
+
+// 单条链的数据加载器集合，所有账本读取都经过这里去重
+type chainLoader struct {
+	bcName string
+	handle *models.ChainHandle
+
+	blocks        *loader
+	heights       *loader
+	txs           *loader
+	balances      *loader
+	frozens       *loader
+	balanceDetail *loader
+	accountACLs   *loader
+	methodACLs    *loader
+	contracts     *loader
+	akAccounts    *loader
+}
+
+func newChainLoader(bcName string, handle *models.ChainHandle) *chainLoader {
+	l := &chainLoader{
+		bcName: bcName,
+		handle: handle,
+	}
+
+	l.blocks = newLoader(l.fetchBlock)
+	l.heights = newLoader(l.fetchBlockByHeight)
+	l.txs = newLoader(l.fetchTx)
+	l.balances = newLoader(func(key string) (interface{}, error) {
+		return handle.GetBalance(key)
+	})
+	l.frozens = newLoader(func(key string) (interface{}, error) {
+		return handle.GetFrozenBalance(key)
+	})
+	l.balanceDetail = newLoader(func(key string) (interface{}, error) {
+		return handle.GetBalanceDetail(key)
+	})
+	l.accountACLs = newLoader(func(key string) (interface{}, error) {
+		return handle.QueryAccountACL(key)
+	})
+	l.methodACLs = newLoader(func(key string) (interface{}, error) {
+		parts := strings.SplitN(key, methodKeySep, 2)
+		return handle.QueryContractMethodACL(parts[0], parts[1])
+	})
+	l.contracts = newLoader(func(key string) (interface{}, error) {
+		return handle.GetAccountContracts(key)
+	})
+	l.akAccounts = newLoader(func(key string) (interface{}, error) {
+		return handle.GetAccountByAK(key)
+	})
+
+	return l
+}
+
+// 区块不存在时返回nil，不作为错误
+func (t *chainLoader) fetchBlock(key string) (interface{}, error) {
+	blkId, err := hex.DecodeString(key)
+	if err != nil {
+		return nil, ecom.ErrParameter
+	}
+
+	blkInfo, err := t.handle.QueryBlock(blkId, true)
+	if blkInfo != nil && blkInfo.GetStatus() == lpb.BlockStatus_BLOCK_NOEXIST {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return t.newBlock(blkInfo), nil
+}
+
+func (t *chainLoader) fetchBlockByHeight(key string) (interface{}, error) {
+	height, err := strconv.ParseInt(key, 10, 64)
+	if err != nil {
+		return nil, ecom.ErrParameter
+	}
+
+	blkInfo, err := t.handle.QueryBlockByHeight(height, true)
+	if err != nil {
+		return nil, err
+	}
+	if blkInfo.GetStatus() == lpb.BlockStatus_BLOCK_NOEXIST {
+		return nil, nil
+	}
+
+	blk := t.newBlock(blkInfo)
+	t.blocks.Prime(hex.EncodeToString(blkInfo.GetBlock().GetBlockid()), blk)
+	return blk, nil
+}
+
+// 交易不存在时返回nil，不作为错误
+func (t *chainLoader) fetchTx(key string) (interface{}, error) {
+	txId, err := hex.DecodeString(key)
+	if err != nil {
+		return nil, ecom.ErrParameter
+	}
+
+	txInfo, err := t.handle.QueryTx(txId)
+	if err == ecom.ErrTxNotExist {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &txNode{bcName: t.bcName, tx: txInfo.GetTx(), status: txInfo.GetStatus()}, nil
+}
+
+// 区块中的交易一并写入交易缓存
+func (t *chainLoader) newBlock(blkInfo *xpb.BlockInfo) *blockNode {
+	blk := &blockNode{
+		bcName: t.bcName,
+		block:  blkInfo.GetBlock(),
+		status: blkInfo.GetStatus(),
+	}
+
+	for _, tx := range blk.block.GetTransactions() {
+		t.txs.Prime(hex.EncodeToString(tx.GetTxid()), &txNode{bcName: t.bcName, tx: tx, status: blk.txStatus()})
+	}
+	return blk
+}
+
+type blockNode struct {
+	bcName string
+	block  *lpb.InternalBlock
+	status lpb.BlockStatus
+}
+
+type txNode struct {
+	bcName string
+	tx     *lpb.Transaction
+	status lpb.TransactionStatus
+}
+
+type accountNode struct {
+	bcName  string
+	address string
+}
+
+type inputNode struct {
+	bcName string
+	input  *protos.TxInput
+}
+
+type outputNode struct {
+	bcName string
+	output *protos.TxOutput
+}
+
+type chainStatusNode struct {
+	bcName string
+	meta   *lpb.LedgerMeta
+}
+
+// 区块内交易状态跟随区块是否在主干
+func (t *blockNode) txStatus() lpb.TransactionStatus {
+	if t.status != lpb.BlockStatus_BLOCK_TRUNK {
+		return lpb.TransactionStatus_TX_FURCATION
+	}
+	return lpb.TransactionStatus_TX_CONFIRM
+}
+
+func (t *accountNode) isContractAccount() bool {
+	return aclUtils.IsAccount(t.address) == 1
+}
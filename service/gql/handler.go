@@ -0,0 +1,183 @@
+package gql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"sync"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+	"github.com/xuperchain/xupercore/lib/logs"
+	"github.com/xuperchain/xupercore/lib/utils"
+
+	sconf "github.com/xuperchain/xuperos/common/config"
+	sctx "github.com/xuperchain/xuperos/common/context"
+	"github.com/xuperchain/xuperos/models"
+)
+
+// 查询上下文在context中的key，使用私有类型避免和其他包冲突
+type queryCtxKey struct{}
+
+type gqlRequest struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// Handler 提供GraphQL查询http服务，挂载在gateway上
+type Handler struct {
+	scfg   *sconf.ServConf
+	engine ecom.Engine
+	log    logs.Logger
+	schema graphql.Schema
+}
+
+func NewHandler(scfg *sconf.ServConf, engine ecom.Engine, log logs.Logger) (*Handler, error) {
+	if scfg == nil || engine == nil {
+		return nil, fmt.Errorf("param error")
+	}
+
+	schema, err := newSchema()
+	if err != nil {
+		return nil, fmt.Errorf("new graphql schema failed.err:%v", err)
+	}
+
+	obj := &Handler{
+		scfg:   scfg,
+		engine: engine,
+		log:    log,
+		schema: schema,
+	}
+	return obj, nil
+}
+
+func (t *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req gqlRequest
+	switch r.Method {
+	case http.MethodGet:
+		req.Query = r.URL.Query().Get("query")
+		req.OperationName = r.URL.Query().Get("operationName")
+		if vars := r.URL.Query().Get("variables"); vars != "" {
+			if err := json.Unmarshal([]byte(vars), &req.Variables); err != nil {
+				t.writeErr(w, http.StatusBadRequest, "parse variables failed")
+				return
+			}
+		}
+	case http.MethodPost:
+		body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, int64(t.scfg.MaxMsgSize)))
+		if err != nil || json.Unmarshal(body, &req) != nil {
+			t.writeErr(w, http.StatusBadRequest, "parse request body failed")
+			return
+		}
+	default:
+		t.writeErr(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	// 创建请求上下文
+	clientIp, _, _ := net.SplitHostPort(r.RemoteAddr)
+	rctx, err := sctx.NewReqCtx(t.engine, utils.GenLogId(), clientIp)
+	if err != nil {
+		t.log.Error("create request context failed", "err", err)
+		t.writeErr(w, http.StatusInternalServerError, "create request context failed")
+		return
+	}
+
+	result := t.execute(rctx, &req)
+	rctx.GetLog().Info("access", "client_ip", clientIp, "operation", req.OperationName,
+		"err_cnt", len(result.Errors), "cost_time", rctx.GetTimer().Print())
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
+
+func (t *Handler) execute(rctx sctx.ReqCtx, req *gqlRequest) *graphql.Result {
+	src := source.NewSource(&source.Source{
+		Body: []byte(req.Query),
+		Name: "GraphQL request",
+	})
+	doc, err := parser.Parse(parser.ParseParams{Source: src})
+	if err != nil {
+		return &graphql.Result{Errors: gqlerrors.FormatErrors(err)}
+	}
+
+	// 执行前校验查询深度和复杂度
+	err = checkQueryLimit(&t.schema, doc, req.OperationName, req.Variables,
+		t.scfg.GraphQLMaxDepth, t.scfg.GraphQLMaxComplexity)
+	if err != nil {
+		return &graphql.Result{Errors: gqlerrors.FormatErrors(err)}
+	}
+	validate := graphql.ValidateDocument(&t.schema, doc, nil)
+	if !validate.IsValid {
+		return &graphql.Result{Errors: validate.Errors}
+	}
+
+	qctx := &queryCtx{
+		rctx:    rctx,
+		loaders: make(map[string]*chainLoader),
+	}
+	defer qctx.release()
+	return graphql.Execute(graphql.ExecuteParams{
+		Schema:        t.schema,
+		AST:           doc,
+		OperationName: req.OperationName,
+		Args:          req.Variables,
+		Context:       context.WithValue(rctx, queryCtxKey{}, qctx),
+	})
+}
+
+func (t *Handler) writeErr(w http.ResponseWriter, code int, msg string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(&graphql.Result{
+		Errors: gqlerrors.FormatErrors(errors.New(msg)),
+	})
+}
+
+// 单次查询上下文，按链缓存数据加载器
+type queryCtx struct {
+	rctx    sctx.ReqCtx
+	mutex   sync.Mutex
+	loaders map[string]*chainLoader
+}
+
+func valueQueryCtx(ctx context.Context) *queryCtx {
+	if qctx, ok := ctx.Value(queryCtxKey{}).(*queryCtx); ok {
+		return qctx
+	}
+	return nil
+}
+
+// 查询结束后释放使用的链
+func (t *queryCtx) release() {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	for _, l := range t.loaders {
+		l.handle.Release()
+	}
+}
+
+func (t *queryCtx) getLoader(bcName string) (*chainLoader, error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if l, ok := t.loaders[bcName]; ok {
+		return l, nil
+	}
+	handle, err := models.NewChainHandle(bcName, t.rctx)
+	if err != nil {
+		return nil, err
+	}
+	l := newChainLoader(bcName, handle)
+	t.loaders[bcName] = l
+	return l, nil
+}
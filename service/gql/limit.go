@@ -0,0 +1,188 @@
+package gql
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+const (
+	// 列表字段没有limit参数时，按该条数估算子字段复杂度
+	defaultListSize = 10
+)
+
+// 查询限制，在执行前根据语法树计算，避免单次查询读取过多账本数据
+// 深度：字段嵌套层数，fragment展开后计算
+// 复杂度：每个字段计1，列表字段其子字段复杂度乘以limit参数或默认条数，不超过maxPageSize
+type queryLimit struct {
+	schema        *graphql.Schema
+	maxDepth      int
+	maxComplexity int
+	fragments     map[string]*ast.FragmentDefinition
+	variables     map[string]interface{}
+}
+
+func checkQueryLimit(schema *graphql.Schema, doc *ast.Document, operationName string,
+	variables map[string]interface{}, maxDepth, maxComplexity int) error {
+	limit := &queryLimit{
+		schema:        schema,
+		maxDepth:      maxDepth,
+		maxComplexity: maxComplexity,
+		fragments:     make(map[string]*ast.FragmentDefinition),
+		variables:     variables,
+	}
+
+	operations := make([]*ast.OperationDefinition, 0)
+	for _, def := range doc.Definitions {
+		switch d := def.(type) {
+		case *ast.FragmentDefinition:
+			limit.fragments[d.Name.Value] = d
+		case *ast.OperationDefinition:
+			if operationName == "" || (d.Name != nil && d.Name.Value == operationName) {
+				operations = append(operations, d)
+			}
+		}
+	}
+
+	for _, op := range operations {
+		// 只支持查询，其他操作由校验拒绝
+		var root graphql.Type
+		if op.Operation == ast.OperationTypeQuery {
+			root = schema.QueryType()
+		}
+		depth, complexity, err := limit.measure(op.SelectionSet, root, 0, make(map[string]bool))
+		if err != nil {
+			return err
+		}
+		if maxDepth > 0 && depth > maxDepth {
+			return fmt.Errorf("query depth %d exceeds limit %d", depth, maxDepth)
+		}
+		if maxComplexity > 0 && complexity > maxComplexity {
+			return fmt.Errorf("query complexity %d exceeds limit %d", complexity, maxComplexity)
+		}
+	}
+
+	return nil
+}
+
+// 返回选择集的最大深度和复杂度，parent为选择集所属类型，未知类型的字段按非列表计算
+func (t *queryLimit) measure(set *ast.SelectionSet, parent graphql.Type, depth int,
+	visited map[string]bool) (int, int, error) {
+	if set == nil {
+		return depth, 0, nil
+	}
+	// 超过深度限制时提前返回，避免恶意构造的查询消耗过多资源
+	if t.maxDepth > 0 && depth > t.maxDepth {
+		return depth, 0, nil
+	}
+
+	maxDepth, complexity := depth, 0
+	for _, sel := range set.Selections {
+		var subDepth, subComplexity int
+		var err error
+		switch s := sel.(type) {
+		case *ast.Field:
+			fieldType, isList := t.fieldType(parent, s.Name.Value)
+			subDepth, subComplexity, err = t.measure(s.SelectionSet, fieldType, depth+1, visited)
+			subComplexity = 1 + t.multiplier(s, isList)*subComplexity
+		case *ast.InlineFragment:
+			fragType := parent
+			if s.TypeCondition != nil {
+				fragType = t.schema.Type(s.TypeCondition.Name.Value)
+			}
+			subDepth, subComplexity, err = t.measure(s.SelectionSet, fragType, depth, visited)
+		case *ast.FragmentSpread:
+			name := s.Name.Value
+			frag, ok := t.fragments[name]
+			if !ok {
+				return 0, 0, fmt.Errorf("unknown fragment %s", name)
+			}
+			if visited[name] {
+				return 0, 0, fmt.Errorf("fragment %s is cyclic", name)
+			}
+			visited[name] = true
+			subDepth, subComplexity, err = t.measure(frag.SelectionSet,
+				t.schema.Type(frag.TypeCondition.Name.Value), depth, visited)
+			delete(visited, name)
+		}
+		if err != nil {
+			return 0, 0, err
+		}
+
+		if subDepth > maxDepth {
+			maxDepth = subDepth
+		}
+		complexity += subComplexity
+	}
+
+	return maxDepth, complexity, nil
+}
+
+// 查找字段类型，去掉NonNull和List包装后返回元素类型及是否为列表
+func (t *queryLimit) fieldType(parent graphql.Type, name string) (graphql.Type, bool) {
+	obj, ok := parent.(*graphql.Object)
+	if !ok {
+		return nil, false
+	}
+	field, ok := obj.Fields()[name]
+	if !ok {
+		return nil, false
+	}
+
+	fieldType, isList := graphql.Type(field.Type), false
+	for {
+		switch v := fieldType.(type) {
+		case *graphql.NonNull:
+			fieldType = v.OfType
+		case *graphql.List:
+			fieldType, isList = v.OfType, true
+		default:
+			return fieldType, isList
+		}
+	}
+}
+
+// 列表字段按limit参数放大子字段复杂度，没有limit参数按默认条数，单层最多放大maxPageSize倍
+func (t *queryLimit) multiplier(field *ast.Field, isList bool) int {
+	n := 1
+	if isList {
+		n = defaultListSize
+	}
+	for _, arg := range field.Arguments {
+		if arg.Name.Value != "limit" {
+			continue
+		}
+
+		var value interface{}
+		switch v := arg.Value.(type) {
+		case *ast.IntValue:
+			value = v.Value
+		case *ast.Variable:
+			value = t.variables[v.Name.Value]
+		}
+		switch v := value.(type) {
+		case string:
+			if i, err := strconv.Atoi(v); err == nil && i > 1 {
+				n = i
+			}
+		case float64:
+			// 先比较再转换，避免超大值转换溢出
+			if v > maxPageSize {
+				n = maxPageSize
+			} else if v > 1 {
+				n = int(v)
+			}
+		case int:
+			if v > 1 {
+				n = v
+			}
+		}
+	}
+	if n > maxPageSize {
+		n = maxPageSize
+	}
+
+	return n
+}
@@ -0,0 +1,96 @@
+package gql
+
+import (
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/parser"
+)
+
+func TestQueryLimit(t *testing.T) {
+	schema, err := newSchema()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		query     string
+		variables map[string]interface{}
+		ok        bool
+	}{
+		{`{ block(bcname:"xuper", height:1) { blockid transactions { txid } } }`, nil, true},
+		{`{ block(bcname:"xuper", height:1) { preBlock { preBlock { preBlock { blockid } } } } }`, nil, false},
+		{`query q($n: Int!) { blocks(bcname:"xuper", from:0, limit:$n) { transactions { txid inputs { amount } } } }`,
+			map[string]interface{}{"n": float64(100)}, false},
+		{`{ tx(bcname:"xuper", txid:"00") { ...f } } fragment f on Transaction { inputs { refTx { ...f } } }`, nil, false},
+		// 没有limit参数的列表字段按默认条数计算
+		{`{ blocks(bcname:"xuper", from:0, limit:10) { transactions { inputs { amount } } } }`, nil, false},
+		{`{ tx(bcname:"xuper", txid:"00") { ...f } } fragment f on Transaction { outputs { to { balance } } }`, nil, true},
+		{`query q($n: Int!) { blocks(bcname:"xuper", from:0, limit:$n) { blockid } }`,
+			map[string]interface{}{"n": float64(1e30)}, true},
+	}
+	for i, c := range cases {
+		doc, err := parser.Parse(parser.ParseParams{Source: c.query})
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = checkQueryLimit(&schema, doc, "", c.variables, 4, 200)
+		if (err == nil) != c.ok {
+			t.Errorf("case %d expect ok=%v, err=%v", i, c.ok, err)
+		}
+		if c.ok && !graphql.ValidateDocument(&schema, doc, nil).IsValid {
+			t.Errorf("case %d validate failed", i)
+		}
+	}
+}
+
+func TestLoaderDedupe(t *testing.T) {
+	var count int32
+	l := newLoader(func(key string) (interface{}, error) {
+		atomic.AddInt32(&count, 1)
+		return key, nil
+	})
+
+	thunks := []func() (interface{}, error){l.Load("a"), l.Load("b"), l.Load("a")}
+	for _, thunk := range thunks {
+		if _, err := thunk(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if count != 2 {
+		t.Errorf("expect 2 fetches, actual %d", count)
+	}
+}
+
+func TestLoaderWorkers(t *testing.T) {
+	var running, maxRunning int32
+	l := newLoader(func(key string) (interface{}, error) {
+		n := atomic.AddInt32(&running, 1)
+		for {
+			old := atomic.LoadInt32(&maxRunning)
+			if n <= old || atomic.CompareAndSwapInt32(&maxRunning, old, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		atomic.AddInt32(&running, -1)
+		return key, nil
+	})
+
+	thunks := make([]func() (interface{}, error), 0)
+	for i := 0; i < 5*maxLoadWorkers; i++ {
+		thunks = append(thunks, l.Load(strconv.Itoa(i)))
+	}
+	for i, thunk := range thunks {
+		value, err := thunk()
+		if err != nil || value != strconv.Itoa(i) {
+			t.Fatalf("load %d failed.value:%v,err:%v", i, value, err)
+		}
+	}
+	if maxRunning > maxLoadWorkers {
+		t.Errorf("expect at most %d workers, actual %d", maxLoadWorkers, maxRunning)
+	}
+}
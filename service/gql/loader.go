@@ -0,0 +1,100 @@
+package gql
+
+import (
+	"sync"
+)
+
+const (
+	// 单个加载器同时读取账本的最大协程数
+	maxLoadWorkers = 16
+)
+
+// 请求级别的数据加载器，同一次查询内相同key只读取一次账本
+// 解析器返回thunk，执行器在同层字段都解析完后才调用thunk，此时统一批量读取
+type loader struct {
+	mutex   sync.Mutex
+	fetch   func(key string) (interface{}, error)
+	results map[string]*loadResult
+	pending []string
+}
+
+type loadResult struct {
+	value interface{}
+	err   error
+	done  chan struct{}
+}
+
+func newLoader(fetch func(key string) (interface{}, error)) *loader {
+	return &loader{
+		fetch:   fetch,
+		results: make(map[string]*loadResult),
+		pending: make([]string, 0),
+	}
+}
+
+// Load 登记待读取的key，返回延迟求值的thunk
+func (l *loader) Load(key string) func() (interface{}, error) {
+	l.mutex.Lock()
+	res, ok := l.results[key]
+	if !ok {
+		res = &loadResult{done: make(chan struct{})}
+		l.results[key] = res
+		l.pending = append(l.pending, key)
+	}
+	l.mutex.Unlock()
+
+	return func() (interface{}, error) {
+		l.dispatch()
+		<-res.done
+		return res.value, res.err
+	}
+}
+
+// 并发读取当前批次所有待读取的key，最多maxLoadWorkers个协程
+func (l *loader) dispatch() {
+	l.mutex.Lock()
+	keys := l.pending
+	l.pending = make([]string, 0)
+	l.mutex.Unlock()
+
+	workers := len(keys)
+	if workers > maxLoadWorkers {
+		workers = maxLoadWorkers
+	}
+	keyCh := make(chan string, len(keys))
+	for _, key := range keys {
+		keyCh <- key
+	}
+	close(keyCh)
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for key := range keyCh {
+				value, err := l.fetch(key)
+
+				l.mutex.Lock()
+				res := l.results[key]
+				l.mutex.Unlock()
+				res.value, res.err = value, err
+				close(res.done)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// Prime 预先写入已读取到的数据，避免同一数据按不同key重复读取
+func (l *loader) Prime(key string, value interface{}) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if _, ok := l.results[key]; ok {
+		return
+	}
+	res := &loadResult{value: value, done: make(chan struct{})}
+	close(res.done)
+	l.results[key] = res
+}
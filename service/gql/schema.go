@@ -0,0 +1,682 @@
+package gql
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+
+	"github.com/graphql-go/graphql"
+	lpb "github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
+	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+	"github.com/xuperchain/xupercore/protos"
+)
+
+// 注意：
+// 1.所有账本读取通过chainLoader，解析器返回thunk以便同层字段批量读取
+// 2.bytes类型字段统一hex编码，金额使用十进制字符串
+// 3.时间戳为纳秒，超出Int范围，使用字符串
+
+const (
+	// blocks分页最大条数
+	maxPageSize = 100
+)
+
+func newSchema() (graphql.Schema, error) {
+	s := &schemaBuilder{}
+	return graphql.NewSchema(graphql.SchemaConfig{
+		Query: s.queryType(),
+	})
+}
+
+type schemaBuilder struct {
+	blockType       *graphql.Object
+	txType          *graphql.Object
+	inputType       *graphql.Object
+	outputType      *graphql.Object
+	invokeType      *graphql.Object
+	accountType     *graphql.Object
+	aclType         *graphql.Object
+	contractType    *graphql.Object
+	balanceType     *graphql.Object
+	chainStatusType *graphql.Object
+}
+
+func (s *schemaBuilder) queryType() *graphql.Object {
+	s.aclType = s.newAclType()
+	s.contractType = s.newContractType()
+	s.balanceType = s.newBalanceType()
+	s.invokeType = s.newInvokeType()
+	s.accountType = s.newAccountType()
+	s.outputType = s.newOutputType()
+	s.inputType = s.newInputType()
+	s.txType = s.newTxType()
+	s.blockType = s.newBlockType()
+	s.chainStatusType = s.newChainStatusType()
+
+	bcnameArg := &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)}
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"block": &graphql.Field{
+				Type:        s.blockType,
+				Description: "query block by blockid or height",
+				Args: graphql.FieldConfigArgument{
+					"bcname":  bcnameArg,
+					"blockid": &graphql.ArgumentConfig{Type: graphql.String},
+					"height":  &graphql.ArgumentConfig{Type: graphql.Int},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					l, err := getLoader(p, p.Args["bcname"].(string))
+					if err != nil {
+						return nil, err
+					}
+					if blkId, ok := p.Args["blockid"].(string); ok {
+						return l.blocks.Load(blkId), nil
+					}
+					if height, ok := p.Args["height"].(int); ok {
+						return l.heights.Load(strconv.Itoa(height)), nil
+					}
+					return nil, fmt.Errorf("blockid or height must be set")
+				},
+			},
+			"blocks": &graphql.Field{
+				Type:        graphql.NewList(s.blockType),
+				Description: "query trunk blocks from height, at most 100 blocks",
+				Args: graphql.FieldConfigArgument{
+					"bcname": bcnameArg,
+					"from":   &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)},
+					"limit":  &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					l, err := getLoader(p, p.Args["bcname"].(string))
+					if err != nil {
+						return nil, err
+					}
+					from, limit := p.Args["from"].(int), p.Args["limit"].(int)
+					if from < 0 || limit <= 0 || limit > maxPageSize {
+						return nil, fmt.Errorf("from must be non-negative and limit must be in [1, %d]", maxPageSize)
+					}
+					blocks := make([]interface{}, 0, limit)
+					for height := from; height < from+limit; height++ {
+						blocks = append(blocks, l.heights.Load(strconv.Itoa(height)))
+					}
+					return blocks, nil
+				},
+			},
+			"tx": &graphql.Field{
+				Type:        s.txType,
+				Description: "query transaction by txid",
+				Args: graphql.FieldConfigArgument{
+					"bcname": bcnameArg,
+					"txid":   &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					l, err := getLoader(p, p.Args["bcname"].(string))
+					if err != nil {
+						return nil, err
+					}
+					return l.txs.Load(p.Args["txid"].(string)), nil
+				},
+			},
+			"account": &graphql.Field{
+				Type:        s.accountType,
+				Description: "query address or contract account",
+				Args: graphql.FieldConfigArgument{
+					"bcname":  bcnameArg,
+					"address": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return &accountNode{
+						bcName:  p.Args["bcname"].(string),
+						address: p.Args["address"].(string),
+					}, nil
+				},
+			},
+			"contractMethodAcl": &graphql.Field{
+				Type:        s.aclType,
+				Description: "query contract method acl",
+				Args: graphql.FieldConfigArgument{
+					"bcname":   bcnameArg,
+					"contract": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+					"method":   &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					l, err := getLoader(p, p.Args["bcname"].(string))
+					if err != nil {
+						return nil, err
+					}
+					key := p.Args["contract"].(string) + methodKeySep + p.Args["method"].(string)
+					return l.methodACLs.Load(key), nil
+				},
+			},
+			"status": &graphql.Field{
+				Type:        s.chainStatusType,
+				Description: "query chain status",
+				Args: graphql.FieldConfigArgument{
+					"bcname": bcnameArg,
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					bcName := p.Args["bcname"].(string)
+					l, err := getLoader(p, bcName)
+					if err != nil {
+						return nil, err
+					}
+					status, err := l.handle.QueryChainStatus()
+					if err != nil {
+						return nil, err
+					}
+					return &chainStatusNode{bcName: bcName, meta: status.GetLedgerMeta()}, nil
+				},
+			},
+		},
+	})
+}
+
+func (s *schemaBuilder) newChainStatusType() *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: "ChainStatus",
+		Fields: graphql.Fields{
+			"rootBlockid": &graphql.Field{
+				Type: graphql.String,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return hexStr(p.Source.(*chainStatusNode).meta.GetRootBlockid()), nil
+				},
+			},
+			"tipBlockid": &graphql.Field{
+				Type: graphql.String,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return hexStr(p.Source.(*chainStatusNode).meta.GetTipBlockid()), nil
+				},
+			},
+			"trunkHeight": &graphql.Field{
+				Type: graphql.Int,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(*chainStatusNode).meta.GetTrunkHeight(), nil
+				},
+			},
+			"tipBlock": &graphql.Field{
+				Type: s.blockType,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					status := p.Source.(*chainStatusNode)
+					l, err := getLoader(p, status.bcName)
+					if err != nil {
+						return nil, err
+					}
+					return l.blocks.Load(hexStr(status.meta.GetTipBlockid())), nil
+				},
+			},
+		},
+	})
+}
+
+func (s *schemaBuilder) newBlockType() *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: "Block",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"blockid": blockField(graphql.String, func(b *blockNode) interface{} {
+					return hexStr(b.block.GetBlockid())
+				}),
+				"preHash": blockField(graphql.String, func(b *blockNode) interface{} {
+					return hexStr(b.block.GetPreHash())
+				}),
+				"nextHash": blockField(graphql.String, func(b *blockNode) interface{} {
+					return hexStr(b.block.GetNextHash())
+				}),
+				"proposer": blockField(graphql.String, func(b *blockNode) interface{} {
+					return string(b.block.GetProposer())
+				}),
+				"height": blockField(graphql.Int, func(b *blockNode) interface{} {
+					return b.block.GetHeight()
+				}),
+				"timestamp": blockField(graphql.String, func(b *blockNode) interface{} {
+					return strconv.FormatInt(b.block.GetTimestamp(), 10)
+				}),
+				"txCount": blockField(graphql.Int, func(b *blockNode) interface{} {
+					return b.block.GetTxCount()
+				}),
+				"inTrunk": blockField(graphql.Boolean, func(b *blockNode) interface{} {
+					return b.block.GetInTrunk()
+				}),
+				"status": blockField(graphql.String, func(b *blockNode) interface{} {
+					return b.status.String()
+				}),
+				"transactions": blockField(graphql.NewList(s.txType), func(b *blockNode) interface{} {
+					txs := make([]*txNode, 0, len(b.block.GetTransactions()))
+					for _, tx := range b.block.GetTransactions() {
+						txs = append(txs, &txNode{bcName: b.bcName, tx: tx, status: b.txStatus()})
+					}
+					return txs
+				}),
+				"preBlock": &graphql.Field{
+					Type: s.blockType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						b := p.Source.(*blockNode)
+						if b.block.GetHeight() == 0 {
+							return nil, nil
+						}
+						l, err := getLoader(p, b.bcName)
+						if err != nil {
+							return nil, err
+						}
+						return l.blocks.Load(hexStr(b.block.GetPreHash())), nil
+					},
+				},
+			}
+		}),
+	})
+}
+
+func (s *schemaBuilder) newTxType() *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: "Transaction",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"txid": txField(graphql.String, func(t *txNode) interface{} {
+					return hexStr(t.tx.GetTxid())
+				}),
+				"blockid": txField(graphql.String, func(t *txNode) interface{} {
+					return hexStr(t.tx.GetBlockid())
+				}),
+				"status": txField(graphql.String, func(t *txNode) interface{} {
+					return t.status.String()
+				}),
+				"initiator": txField(graphql.String, func(t *txNode) interface{} {
+					return t.tx.GetInitiator()
+				}),
+				"authRequire": txField(graphql.NewList(graphql.String), func(t *txNode) interface{} {
+					return t.tx.GetAuthRequire()
+				}),
+				"desc": txField(graphql.String, func(t *txNode) interface{} {
+					return string(t.tx.GetDesc())
+				}),
+				"nonce": txField(graphql.String, func(t *txNode) interface{} {
+					return t.tx.GetNonce()
+				}),
+				"timestamp": txField(graphql.String, func(t *txNode) interface{} {
+					return strconv.FormatInt(t.tx.GetTimestamp(), 10)
+				}),
+				"version": txField(graphql.Int, func(t *txNode) interface{} {
+					return t.tx.GetVersion()
+				}),
+				"coinbase": txField(graphql.Boolean, func(t *txNode) interface{} {
+					return t.tx.GetCoinbase()
+				}),
+				"autogen": txField(graphql.Boolean, func(t *txNode) interface{} {
+					return t.tx.GetAutogen()
+				}),
+				"inputs": txField(graphql.NewList(s.inputType), func(t *txNode) interface{} {
+					inputs := make([]*inputNode, 0, len(t.tx.GetTxInputs()))
+					for _, in := range t.tx.GetTxInputs() {
+						inputs = append(inputs, &inputNode{bcName: t.bcName, input: in})
+					}
+					return inputs
+				}),
+				"outputs": txField(graphql.NewList(s.outputType), func(t *txNode) interface{} {
+					outputs := make([]*outputNode, 0, len(t.tx.GetTxOutputs()))
+					for _, out := range t.tx.GetTxOutputs() {
+						outputs = append(outputs, &outputNode{bcName: t.bcName, output: out})
+					}
+					return outputs
+				}),
+				"contractRequests": txField(graphql.NewList(s.invokeType), func(t *txNode) interface{} {
+					return t.tx.GetContractRequests()
+				}),
+				"initiatorAccount": txField(s.accountType, func(t *txNode) interface{} {
+					return &accountNode{bcName: t.bcName, address: t.tx.GetInitiator()}
+				}),
+				"block": &graphql.Field{
+					Type: s.blockType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						t := p.Source.(*txNode)
+						if len(t.tx.GetBlockid()) == 0 {
+							return nil, nil
+						}
+						l, err := getLoader(p, t.bcName)
+						if err != nil {
+							return nil, err
+						}
+						return l.blocks.Load(hexStr(t.tx.GetBlockid())), nil
+					},
+				},
+			}
+		}),
+	})
+}
+
+func (s *schemaBuilder) newInputType() *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: "TxInput",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"refTxid": inputField(graphql.String, func(in *protos.TxInput) interface{} {
+					return hexStr(in.GetRefTxid())
+				}),
+				"refOffset": inputField(graphql.Int, func(in *protos.TxInput) interface{} {
+					return in.GetRefOffset()
+				}),
+				"fromAddr": inputField(graphql.String, func(in *protos.TxInput) interface{} {
+					return string(in.GetFromAddr())
+				}),
+				"amount": inputField(graphql.String, func(in *protos.TxInput) interface{} {
+					return amountStr(in.GetAmount())
+				}),
+				"frozenHeight": inputField(graphql.Int, func(in *protos.TxInput) interface{} {
+					return in.GetFrozenHeight()
+				}),
+				"from": &graphql.Field{
+					Type: s.accountType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						in := p.Source.(*inputNode)
+						return &accountNode{bcName: in.bcName, address: string(in.input.GetFromAddr())}, nil
+					},
+				},
+				"refTx": &graphql.Field{
+					Type: s.txType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						in := p.Source.(*inputNode)
+						l, err := getLoader(p, in.bcName)
+						if err != nil {
+							return nil, err
+						}
+						return l.txs.Load(hexStr(in.input.GetRefTxid())), nil
+					},
+				},
+			}
+		}),
+	})
+}
+
+func (s *schemaBuilder) newOutputType() *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: "TxOutput",
+		Fields: graphql.Fields{
+			"toAddr": &graphql.Field{
+				Type: graphql.String,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return string(p.Source.(*outputNode).output.GetToAddr()), nil
+				},
+			},
+			"amount": &graphql.Field{
+				Type: graphql.String,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return amountStr(p.Source.(*outputNode).output.GetAmount()), nil
+				},
+			},
+			"frozenHeight": &graphql.Field{
+				Type: graphql.Int,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(*outputNode).output.GetFrozenHeight(), nil
+				},
+			},
+			"to": &graphql.Field{
+				Type: s.accountType,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					out := p.Source.(*outputNode)
+					return &accountNode{bcName: out.bcName, address: string(out.output.GetToAddr())}, nil
+				},
+			},
+		},
+	})
+}
+
+func (s *schemaBuilder) newInvokeType() *graphql.Object {
+	argType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "InvokeArg",
+		Fields: graphql.Fields{
+			"key":   &graphql.Field{Type: graphql.String},
+			"value": &graphql.Field{Type: graphql.String},
+		},
+	})
+
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: "InvokeRequest",
+		Fields: graphql.Fields{
+			"moduleName": invokeField(graphql.String, func(req *protos.InvokeRequest) interface{} {
+				return req.GetModuleName()
+			}),
+			"contractName": invokeField(graphql.String, func(req *protos.InvokeRequest) interface{} {
+				return req.GetContractName()
+			}),
+			"methodName": invokeField(graphql.String, func(req *protos.InvokeRequest) interface{} {
+				return req.GetMethodName()
+			}),
+			"amount": invokeField(graphql.String, func(req *protos.InvokeRequest) interface{} {
+				return req.GetAmount()
+			}),
+			"args": invokeField(graphql.NewList(argType), func(req *protos.InvokeRequest) interface{} {
+				keys := make([]string, 0, len(req.GetArgs()))
+				for key := range req.GetArgs() {
+					keys = append(keys, key)
+				}
+				sort.Strings(keys)
+				args := make([]map[string]interface{}, 0, len(keys))
+				for _, key := range keys {
+					args = append(args, map[string]interface{}{
+						"key":   key,
+						"value": string(req.GetArgs()[key]),
+					})
+				}
+				return args
+			}),
+		},
+	})
+}
+
+func (s *schemaBuilder) newAccountType() *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: "Account",
+		Fields: graphql.Fields{
+			"address": &graphql.Field{
+				Type: graphql.String,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(*accountNode).address, nil
+				},
+			},
+			"isContractAccount": &graphql.Field{
+				Type: graphql.Boolean,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(*accountNode).isContractAccount(), nil
+				},
+			},
+			"balance": accountField(graphql.String, func(l *chainLoader, a *accountNode) interface{} {
+				return l.balances.Load(a.address)
+			}),
+			"frozenBalance": accountField(graphql.String, func(l *chainLoader, a *accountNode) interface{} {
+				return l.frozens.Load(a.address)
+			}),
+			"balanceDetail": accountField(graphql.NewList(s.balanceType), func(l *chainLoader, a *accountNode) interface{} {
+				return l.balanceDetail.Load(a.address)
+			}),
+			// 以下字段只对合约账户有效
+			"acl": accountField(s.aclType, func(l *chainLoader, a *accountNode) interface{} {
+				if !a.isContractAccount() {
+					return nil
+				}
+				return l.accountACLs.Load(a.address)
+			}),
+			"contracts": accountField(graphql.NewList(s.contractType), func(l *chainLoader, a *accountNode) interface{} {
+				if !a.isContractAccount() {
+					return nil
+				}
+				return l.contracts.Load(a.address)
+			}),
+			// 只对普通地址有效，返回包含该地址的合约账户
+			"accounts": accountField(graphql.NewList(graphql.String), func(l *chainLoader, a *accountNode) interface{} {
+				if a.isContractAccount() {
+					return nil
+				}
+				return l.akAccounts.Load(a.address)
+			}),
+		},
+	})
+}
+
+func (s *schemaBuilder) newAclType() *graphql.Object {
+	akWeightType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "AkWeight",
+		Fields: graphql.Fields{
+			"address": &graphql.Field{Type: graphql.String},
+			"weight":  &graphql.Field{Type: graphql.Float},
+		},
+	})
+
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: "Acl",
+		Fields: graphql.Fields{
+			"rule": aclField(graphql.String, func(acl *protos.Acl) interface{} {
+				return acl.GetPm().GetRule().String()
+			}),
+			"acceptValue": aclField(graphql.Float, func(acl *protos.Acl) interface{} {
+				return acl.GetPm().GetAcceptValue()
+			}),
+			"aksWeight": aclField(graphql.NewList(akWeightType), func(acl *protos.Acl) interface{} {
+				addrs := make([]string, 0, len(acl.GetAksWeight()))
+				for addr := range acl.GetAksWeight() {
+					addrs = append(addrs, addr)
+				}
+				sort.Strings(addrs)
+				weights := make([]map[string]interface{}, 0, len(addrs))
+				for _, addr := range addrs {
+					weights = append(weights, map[string]interface{}{
+						"address": addr,
+						"weight":  acl.GetAksWeight()[addr],
+					})
+				}
+				return weights
+			}),
+		},
+	})
+}
+
+func (s *schemaBuilder) newContractType() *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: "Contract",
+		Fields: graphql.Fields{
+			"contractName": contractField(graphql.String, func(c *protos.ContractStatus) interface{} {
+				return c.GetContractName()
+			}),
+			"txid": contractField(graphql.String, func(c *protos.ContractStatus) interface{} {
+				return c.GetTxid()
+			}),
+			"desc": contractField(graphql.String, func(c *protos.ContractStatus) interface{} {
+				return string(c.GetDesc())
+			}),
+			"isBanned": contractField(graphql.Boolean, func(c *protos.ContractStatus) interface{} {
+				return c.GetIsBanned()
+			}),
+			"timestamp": contractField(graphql.String, func(c *protos.ContractStatus) interface{} {
+				return strconv.FormatInt(c.GetTimestamp(), 10)
+			}),
+			"runtime": contractField(graphql.String, func(c *protos.ContractStatus) interface{} {
+				return c.GetRuntime()
+			}),
+		},
+	})
+}
+
+func (s *schemaBuilder) newBalanceType() *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: "BalanceDetail",
+		Fields: graphql.Fields{
+			"balance": &graphql.Field{
+				Type: graphql.String,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(*lpb.BalanceDetailInfo).GetBalance(), nil
+				},
+			},
+			"isFrozen": &graphql.Field{
+				Type: graphql.Boolean,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(*lpb.BalanceDetailInfo).GetIsFrozen(), nil
+				},
+			},
+		},
+	})
+}
+
+func getLoader(p graphql.ResolveParams, bcName string) (*chainLoader, error) {
+	qctx := valueQueryCtx(p.Context)
+	if qctx == nil {
+		return nil, ecom.ErrInternal
+	}
+	return qctx.getLoader(bcName)
+}
+
+func blockField(typ graphql.Output, fn func(b *blockNode) interface{}) *graphql.Field {
+	return &graphql.Field{
+		Type: typ,
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			return fn(p.Source.(*blockNode)), nil
+		},
+	}
+}
+
+func txField(typ graphql.Output, fn func(t *txNode) interface{}) *graphql.Field {
+	return &graphql.Field{
+		Type: typ,
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			return fn(p.Source.(*txNode)), nil
+		},
+	}
+}
+
+func inputField(typ graphql.Output, fn func(in *protos.TxInput) interface{}) *graphql.Field {
+	return &graphql.Field{
+		Type: typ,
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			return fn(p.Source.(*inputNode).input), nil
+		},
+	}
+}
+
+func invokeField(typ graphql.Output, fn func(req *protos.InvokeRequest) interface{}) *graphql.Field {
+	return &graphql.Field{
+		Type: typ,
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			return fn(p.Source.(*protos.InvokeRequest)), nil
+		},
+	}
+}
+
+func accountField(typ graphql.Output, fn func(l *chainLoader, a *accountNode) interface{}) *graphql.Field {
+	return &graphql.Field{
+		Type: typ,
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			a := p.Source.(*accountNode)
+			l, err := getLoader(p, a.bcName)
+			if err != nil {
+				return nil, err
+			}
+			return fn(l, a), nil
+		},
+	}
+}
+
+func aclField(typ graphql.Output, fn func(acl *protos.Acl) interface{}) *graphql.Field {
+	return &graphql.Field{
+		Type: typ,
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			return fn(p.Source.(*protos.Acl)), nil
+		},
+	}
+}
+
+func contractField(typ graphql.Output, fn func(c *protos.ContractStatus) interface{}) *graphql.Field {
+	return &graphql.Field{
+		Type: typ,
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			return fn(p.Source.(*protos.ContractStatus)), nil
+		},
+	}
+}
+
+func hexStr(b []byte) string {
+	return hex.EncodeToString(b)
+}
+
+func amountStr(b []byte) string {
+	return new(big.Int).SetBytes(b).String()
+}
@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/xuperchain/xupercore/lib/logs"
+)
+
+// HealthStatus 服务健康检查结果，所有关键组件运行中时为ok
+type HealthStatus struct {
+	Status   string        `json:"status"`
+	Services []*ServStatus `json:"services"`
+}
+
+// 监控服务，在MetricPort上提供/metrics和/health
+type metricServ struct {
+	port   int
+	servMG *ServMG
+	log    logs.Logger
+
+	lock   sync.Mutex
+	server *http.Server
+	isExit bool
+}
+
+func newMetricServ(port int, servMG *ServMG, log logs.Logger) *metricServ {
+	return &metricServ{
+		port:   port,
+		servMG: servMG,
+		log:    log,
+	}
+}
+
+// 启动监控服务，阻塞直到退出
+func (t *metricServ) Run() error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	mux.HandleFunc("/health", t.health)
+	server := &http.Server{
+		Addr:    fmt.Sprintf(":%d", t.port),
+		Handler: mux,
+	}
+
+	t.lock.Lock()
+	if t.isExit {
+		t.lock.Unlock()
+		return nil
+	}
+	t.server = server
+	t.lock.Unlock()
+
+	t.log.Trace("run metric server", "port", t.port)
+	err := server.ListenAndServe()
+	if err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
+
+// 退出监控服务，需要幂等
+func (t *metricServ) Exit() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.isExit = true
+	if t.server != nil {
+		t.server.Shutdown(context.Background())
+	}
+}
+
+func (t *metricServ) health(w http.ResponseWriter, r *http.Request) {
+	health := t.servMG.Health()
+	code := http.StatusOK
+	if health.Status != "ok" {
+		code = http.StatusServiceUnavailable
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(health)
+}
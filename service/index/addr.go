@@ -0,0 +1,178 @@
+package index
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"regexp"
+	"strings"
+
+	lpb "github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
+	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+	"github.com/xuperchain/xupercore/lib/storage/kvdb"
+)
+
+const (
+	addrTxPrefix = "A/"
+	// 手续费输出地址
+	feeAddress = "$"
+
+	DefAddressTxsLimit = 20
+	MaxAddressTxsLimit = 100
+)
+
+// 交易对地址的资金方向，与pb定义保持一致
+const (
+	DirectionNone = 0
+	DirectionIn   = 1
+	DirectionOut  = 2
+)
+
+var (
+	ErrIndexDisabled = ecom.ErrForbidden.More("address index not enabled")
+	ErrInvalidCursor = ecom.ErrParameter.More("invalid cursor")
+
+	cursorRegex = regexp.MustCompile(`^\d{20}/\d{10}$`)
+)
+
+// 地址相关交易记录
+type AddressTx struct {
+	Height    int64  `json:"height"`
+	Txid      []byte `json:"txid"`
+	Direction int32  `json:"direction"`
+	Amount    string `json:"amount"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// 分页查询地址相关交易，按高度从新到旧返回，nextCursor为空表示没有更多数据
+func (t *Indexer) GetAddressTxs(bcName, address, cursor string,
+	limit int) ([]*AddressTx, string, error) {
+	if t == nil || !t.scfg.EnableAddrIndex {
+		return nil, "", ErrIndexDisabled
+	}
+	if bcName == "" || address == "" || strings.Contains(address, "/") {
+		return nil, "", ecom.ErrParameter
+	}
+	if cursor != "" && !cursorRegex.MatchString(cursor) {
+		return nil, "", ErrInvalidCursor
+	}
+	if limit <= 0 {
+		limit = DefAddressTxsLimit
+	}
+	if limit > MaxAddressTxsLimit {
+		limit = MaxAddressTxsLimit
+	}
+
+	prefix := addrTxKeyPrefix(bcName, address)
+	start := []byte(prefix)
+	if cursor != "" {
+		// 从游标之后的第一条开始
+		start = append([]byte(prefix+cursor), 0)
+	}
+	end := []byte(prefix[:len(prefix)-1] + "0")
+
+	iter := t.db.NewIteratorWithRange(start, end)
+	defer iter.Release()
+
+	txs := make([]*AddressTx, 0, limit)
+	lastKey := ""
+	for iter.Next() {
+		if len(txs) >= limit {
+			return txs, strings.TrimPrefix(lastKey, prefix), nil
+		}
+		tx := &AddressTx{}
+		if err := json.Unmarshal(iter.Value(), tx); err != nil {
+			return nil, "", err
+		}
+		txs = append(txs, tx)
+		lastKey = string(iter.Key())
+	}
+	if err := iter.Error(); err != nil {
+		return nil, "", err
+	}
+
+	return txs, "", nil
+}
+
+// 资金流向统计
+type addrFlow struct {
+	spent    *big.Int
+	received *big.Int
+}
+
+// 索引交易涉及的地址，返回写入的key
+func (t *Indexer) indexAddrTx(batch kvdb.Batch, bcName string, block *lpb.InternalBlock,
+	idx int, tx *lpb.Transaction) ([][]byte, error) {
+	flows := make(map[string]*addrFlow)
+	getFlow := func(addr string) *addrFlow {
+		if _, ok := flows[addr]; !ok {
+			flows[addr] = &addrFlow{spent: new(big.Int), received: new(big.Int)}
+		}
+		return flows[addr]
+	}
+
+	for _, input := range tx.GetTxInputs() {
+		flow := getFlow(string(input.GetFromAddr()))
+		flow.spent.Add(flow.spent, new(big.Int).SetBytes(input.GetAmount()))
+	}
+	for _, output := range tx.GetTxOutputs() {
+		if string(output.GetToAddr()) == feeAddress {
+			continue
+		}
+		flow := getFlow(string(output.GetToAddr()))
+		flow.received.Add(flow.received, new(big.Int).SetBytes(output.GetAmount()))
+	}
+	// 发起者和需要背书的账户/地址即使没有转账也记录
+	getFlow(tx.GetInitiator())
+	for _, authRequire := range tx.GetAuthRequire() {
+		for _, addr := range strings.Split(authRequire, "/") {
+			getFlow(addr)
+		}
+	}
+
+	keys := make([][]byte, 0, len(flows))
+	for addr, flow := range flows {
+		if addr == "" || strings.Contains(addr, "/") {
+			continue
+		}
+
+		rec := &AddressTx{
+			Height:    block.GetHeight(),
+			Txid:      tx.GetTxid(),
+			Direction: DirectionNone,
+			Amount:    "0",
+			Timestamp: tx.GetTimestamp(),
+		}
+		switch flow.spent.Cmp(flow.received) {
+		case 1:
+			rec.Direction = DirectionOut
+			rec.Amount = new(big.Int).Sub(flow.spent, flow.received).String()
+		case -1:
+			rec.Direction = DirectionIn
+			rec.Amount = new(big.Int).Sub(flow.received, flow.spent).String()
+		}
+
+		val, err := json.Marshal(rec)
+		if err != nil {
+			return nil, err
+		}
+		key := addrTxKey(bcName, addr, block.GetHeight(), idx)
+		if err := batch.Put(key, val); err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+func addrTxKeyPrefix(bcName, address string) string {
+	return fmt.Sprintf("%s%s/%s/", addrTxPrefix, bcName, address)
+}
+
+// 高度和块内序号取反，使前缀遍历按从新到旧排列
+func addrTxKey(bcName, address string, height int64, idx int) []byte {
+	return []byte(fmt.Sprintf("%s%020d/%010d", addrTxKeyPrefix(bcName, address),
+		math.MaxInt64-height, math.MaxInt32-idx))
+}
@@ -0,0 +1,119 @@
+package index
+
+import (
+	"io/ioutil"
+	"math/big"
+	"os"
+	"testing"
+
+	lpb "github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
+	"github.com/xuperchain/xupercore/kernel/common/xconfig"
+	"github.com/xuperchain/xupercore/protos"
+
+	sconf "github.com/xuperchain/xuperos/common/config"
+)
+
+func TestAddressTxs(t *testing.T) {
+	dir, err := ioutil.TempDir("", "index")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	scfg := sconf.GetDefServConf()
+	scfg.EnableAddrIndex = true
+	indexer, err := OpenIndexer(scfg, &xconfig.EnvConf{RootPath: dir, DataDir: "data"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer indexer.Close()
+	idx := indexer.indexes[0]
+
+	amount := func(n int64) []byte { return big.NewInt(n).Bytes() }
+	// 每个区块alice转给bob 10，找零给自己，手续费1
+	var recs []*blockRecord
+	for height := int64(0); height < 5; height++ {
+		tx := &lpb.Transaction{
+			Txid:      []byte{byte(height)},
+			Initiator: "alice",
+			TxInputs:  []*protos.TxInput{{FromAddr: []byte("alice"), Amount: amount(100)}},
+			TxOutputs: []*protos.TxOutput{
+				{ToAddr: []byte("bob"), Amount: amount(10)},
+				{ToAddr: []byte("alice"), Amount: amount(89)},
+				{ToAddr: []byte("$"), Amount: amount(1)},
+			},
+		}
+		block := &lpb.InternalBlock{Blockid: []byte{byte(height)}, Height: height,
+			Transactions: []*lpb.Transaction{tx}}
+		batch := indexer.db.NewBatch()
+		if err := indexer.indexBlock(batch, idx, "xuper", block); err != nil {
+			t.Fatal(err)
+		}
+		if err := batch.Write(); err != nil {
+			t.Fatal(err)
+		}
+		rec, err := indexer.getBlockRecord(idx, "xuper", height)
+		if err != nil {
+			t.Fatal(err)
+		}
+		recs = append(recs, rec)
+	}
+
+	// 分页从新到旧遍历
+	var heights []int64
+	cursor := ""
+	for {
+		txs, next, err := indexer.GetAddressTxs("xuper", "bob", cursor, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, tx := range txs {
+			if tx.Direction != DirectionIn || tx.Amount != "10" {
+				t.Errorf("unexpected bob tx %+v", tx)
+			}
+			heights = append(heights, tx.Height)
+		}
+		if next == "" {
+			break
+		}
+		cursor = next
+	}
+	if len(heights) != 5 || heights[0] != 4 || heights[4] != 0 {
+		t.Errorf("unexpected heights %v", heights)
+	}
+
+	txs, _, err := indexer.GetAddressTxs("xuper", "alice", "", 0)
+	if err != nil || len(txs) != 5 {
+		t.Fatalf("unexpected alice txs %v, err %v", txs, err)
+	}
+	if txs[0].Direction != DirectionOut || txs[0].Amount != "11" {
+		t.Errorf("unexpected alice tx %+v", txs[0])
+	}
+
+	// 回滚最新区块
+	if err := indexer.undoBlock(idx, "xuper", 4, recs[4]); err != nil {
+		t.Fatal(err)
+	}
+	txs, _, _ = indexer.GetAddressTxs("xuper", "bob", "", 0)
+	if len(txs) != 4 || txs[0].Height != 3 {
+		t.Errorf("unexpected txs after undo %v", txs)
+	}
+	if height, _ := indexer.getIndexedHeight(idx, "xuper"); height != 3 {
+		t.Errorf("unexpected indexed height %d", height)
+	}
+
+	if _, _, err := indexer.GetAddressTxs("xuper", "bob", "bad", 0); err != ErrInvalidCursor {
+		t.Errorf("expect invalid cursor, actual %v", err)
+	}
+
+	if err := indexer.Reset("xuper"); err != nil {
+		t.Fatal(err)
+	}
+	txs, _, _ = indexer.GetAddressTxs("xuper", "bob", "", 0)
+	if len(txs) != 0 {
+		t.Errorf("unexpected txs after reset %v", txs)
+	}
+	if height, _ := indexer.getIndexedHeight(idx, "xuper"); height != -1 {
+		t.Errorf("unexpected indexed height %d", height)
+	}
+}
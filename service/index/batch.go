@@ -0,0 +1,95 @@
+package index
+
+import (
+	ldef "github.com/xuperchain/xupercore/bcs/ledger/xledger/def"
+	"github.com/xuperchain/xupercore/lib/storage/kvdb"
+)
+
+// 可变状态修改前的值，Value为nil表示修改前不存在
+type undoEntry struct {
+	Key   []byte `json:"key"`
+	Value []byte `json:"value"`
+}
+
+// 可以读到未提交写入的批次，同一批次跨多个区块时可变状态也能正确累加
+// 每个区块首次修改某个key时记录修改前的值，用于分叉回滚
+type stateBatch struct {
+	kvdb.Batch
+	db kvdb.Database
+	// 未提交的写入，value为nil表示已删除
+	pending map[string][]byte
+	touched map[string]bool
+	undo    []*undoEntry
+}
+
+func newStateBatch(db kvdb.Database, batch kvdb.Batch) *stateBatch {
+	return &stateBatch{
+		Batch:   batch,
+		db:      db,
+		pending: make(map[string][]byte),
+	}
+}
+
+// 开始记录一个区块的修改
+func (t *stateBatch) beginBlock() {
+	t.touched = make(map[string]bool)
+	t.undo = nil
+}
+
+// 结束记录，返回区块的回滚记录
+func (t *stateBatch) endBlock() []*undoEntry {
+	undo := t.undo
+	t.touched = nil
+	t.undo = nil
+	return undo
+}
+
+// 读取key的最新值，不存在返回nil
+func (t *stateBatch) Get(key []byte) ([]byte, error) {
+	if val, ok := t.pending[string(key)]; ok {
+		return val, nil
+	}
+	return getKV(t.db, key)
+}
+
+func (t *stateBatch) Put(key, value []byte) error {
+	if err := t.record(key); err != nil {
+		return err
+	}
+	t.pending[string(key)] = append([]byte{}, value...)
+	return t.Batch.Put(key, value)
+}
+
+func (t *stateBatch) Delete(key []byte) error {
+	if err := t.record(key); err != nil {
+		return err
+	}
+	t.pending[string(key)] = nil
+	return t.Batch.Delete(key)
+}
+
+// 区块内首次修改时记录旧值，未调用beginBlock时不记录
+func (t *stateBatch) record(key []byte) error {
+	if t.touched == nil || t.touched[string(key)] {
+		return nil
+	}
+	old, err := t.Get(key)
+	if err != nil {
+		return err
+	}
+	t.touched[string(key)] = true
+	t.undo = append(t.undo, &undoEntry{Key: append([]byte{}, key...), Value: old})
+	return nil
+}
+
+// 读取key，不存在返回nil
+func getKV(db kvdb.Database, key []byte) ([]byte, error) {
+	val, err := db.Get(key)
+	if err != nil {
+		if ldef.NormalizedKVError(err) == ldef.ErrKVNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return val, nil
+}
@@ -0,0 +1,171 @@
+package index
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	lpb "github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
+	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+	"github.com/xuperchain/xupercore/lib/storage/kvdb"
+
+	"github.com/xuperchain/xuperos/models"
+)
+
+const (
+	eventPrefix         = "E/"
+	contractEventPrefix = "C/"
+
+	DefContractEventsLimit = 20
+	MaxContractEventsLimit = 100
+	// 单次查询最多遍历的事件数，过滤条件命中率低时提前返回游标
+	maxEventsScan = 10000
+)
+
+var (
+	ErrEventIndexDisabled = ecom.ErrForbidden.More("contract event index not enabled")
+
+	eventCursorRegex = regexp.MustCompile(`^\d{20}/\d{10}/\d{6}$`)
+)
+
+// 合约事件过滤条件，字段为空不过滤，EndHeight为0不限制
+type EventFilter struct {
+	Contract    string
+	EventName   string
+	Initiator   string
+	StartHeight int64
+	EndHeight   int64
+}
+
+// 合约事件记录
+type ContractEvent struct {
+	Contract  string `json:"contract"`
+	Name      string `json:"name"`
+	Body      []byte `json:"body"`
+	Txid      []byte `json:"txid"`
+	Height    int64  `json:"height"`
+	Initiator string `json:"initiator"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// 分页查询合约事件，按高度从旧到新返回，nextCursor为空表示没有更多数据
+// 遍历数量达到上限时即使结果不足limit也会返回游标
+func (t *Indexer) QueryContractEvents(bcName string, filter *EventFilter, cursor string,
+	limit int) ([]*ContractEvent, string, error) {
+	if t == nil || !t.scfg.EnableEventIndex {
+		return nil, "", ErrEventIndexDisabled
+	}
+	if bcName == "" || filter == nil || strings.Contains(filter.Contract, "/") {
+		return nil, "", ecom.ErrParameter
+	}
+	if filter.StartHeight < 0 || (filter.EndHeight != 0 && filter.EndHeight < filter.StartHeight) {
+		return nil, "", ecom.ErrParameter.More("invalid height range")
+	}
+	if cursor != "" && !eventCursorRegex.MatchString(cursor) {
+		return nil, "", ErrInvalidCursor
+	}
+	if limit <= 0 {
+		limit = DefContractEventsLimit
+	}
+	if limit > MaxContractEventsLimit {
+		limit = MaxContractEventsLimit
+	}
+
+	// 指定合约时走合约维度的key
+	prefix := eventKeyPrefix(bcName)
+	if filter.Contract != "" {
+		prefix = contractEventKeyPrefix(bcName, filter.Contract)
+	}
+	start := []byte(fmt.Sprintf("%s%020d", prefix, filter.StartHeight))
+	if cursor != "" && prefix+cursor >= string(start) {
+		// 从游标之后的第一条开始
+		start = append([]byte(prefix+cursor), 0)
+	}
+	end := []byte(prefix[:len(prefix)-1] + "0")
+	if filter.EndHeight > 0 {
+		end = []byte(fmt.Sprintf("%s%020d", prefix, filter.EndHeight+1))
+	}
+
+	iter := t.db.NewIteratorWithRange(start, end)
+	defer iter.Release()
+
+	events := make([]*ContractEvent, 0, limit)
+	lastKey := ""
+	for scanned := 0; iter.Next(); scanned++ {
+		if len(events) >= limit || scanned >= maxEventsScan {
+			return events, strings.TrimPrefix(lastKey, prefix), nil
+		}
+		lastKey = string(iter.Key())
+
+		event := &ContractEvent{}
+		if err := json.Unmarshal(iter.Value(), event); err != nil {
+			return nil, "", err
+		}
+		if filter.EventName != "" && event.Name != filter.EventName {
+			continue
+		}
+		if filter.Initiator != "" && event.Initiator != filter.Initiator {
+			continue
+		}
+		events = append(events, event)
+	}
+	if err := iter.Error(); err != nil {
+		return nil, "", err
+	}
+
+	return events, "", nil
+}
+
+// 索引交易中的合约事件，返回写入的key
+func (t *Indexer) indexContractEvent(batch kvdb.Batch, bcName string, block *lpb.InternalBlock,
+	idx int, tx *lpb.Transaction) ([][]byte, error) {
+	events, err := models.ParseContractEvents(tx)
+	if err != nil {
+		return nil, fmt.Errorf("parse contract events failed.txid:%x,err:%v", tx.GetTxid(), err)
+	}
+
+	keys := make([][]byte, 0, 2*len(events))
+	for i, event := range events {
+		rec := &ContractEvent{
+			Contract:  event.GetContract(),
+			Name:      event.GetName(),
+			Body:      event.GetBody(),
+			Txid:      tx.GetTxid(),
+			Height:    block.GetHeight(),
+			Initiator: tx.GetInitiator(),
+			Timestamp: tx.GetTimestamp(),
+		}
+		val, err := json.Marshal(rec)
+		if err != nil {
+			return nil, err
+		}
+
+		suffix := eventKeySuffix(block.GetHeight(), idx, i)
+		eventKeys := [][]byte{[]byte(eventKeyPrefix(bcName) + suffix)}
+		if rec.Contract != "" && !strings.Contains(rec.Contract, "/") {
+			eventKeys = append(eventKeys, []byte(contractEventKeyPrefix(bcName, rec.Contract)+suffix))
+		}
+		for _, key := range eventKeys {
+			if err := batch.Put(key, val); err != nil {
+				return nil, err
+			}
+		}
+		keys = append(keys, eventKeys...)
+	}
+
+	return keys, nil
+}
+
+func eventKeyPrefix(bcName string) string {
+	return fmt.Sprintf("%s%s/", eventPrefix, bcName)
+}
+
+func contractEventKeyPrefix(bcName, contract string) string {
+	return fmt.Sprintf("%s%s/%s/", contractEventPrefix, bcName, contract)
+}
+
+// 高度、块内交易序号、交易内事件序号，前缀遍历按从旧到新排列
+func eventKeySuffix(height int64, txIdx, eventIdx int) string {
+	return fmt.Sprintf("%020d/%010d/%06d", height, txIdx, eventIdx)
+}
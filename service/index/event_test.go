@@ -0,0 +1,113 @@
+package index
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/state/xmodel"
+	lpb "github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
+	"github.com/xuperchain/xupercore/kernel/common/xconfig"
+	"github.com/xuperchain/xupercore/protos"
+
+	sconf "github.com/xuperchain/xuperos/common/config"
+)
+
+func TestContractEvents(t *testing.T) {
+	dir, err := ioutil.TempDir("", "index")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	scfg := sconf.GetDefServConf()
+	scfg.EnableEventIndex = true
+	indexer, err := OpenIndexer(scfg, &xconfig.EnvConf{RootPath: dir, DataDir: "data"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer indexer.Close()
+	idx := indexer.indexes[0]
+
+	// 每个区块counter合约发出increase和reset事件，发起者交替为alice和bob
+	for height := int64(0); height < 10; height++ {
+		buf, err := xmodel.MarshalMessages([]*protos.ContractEvent{
+			{Contract: "counter", Name: "increase", Body: []byte(fmt.Sprint(height))},
+			{Contract: "counter", Name: "reset"},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		initiator := "alice"
+		if height%2 == 1 {
+			initiator = "bob"
+		}
+		tx := &lpb.Transaction{
+			Txid:      []byte{byte(height)},
+			Initiator: initiator,
+			TxOutputsExt: []*protos.TxOutputExt{
+				{Bucket: xmodel.TransientBucket, Key: []byte("contractEvent"), Value: buf},
+			},
+		}
+		block := &lpb.InternalBlock{Blockid: []byte{byte(height)}, Height: height,
+			Transactions: []*lpb.Transaction{tx, {Txid: []byte("empty")}}}
+		batch := indexer.db.NewBatch()
+		if err := indexer.indexBlock(batch, idx, "xuper", block); err != nil {
+			t.Fatal(err)
+		}
+		if err := batch.Write(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// 分页从旧到新遍历
+	filter := &EventFilter{Contract: "counter", EventName: "increase", Initiator: "bob",
+		StartHeight: 2, EndHeight: 7}
+	var heights []int64
+	cursor := ""
+	for {
+		events, next, err := indexer.QueryContractEvents("xuper", filter, cursor, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, event := range events {
+			if event.Name != "increase" || event.Initiator != "bob" ||
+				string(event.Body) != fmt.Sprint(event.Height) {
+				t.Errorf("unexpected event %+v", event)
+			}
+			heights = append(heights, event.Height)
+		}
+		if next == "" {
+			break
+		}
+		cursor = next
+	}
+	if fmt.Sprint(heights) != "[3 5 7]" {
+		t.Errorf("unexpected heights %v", heights)
+	}
+
+	events, _, err := indexer.QueryContractEvents("xuper", &EventFilter{}, "", 0)
+	if err != nil || len(events) != 20 {
+		t.Fatalf("unexpected events %v, err %v", events, err)
+	}
+	events, _, _ = indexer.QueryContractEvents("xuper", &EventFilter{Contract: "other"}, "", 0)
+	if len(events) != 0 {
+		t.Errorf("unexpected other contract events %v", events)
+	}
+
+	if _, _, err := indexer.QueryContractEvents("xuper", &EventFilter{}, "bad", 0); err != ErrInvalidCursor {
+		t.Errorf("expect invalid cursor, actual %v", err)
+	}
+	if _, _, err := indexer.GetAddressTxs("xuper", "alice", "", 0); err != ErrIndexDisabled {
+		t.Errorf("expect address index disabled, actual %v", err)
+	}
+
+	if err := indexer.Reset("xuper"); err != nil {
+		t.Fatal(err)
+	}
+	events, _, _ = indexer.QueryContractEvents("xuper", &EventFilter{Contract: "counter"}, "", 0)
+	if len(events) != 0 {
+		t.Errorf("unexpected events after reset %v", events)
+	}
+}
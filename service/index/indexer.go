@@ -0,0 +1,376 @@
+package index
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	ldef "github.com/xuperchain/xupercore/bcs/ledger/xledger/def"
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/ledger"
+	lpb "github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
+	"github.com/xuperchain/xupercore/kernel/common/xconfig"
+	"github.com/xuperchain/xupercore/kernel/engines"
+	"github.com/xuperchain/xupercore/kernel/engines/xuperos"
+	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+	"github.com/xuperchain/xupercore/lib/logs"
+	"github.com/xuperchain/xupercore/lib/storage/kvdb"
+	_ "github.com/xuperchain/xupercore/lib/storage/kvdb/leveldb"
+	"github.com/xuperchain/xupercore/lib/utils"
+
+	"github.com/xuperchain/xuperos/common/chainmgr"
+	sconf "github.com/xuperchain/xuperos/common/config"
+	"github.com/xuperchain/xuperos/common/def"
+	"github.com/xuperchain/xuperos/common/loglevel"
+)
+
+const (
+	// 跟块轮询间隔
+	followInterval = time.Second
+	// 每批写入的区块数
+	blocksPerBatch = 100
+
+	metaPrefix  = "M/"
+	blockPrefix = "B/"
+)
+
+// 区块索引记录，用于分叉时回滚该区块写入的索引
+type blockRecord struct {
+	Blockid []byte   `json:"blockid"`
+	Keys    [][]byte `json:"keys"`
+	// 可变状态被修改前的值，回滚时恢复
+	Undo []*undoEntry `json:"undo,omitempty"`
+}
+
+// 子索引，各自记录同步高度，开启新的子索引时可以单独补齐
+type subIndex struct {
+	name string
+	// 数据key前缀，key格式为prefix+bcName+"/"+...
+	prefixes []string
+	// 索引一笔交易，返回写入的key
+	indexTx func(batch kvdb.Batch, bcName string, block *lpb.InternalBlock,
+		idx int, tx *lpb.Transaction) ([][]byte, error)
+	// 维护可变状态的索引按区块处理，修改前的值由stateBatch记录
+	indexBlock func(batch *stateBatch, bcName string, block *lpb.InternalBlock) error
+}
+
+// 服务层二级索引，跟随账本主干区块维护，存储在本地leveldb
+type Indexer struct {
+	scfg     *sconf.ServConf
+	engine   ecom.Engine
+	log      logs.Logger
+	db       kvdb.Database
+	indexes  []*subIndex
+	exitCh   chan struct{}
+	isInit   bool
+	exitOnce *sync.Once
+}
+
+// 节点运行时实例化索引服务
+func NewIndexer(scfg *sconf.ServConf, engine engines.BCEngine) (*Indexer, error) {
+	if scfg == nil || engine == nil {
+		return nil, fmt.Errorf("param error")
+	}
+	xosEngine, err := xuperos.EngineConvert(engine)
+	if err != nil {
+		return nil, fmt.Errorf("not xuperos engine")
+	}
+
+	obj, err := OpenIndexer(scfg, xosEngine.Context().EnvCfg)
+	if err != nil {
+		return nil, err
+	}
+	obj.engine = xosEngine
+
+	return obj, nil
+}
+
+// 打开索引存储，节点停止时可用于离线重建索引
+func OpenIndexer(scfg *sconf.ServConf, envCfg *xconfig.EnvConf) (*Indexer, error) {
+	if scfg == nil || envCfg == nil {
+		return nil, fmt.Errorf("param error")
+	}
+
+	log, _ := loglevel.NewLogger("", def.SubModName)
+	db, err := kvdb.CreateKVInstance(&kvdb.KVParameter{
+		DBPath:                envCfg.GenDataAbsPath(scfg.IndexDir),
+		KVEngineType:          kvdb.KVEngineTypeLDB,
+		StorageType:           kvdb.StorageTypeSingle,
+		MemCacheSize:          64,
+		FileHandlersCacheSize: 64,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("open index db failed.path:%s,err:%v",
+			envCfg.GenDataAbsPath(scfg.IndexDir), err)
+	}
+
+	obj := &Indexer{
+		scfg:     scfg,
+		log:      log,
+		db:       db,
+		exitCh:   make(chan struct{}),
+		isInit:   true,
+		exitOnce: &sync.Once{},
+	}
+	if scfg.EnableAddrIndex {
+		obj.indexes = append(obj.indexes, &subIndex{
+			name:     "addr",
+			prefixes: []string{addrTxPrefix},
+			indexTx:  obj.indexAddrTx,
+		})
+	}
+	if scfg.EnableEventIndex {
+		obj.indexes = append(obj.indexes, &subIndex{
+			name:     "event",
+			prefixes: []string{eventPrefix, contractEventPrefix},
+			indexTx:  obj.indexContractEvent,
+		})
+	}
+	if scfg.EnableTokenIndex {
+		obj.indexes = append(obj.indexes, &subIndex{
+			name:       "token",
+			prefixes:   []string{tokenPrefix},
+			indexBlock: obj.indexTokenBlock,
+		})
+	}
+
+	return obj, nil
+}
+
+// 跟随各链主干更新索引，阻塞直到退出
+func (t *Indexer) Run() error {
+	if !t.isInit || t.engine == nil {
+		return errors.New("indexer not init")
+	}
+	defer t.db.Close()
+
+	ticker := time.NewTicker(followInterval)
+	defer ticker.Stop()
+	for {
+		for _, bcName := range t.engine.GetChains() {
+			chain, release, err := chainmgr.AcquireChain(t.engine, bcName)
+			if err != nil {
+				continue
+			}
+			err = t.SyncLedger(bcName, chain.Context().Ledger)
+			release()
+			if err != nil {
+				t.log.Warn("sync index failed", "bcName", bcName, "err", err)
+			}
+		}
+
+		select {
+		case <-t.exitCh:
+			t.log.Trace("indexer exit")
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// 退出索引服务，需要幂等
+func (t *Indexer) Exit() {
+	if !t.isInit {
+		return
+	}
+
+	t.exitOnce.Do(func() {
+		close(t.exitCh)
+	})
+}
+
+// 关闭离线打开的索引存储
+func (t *Indexer) Close() {
+	t.db.Close()
+}
+
+// 同步账本主干到各子索引
+func (t *Indexer) SyncLedger(bcName string, l *ledger.Ledger) error {
+	if l == nil {
+		return fmt.Errorf("ledger not ready")
+	}
+
+	for _, idx := range t.indexes {
+		if err := t.syncIndex(idx, bcName, l); err != nil {
+			return fmt.Errorf("sync %s index failed.err:%v", idx.name, err)
+		}
+	}
+	return nil
+}
+
+// 清空某条链的全部索引，用于重建
+func (t *Indexer) Reset(bcName string) error {
+	for _, idx := range t.indexes {
+		if err := t.db.Delete(metaKey(idx, bcName)); err != nil {
+			return err
+		}
+
+		prefixes := []string{blockPrefix + idx.name + "/"}
+		prefixes = append(prefixes, idx.prefixes...)
+		for _, prefix := range prefixes {
+			if err := t.deletePrefix([]byte(prefix + bcName + "/")); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// 先回滚已分叉的区块再追加新区块
+func (t *Indexer) syncIndex(idx *subIndex, bcName string, l *ledger.Ledger) error {
+	height, err := t.getIndexedHeight(idx, bcName)
+	if err != nil {
+		return err
+	}
+	for ; height >= 0; height-- {
+		rec, err := t.getBlockRecord(idx, bcName, height)
+		if err != nil {
+			return err
+		}
+		block, err := l.QueryBlockByHeight(height)
+		if err == nil && string(block.GetBlockid()) == string(rec.Blockid) {
+			break
+		}
+		if err := t.undoBlock(idx, bcName, height, rec); err != nil {
+			return err
+		}
+		t.log.Info("index rollback block", "index", idx.name, "bcName", bcName,
+			"height", height, "blockid", utils.F(rec.Blockid))
+	}
+
+	tipHeight := l.GetMeta().GetTrunkHeight()
+	for height++; height <= tipHeight; {
+		batch := newStateBatch(t.db, t.db.NewBatch())
+		end := height + blocksPerBatch
+		for ; height < end && height <= tipHeight; height++ {
+			block, err := l.QueryBlockByHeight(height)
+			if err != nil {
+				return fmt.Errorf("query block failed.height:%d,err:%v", height, err)
+			}
+			if err := t.indexBlock(batch, idx, bcName, block); err != nil {
+				return err
+			}
+		}
+		batch.Put(metaKey(idx, bcName), []byte(strconv.FormatInt(height-1, 10)))
+		if err := batch.Write(); err != nil {
+			return fmt.Errorf("write index failed.err:%v", err)
+		}
+
+		select {
+		case <-t.exitCh:
+			return nil
+		default:
+		}
+	}
+
+	return nil
+}
+
+func (t *Indexer) deletePrefix(prefix []byte) error {
+	batch := t.db.NewBatch()
+	iter := t.db.NewIteratorWithPrefix(prefix)
+	for iter.Next() {
+		batch.Delete(append([]byte{}, iter.Key()...))
+	}
+	iter.Release()
+	if err := iter.Error(); err != nil {
+		return err
+	}
+
+	return batch.Write()
+}
+
+// 已索引的最新高度，未索引返回-1
+func (t *Indexer) getIndexedHeight(idx *subIndex, bcName string) (int64, error) {
+	val, err := t.db.Get(metaKey(idx, bcName))
+	if err != nil {
+		if ldef.NormalizedKVError(err) == ldef.ErrKVNotFound {
+			return -1, nil
+		}
+		return 0, err
+	}
+
+	return strconv.ParseInt(string(val), 10, 64)
+}
+
+func (t *Indexer) getBlockRecord(idx *subIndex, bcName string, height int64) (*blockRecord, error) {
+	val, err := t.db.Get(blockKey(idx, bcName, height))
+	if err != nil {
+		return nil, fmt.Errorf("get index block record failed.height:%d,err:%v", height, err)
+	}
+
+	rec := &blockRecord{}
+	if err := json.Unmarshal(val, rec); err != nil {
+		return nil, err
+	}
+	return rec, nil
+}
+
+func (t *Indexer) indexBlock(batch kvdb.Batch, idx *subIndex, bcName string,
+	block *lpb.InternalBlock) error {
+	rec := &blockRecord{Blockid: block.GetBlockid()}
+	if idx.indexTx != nil {
+		for i, tx := range block.GetTransactions() {
+			keys, err := idx.indexTx(batch, bcName, block, i, tx)
+			if err != nil {
+				return err
+			}
+			rec.Keys = append(rec.Keys, keys...)
+		}
+	}
+	if idx.indexBlock != nil {
+		sb, ok := batch.(*stateBatch)
+		if !ok {
+			sb = newStateBatch(t.db, batch)
+		}
+		sb.beginBlock()
+		if err := idx.indexBlock(sb, bcName, block); err != nil {
+			return err
+		}
+		rec.Undo = sb.endBlock()
+	}
+
+	val, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+	return batch.Put(blockKey(idx, bcName, block.GetHeight()), val)
+}
+
+func (t *Indexer) undoBlock(idx *subIndex, bcName string, height int64, rec *blockRecord) error {
+	batch := t.db.NewBatch()
+	for _, key := range rec.Keys {
+		batch.Delete(key)
+	}
+	for _, entry := range rec.Undo {
+		if entry.Value == nil {
+			batch.Delete(entry.Key)
+		} else {
+			batch.Put(entry.Key, entry.Value)
+		}
+	}
+	batch.Delete(blockKey(idx, bcName, height))
+	batch.Put(metaKey(idx, bcName), []byte(strconv.FormatInt(height-1, 10)))
+
+	return batch.Write()
+}
+
+func (t *Indexer) getSubIndex(name string) *subIndex {
+	for _, idx := range t.indexes {
+		if idx.name == name {
+			return idx
+		}
+	}
+	return nil
+}
+
+func metaKey(idx *subIndex, bcName string) []byte {
+	return []byte(metaPrefix + idx.name + "/" + bcName)
+}
+
+func blockKey(idx *subIndex, bcName string, height int64) []byte {
+	return []byte(fmt.Sprintf("%s%s/%s/%020d", blockPrefix, idx.name, bcName, height))
+}
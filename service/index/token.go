@@ -0,0 +1,302 @@
+package index
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"strings"
+
+	lpb "github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
+	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+)
+
+const (
+	tokenPrefix = "T/"
+
+	DefTokenHoldersLimit = 10
+	MaxTokenHoldersLimit = 100
+	// 持有者排行key中余额补齐的十进制位数
+	rankDigits = 64
+)
+
+var (
+	ErrTokenIndexDisabled = ecom.ErrForbidden.More("token index not enabled")
+	ErrHeightNotIndexed   = ecom.ErrForbidden.More("height not indexed yet")
+)
+
+// 代币统计
+type TokenStats struct {
+	// 统计对应的已索引高度
+	Height       int64
+	TotalSupply  string
+	FrozenAmount string
+	HolderCount  int64
+	TopHolders   []*TokenHolder
+}
+
+type TokenHolder struct {
+	Address string
+	Balance string
+}
+
+// 随区块累加的汇总数据
+type tokenSummary struct {
+	TotalSupply string `json:"total_supply"`
+	HolderCount int64  `json:"holder_count"`
+}
+
+// 查询代币总量、冻结金额、持有者数量和余额前limit名的持有者
+func (t *Indexer) GetTokenStats(bcName string, limit int) (*TokenStats, error) {
+	if t == nil || !t.scfg.EnableTokenIndex {
+		return nil, ErrTokenIndexDisabled
+	}
+	if bcName == "" || strings.Contains(bcName, "/") {
+		return nil, ecom.ErrParameter
+	}
+	if limit <= 0 {
+		limit = DefTokenHoldersLimit
+	}
+	if limit > MaxTokenHoldersLimit {
+		limit = MaxTokenHoldersLimit
+	}
+
+	height, err := t.getIndexedHeight(t.getSubIndex("token"), bcName)
+	if err != nil {
+		return nil, err
+	}
+	prefix := tokenKeyPrefix(bcName)
+	summary, err := getTokenSummary(func(key []byte) ([]byte, error) {
+		return getKV(t.db, key)
+	}, prefix)
+	if err != nil {
+		return nil, err
+	}
+	stats := &TokenStats{
+		Height:      height,
+		TotalSupply: summary.TotalSupply,
+		HolderCount: summary.HolderCount,
+		TopHolders:  make([]*TokenHolder, 0, limit),
+	}
+
+	// 解冻高度大于已索引高度的输出仍处于冻结状态
+	frozen := new(big.Int)
+	iter := t.db.NewIteratorWithRange([]byte(fmt.Sprintf("%sf/%020d", prefix, height+1)),
+		[]byte(prefix+"f0"))
+	for iter.Next() {
+		amount, ok := new(big.Int).SetString(string(iter.Value()), 10)
+		if !ok {
+			iter.Release()
+			return nil, fmt.Errorf("invalid frozen amount.key:%s", iter.Key())
+		}
+		frozen.Add(frozen, amount)
+	}
+	iter.Release()
+	if err := iter.Error(); err != nil {
+		return nil, err
+	}
+	stats.FrozenAmount = frozen.String()
+
+	iter = t.db.NewIteratorWithPrefix([]byte(prefix + "r/"))
+	defer iter.Release()
+	for iter.Next() && len(stats.TopHolders) < limit {
+		rank := strings.TrimPrefix(string(iter.Key()), prefix+"r/")
+		stats.TopHolders = append(stats.TopHolders, &TokenHolder{
+			Address: string(iter.Value()),
+			Balance: rankBalance(rank[:rankDigits]),
+		})
+	}
+	if err := iter.Error(); err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// 查询地址在指定高度的余额，取该高度及之前最近一次余额变化的检查点
+func (t *Indexer) GetBalanceAt(bcName, address string, height int64) (string, error) {
+	if t == nil || !t.scfg.EnableTokenIndex {
+		return "", ErrTokenIndexDisabled
+	}
+	if bcName == "" || strings.Contains(bcName, "/") || address == "" ||
+		strings.Contains(address, "/") || height < 0 {
+		return "", ecom.ErrParameter
+	}
+
+	indexedHeight, err := t.getIndexedHeight(t.getSubIndex("token"), bcName)
+	if err != nil {
+		return "", err
+	}
+	if height > indexedHeight {
+		return "", ErrHeightNotIndexed
+	}
+
+	prefix := tokenKeyPrefix(bcName) + "h/" + address + "/"
+	iter := t.db.NewIteratorWithRange([]byte(prefix), tokenHistoryKey(tokenKeyPrefix(bcName), address, height+1))
+	defer iter.Release()
+	if !iter.Last() {
+		return "0", iter.Error()
+	}
+	return string(iter.Value()), nil
+}
+
+// 按区块内的utxo变化更新地址余额、持有者排行和汇总数据
+func (t *Indexer) indexTokenBlock(batch *stateBatch, bcName string, block *lpb.InternalBlock) error {
+	prefix := tokenKeyPrefix(bcName)
+	deltas := make(map[string]*big.Int)
+	addDelta := func(addr string, amount *big.Int) {
+		if _, ok := deltas[addr]; !ok {
+			deltas[addr] = new(big.Int)
+		}
+		deltas[addr].Add(deltas[addr], amount)
+	}
+
+	for _, tx := range block.GetTransactions() {
+		for _, input := range tx.GetTxInputs() {
+			amount := new(big.Int).SetBytes(input.GetAmount())
+			addDelta(string(input.GetFromAddr()), amount.Neg(amount))
+		}
+		for offset, output := range tx.GetTxOutputs() {
+			// 手续费上链后归属矿工
+			addr := string(output.GetToAddr())
+			if addr == feeAddress {
+				addr = string(block.GetProposer())
+			}
+			amount := new(big.Int).SetBytes(output.GetAmount())
+			addDelta(addr, amount)
+
+			// 冻结高度为-1表示永久冻结
+			frozenHeight := output.GetFrozenHeight()
+			if frozenHeight == -1 {
+				frozenHeight = math.MaxInt64
+			}
+			if frozenHeight > block.GetHeight() && amount.Sign() > 0 {
+				key := fmt.Sprintf("%sf/%020d/%x/%d", prefix, frozenHeight, tx.GetTxid(), offset)
+				if err := batch.Put([]byte(key), []byte(amount.String())); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	summary, err := getTokenSummary(batch.Get, prefix)
+	if err != nil {
+		return err
+	}
+	supply, ok := new(big.Int).SetString(summary.TotalSupply, 10)
+	if !ok {
+		return fmt.Errorf("invalid total supply:%s", summary.TotalSupply)
+	}
+
+	// 固定处理顺序，保证回滚记录稳定
+	addrs := make([]string, 0, len(deltas))
+	for addr := range deltas {
+		addrs = append(addrs, addr)
+	}
+	sort.Strings(addrs)
+	for _, addr := range addrs {
+		delta := deltas[addr]
+		if addr == "" || delta.Sign() == 0 {
+			continue
+		}
+		oldBalance, err := getTokenBalance(batch, prefix, addr)
+		if err != nil {
+			return err
+		}
+		newBalance := new(big.Int).Add(oldBalance, delta)
+		if err := updateTokenBalance(batch, prefix, addr, oldBalance, newBalance); err != nil {
+			return err
+		}
+		// 记录余额变化检查点，用于查询历史余额
+		err = batch.Put(tokenHistoryKey(prefix, addr, block.GetHeight()), []byte(newBalance.String()))
+		if err != nil {
+			return err
+		}
+		if oldBalance.Sign() <= 0 && newBalance.Sign() > 0 {
+			summary.HolderCount++
+		}
+		if oldBalance.Sign() > 0 && newBalance.Sign() <= 0 {
+			summary.HolderCount--
+		}
+		supply.Add(supply, delta)
+	}
+
+	summary.TotalSupply = supply.String()
+	val, err := json.Marshal(summary)
+	if err != nil {
+		return err
+	}
+	return batch.Put([]byte(prefix+"s"), val)
+}
+
+// 写入新余额并调整排行key，余额不为正时不参与排行
+func updateTokenBalance(batch *stateBatch, prefix, addr string, oldBalance, newBalance *big.Int) error {
+	if oldBalance.Sign() > 0 {
+		if err := batch.Delete(tokenRankKey(prefix, addr, oldBalance)); err != nil {
+			return err
+		}
+	}
+	if newBalance.Sign() > 0 {
+		if err := batch.Put(tokenRankKey(prefix, addr, newBalance), []byte(addr)); err != nil {
+			return err
+		}
+	}
+
+	key := []byte(prefix + "b/" + addr)
+	if newBalance.Sign() == 0 {
+		return batch.Delete(key)
+	}
+	return batch.Put(key, []byte(newBalance.String()))
+}
+
+func getTokenBalance(batch *stateBatch, prefix, addr string) (*big.Int, error) {
+	val, err := batch.Get([]byte(prefix + "b/" + addr))
+	if err != nil || val == nil {
+		return new(big.Int), err
+	}
+	balance, ok := new(big.Int).SetString(string(val), 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid balance.addr:%s,balance:%s", addr, val)
+	}
+	return balance, nil
+}
+
+func getTokenSummary(get func(key []byte) ([]byte, error), prefix string) (*tokenSummary, error) {
+	summary := &tokenSummary{TotalSupply: "0"}
+	val, err := get([]byte(prefix + "s"))
+	if err != nil || val == nil {
+		return summary, err
+	}
+	if err := json.Unmarshal(val, summary); err != nil {
+		return nil, err
+	}
+	return summary, nil
+}
+
+func tokenKeyPrefix(bcName string) string {
+	return fmt.Sprintf("%s%s/", tokenPrefix, bcName)
+}
+
+func tokenHistoryKey(prefix, addr string, height int64) []byte {
+	return []byte(fmt.Sprintf("%sh/%s/%020d", prefix, addr, height))
+}
+
+// 余额补齐到定长后逐位取反，前缀遍历按余额从大到小排列
+func tokenRankKey(prefix, addr string, balance *big.Int) []byte {
+	digits := []byte(fmt.Sprintf("%0*s", rankDigits, balance.String()))
+	for i := range digits {
+		digits[i] = '9' - digits[i] + '0'
+	}
+	return []byte(prefix + "r/" + string(digits) + "/" + addr)
+}
+
+// 从排行key中还原余额
+func rankBalance(rank string) string {
+	digits := []byte(rank)
+	for i := range digits {
+		digits[i] = '9' - digits[i] + '0'
+	}
+	balance, _ := new(big.Int).SetString(string(digits), 10)
+	return balance.String()
+}
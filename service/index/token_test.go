@@ -0,0 +1,147 @@
+package index
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"strconv"
+	"testing"
+
+	lpb "github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
+	"github.com/xuperchain/xupercore/kernel/common/xconfig"
+	"github.com/xuperchain/xupercore/protos"
+
+	sconf "github.com/xuperchain/xuperos/common/config"
+)
+
+func TestTokenStats(t *testing.T) {
+	dir, err := ioutil.TempDir("", "index")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	scfg := sconf.GetDefServConf()
+	scfg.EnableTokenIndex = true
+	indexer, err := OpenIndexer(scfg, &xconfig.EnvConf{RootPath: dir, DataDir: "data"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer indexer.Close()
+	idx := indexer.indexes[0]
+
+	amount := func(n int64) []byte { return big.NewInt(n).Bytes() }
+	output := func(addr string, n int64) *protos.TxOutput {
+		return &protos.TxOutput{ToAddr: []byte(addr), Amount: amount(n)}
+	}
+	input := func(addr string, n int64) *protos.TxInput {
+		return &protos.TxInput{FromAddr: []byte(addr), Amount: amount(n)}
+	}
+	blocks := []*lpb.InternalBlock{
+		// 创世块给alice 100，bob 50
+		{Height: 0, Transactions: []*lpb.Transaction{
+			{Txid: []byte("t0"), TxOutputs: []*protos.TxOutput{output("alice", 100), output("bob", 50)}},
+		}},
+		// alice转给bob 30冻结到高度5，手续费1归矿工carol
+		{Height: 1, Proposer: []byte("carol"), Transactions: []*lpb.Transaction{
+			{Txid: []byte("t1"), TxInputs: []*protos.TxInput{input("alice", 100)},
+				TxOutputs: []*protos.TxOutput{
+					{ToAddr: []byte("bob"), Amount: amount(30), FrozenHeight: 5},
+					output("$", 1), output("alice", 69),
+				}},
+		}},
+		// bob转给alice 50，carol全部转给dave
+		{Height: 2, Proposer: []byte("carol"), Transactions: []*lpb.Transaction{
+			{Txid: []byte("t2"), TxInputs: []*protos.TxInput{input("bob", 50)},
+				TxOutputs: []*protos.TxOutput{output("alice", 50)}},
+			{Txid: []byte("t3"), TxInputs: []*protos.TxInput{input("carol", 1)},
+				TxOutputs: []*protos.TxOutput{output("dave", 1)}},
+		}},
+	}
+	indexBlocks := func(blocks []*lpb.InternalBlock) {
+		// 同一批次写入多个区块
+		batch := newStateBatch(indexer.db, indexer.db.NewBatch())
+		for _, block := range blocks {
+			block.Blockid = []byte{byte(block.Height)}
+			if err := indexer.indexBlock(batch, idx, "xuper", block); err != nil {
+				t.Fatal(err)
+			}
+		}
+		height := blocks[len(blocks)-1].Height
+		batch.Put(metaKey(idx, "xuper"), []byte(strconv.FormatInt(height, 10)))
+		if err := batch.Write(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	checkStats := func(expect string) {
+		stats, err := indexer.GetTokenStats("xuper", 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		actual := fmt.Sprintf("%d %s %s %d", stats.Height, stats.TotalSupply, stats.FrozenAmount, stats.HolderCount)
+		for _, holder := range stats.TopHolders {
+			actual += fmt.Sprintf(" %s:%s", holder.Address, holder.Balance)
+		}
+		if actual != expect {
+			t.Errorf("expect stats %q, actual %q", expect, actual)
+		}
+	}
+
+	indexBlocks(blocks[:2])
+	checkStats("1 150 30 3 bob:80 alice:69 carol:1")
+	indexBlocks(blocks[2:])
+	checkStats("2 150 30 3 alice:119 bob:30 dave:1")
+
+	// 历史余额取各高度的检查点，手续费计入矿工
+	checkBalanceAt := func(addr string, height int64, expect string) {
+		balance, err := indexer.GetBalanceAt("xuper", addr, height)
+		if err != nil || balance != expect {
+			t.Errorf("%s at %d expect %s, actual %s, err %v", addr, height, expect, balance, err)
+		}
+	}
+	checkBalanceAt("alice", 0, "100")
+	checkBalanceAt("alice", 1, "69")
+	checkBalanceAt("alice", 2, "119")
+	checkBalanceAt("bob", 1, "80")
+	checkBalanceAt("dave", 1, "0")
+	checkBalanceAt("dave", 2, "1")
+	checkBalanceAt("carol", 1, "1")
+	checkBalanceAt("carol", 2, "0")
+	if _, err := indexer.GetBalanceAt("xuper", "alice", 3); err != ErrHeightNotIndexed {
+		t.Errorf("expect height not indexed, actual %v", err)
+	}
+
+	stats, _ := indexer.GetTokenStats("xuper", 1)
+	if len(stats.TopHolders) != 1 || stats.TopHolders[0].Address != "alice" {
+		t.Errorf("unexpected top holders %v", stats.TopHolders)
+	}
+
+	// 回滚最新区块恢复到高度1的状态
+	rec, err := indexer.getBlockRecord(idx, "xuper", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := indexer.undoBlock(idx, "xuper", 2, rec); err != nil {
+		t.Fatal(err)
+	}
+	checkStats("1 150 30 3 bob:80 alice:69 carol:1")
+	if _, err := indexer.GetBalanceAt("xuper", "alice", 2); err != ErrHeightNotIndexed {
+		t.Errorf("expect height not indexed after undo, actual %v", err)
+	}
+	if val, _ := getKV(indexer.db, tokenHistoryKey(tokenKeyPrefix("xuper"), "dave", 2)); val != nil {
+		t.Errorf("balance checkpoint not removed after undo %s", val)
+	}
+	indexBlocks(blocks[2:])
+	checkBalanceAt("alice", 2, "119")
+	checkBalanceAt("alice", 1, "69")
+
+	if err := indexer.Reset("xuper"); err != nil {
+		t.Fatal(err)
+	}
+	checkStats("-1 0 0 0")
+
+	if _, _, err := indexer.GetAddressTxs("xuper", "alice", "", 0); err != ErrIndexDisabled {
+		t.Errorf("expect address index disabled, actual %v", err)
+	}
+}
@@ -2,14 +2,20 @@ package service
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/xuperchain/xupercore/kernel/engines"
+	"github.com/xuperchain/xupercore/kernel/engines/xuperos"
 	"github.com/xuperchain/xupercore/lib/logs"
 
 	sconf "github.com/xuperchain/xuperos/common/config"
 	def "github.com/xuperchain/xuperos/common/def"
+	"github.com/xuperchain/xuperos/common/loglevel"
 	adpgw "github.com/xuperchain/xuperos/service/adapter/gateway"
 	adprpc "github.com/xuperchain/xuperos/service/adapter/rpc"
+	"github.com/xuperchain/xuperos/service/admin"
+	"github.com/xuperchain/xuperos/service/eth"
+	"github.com/xuperchain/xuperos/service/index"
 	"github.com/xuperchain/xuperos/service/rpc"
 )
 
@@ -19,84 +25,179 @@ type ServCom interface {
 	Exit()
 }
 
+// 各组件默认重启策略，对外接口异常退出后退避重启，rpc服务无法恢复时进程退出
+var (
+	criticalPolicy = RestartPolicy{Mode: RestartBackoff, MaxRetry: 5, Critical: true}
+	backoffPolicy  = RestartPolicy{Mode: RestartBackoff, MaxRetry: 5}
+	neverPolicy    = RestartPolicy{Mode: RestartNever}
+)
+
 // 各server组件运行控制
 type ServMG struct {
 	scfg    *sconf.ServConf
 	log     logs.Logger
-	servers []ServCom
+	servers []*servEntry
+
+	exitOnce *sync.Once
+	exitCh   chan struct{}
 }
 
 func NewServMG(scfg *sconf.ServConf, engine engines.BCEngine) (*ServMG, error) {
 	if scfg == nil || engine == nil {
 		return nil, fmt.Errorf("param error")
 	}
+	xosEngine, err := xuperos.EngineConvert(engine)
+	if err != nil {
+		return nil, fmt.Errorf("not xuperos engine")
+	}
 
-	log, _ := logs.NewLogger("", def.SubModName)
+	log, _ := loglevel.NewLogger("", def.SubModName)
 	obj := &ServMG{
-		scfg:    scfg,
-		log:     log,
-		servers: make([]ServCom, 0),
+		scfg:     scfg,
+		log:      log,
+		servers:  make([]*servEntry, 0),
+		exitOnce: &sync.Once{},
+		exitCh:   make(chan struct{}),
+	}
+
+	// 实例化节点管理服务，只监听本机地址
+	// 最先实例化，开启日志模块级别过滤后再创建其他组件的日志实例
+	if scfg.AdminListenAddr != "" || scfg.AdminUnixSocket != "" {
+		adminServ, err := admin.NewAdminServMG(scfg, engine)
+		if err != nil {
+			return nil, err
+		}
+		obj.register("admin", adminServ, backoffPolicy)
+	}
+
+	// 实例化服务层索引，未开启时查询接口返回错误
+	// 索引退出时会关闭存储，不支持重启
+	var indexer *index.Indexer
+	if scfg.EnableAddrIndex || scfg.EnableEventIndex || scfg.EnableTokenIndex {
+		indexer, err = index.NewIndexer(scfg, engine)
+		if err != nil {
+			return nil, err
+		}
+		obj.register("indexer", indexer, neverPolicy)
 	}
 
 	// 实例化rpc服务
-	rpcServ, err := rpc.NewRpcServMG(scfg, engine)
+	rpcServ, err := rpc.NewRpcServMG(scfg, engine, indexer)
 	if err != nil {
 		return nil, err
 	}
-	obj.servers = append(obj.servers, rpcServ)
+	obj.register("rpc", rpcServ, criticalPolicy)
 
 	// 实例化老版本接口适配服务
 	if scfg.EnableAdapter {
-		adpServ, err := adprpc.NewRpcServMG(scfg, engine)
+		adpServ, err := adprpc.NewRpcServMG(scfg, engine, indexer)
+		if err != nil {
+			return nil, err
+		}
+		adpGW, err := adpgw.NewGateway(scfg, engine)
 		if err != nil {
 			return nil, err
 		}
-		adpGW, err := adpgw.NewGateway(scfg)
+
+		obj.register("adapter_rpc", adpServ, criticalPolicy)
+		obj.register("adapter_gateway", adpGW, backoffPolicy)
+	}
+
+	// 实例化以太坊兼容json-rpc服务
+	if scfg.EnableEthRpc {
+		ethServ, err := eth.NewEthServMG(scfg, engine)
 		if err != nil {
 			return nil, err
 		}
+		obj.register("eth", ethServ, backoffPolicy)
+	}
+
+	// 实例化server.yaml中开启的自定义服务组件
+	if err := obj.loadServices(engine); err != nil {
+		return nil, err
+	}
 
-		obj.servers = append(obj.servers, adpServ, adpGW)
+	// 开启监控时提供metrics和组件健康状态
+	if scfg.EnableMetric && xosEngine.Context().EnvCfg.MetricSwitch && scfg.MetricPort > 0 {
+		obj.register("metric", newMetricServ(scfg.MetricPort, obj, log), backoffPolicy)
 	}
 
 	return obj, nil
 }
 
-// 启动rpc服务
+// 添加服务组件，需要在Run之前调用
+func (t *ServMG) register(name string, serv ServCom, policy RestartPolicy) {
+	t.servers = append(t.servers, newServEntry(name, serv, policy))
+}
+
+// 启动rpc服务，关键组件无法恢复时退出所有组件并返回错误
 func (t *ServMG) Run() error {
-	ch := make(chan error, 0)
-	defer close(ch)
+	ch := make(chan error, len(t.servers))
 
-	for _, serv := range t.servers {
+	for _, entry := range t.servers {
 		// 启动各个service
-		go func(s ServCom) {
-			ch <- s.Run()
-		}(serv)
+		go func(e *servEntry) {
+			ch <- t.supervise(e)
+		}(entry)
 	}
 
 	// 监听各个service状态
-	exitCnt := 0
-	for {
-		if exitCnt >= len(t.servers) {
-			break
-		}
-
-		select {
-		case err := <-ch:
-			t.log.Warn("service exit", "err", err)
-			exitCnt++
+	var runErr error
+	for i := 0; i < len(t.servers); i++ {
+		err := <-ch
+		if err != nil && runErr == nil {
+			t.log.Error("service can not recover, exit all services", "err", err)
+			runErr = err
+			t.Exit()
 		}
 	}
 
-	return nil
+	return runErr
 }
 
 // 退出rpc服务，释放相关资源，需要幂等
 func (t *ServMG) Exit() {
-	for _, serv := range t.servers {
+	t.exitOnce.Do(func() {
+		close(t.exitCh)
+	})
+
+	for _, entry := range t.servers {
 		// 触发各service退出
 		go func(s ServCom) {
 			s.Exit()
-		}(serv)
+		}(entry.serv)
+	}
+}
+
+// Status 各服务组件当前状态
+func (t *ServMG) Status() []*ServStatus {
+	result := make([]*ServStatus, 0, len(t.servers))
+	for _, entry := range t.servers {
+		status := entry.getStatus()
+		result = append(result, &status)
+	}
+	return result
+}
+
+// Health 所有关键组件运行中时状态为ok，否则为unhealthy
+func (t *ServMG) Health() *HealthStatus {
+	health := &HealthStatus{
+		Status:   "ok",
+		Services: t.Status(),
+	}
+	for _, status := range health.Services {
+		if status.Critical && status.State != StateRunning {
+			health.Status = "unhealthy"
+		}
+	}
+	return health
+}
+
+func (t *ServMG) isExiting() bool {
+	select {
+	case <-t.exitCh:
+		return true
+	default:
+		return false
 	}
 }
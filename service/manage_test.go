@@ -0,0 +1,153 @@
+package service
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/xuperchain/xupercore/lib/logs"
+)
+
+// 前failCnt次运行返回错误，之后阻塞直到退出
+type fakeServ struct {
+	lock    sync.Mutex
+	failCnt int
+	runCnt  int
+	exitCh  chan struct{}
+}
+
+func newFakeServ(failCnt int) *fakeServ {
+	return &fakeServ{failCnt: failCnt, exitCh: make(chan struct{})}
+}
+
+func (s *fakeServ) Run() error {
+	s.lock.Lock()
+	s.runCnt++
+	fail := s.runCnt <= s.failCnt
+	s.lock.Unlock()
+	if fail {
+		return errors.New("listen failed")
+	}
+	<-s.exitCh
+	return nil
+}
+
+func (s *fakeServ) Exit() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	select {
+	case <-s.exitCh:
+	default:
+		close(s.exitCh)
+	}
+}
+
+func (s *fakeServ) runs() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.runCnt
+}
+
+func newTestServMG(t *testing.T) *ServMG {
+	dir, err := ioutil.TempDir("", "service")
+	if err != nil {
+		t.Fatal(err)
+	}
+	logConfFile := filepath.Join(dir, "log.yaml")
+	ioutil.WriteFile(logConfFile, []byte("level: warn\nconsole: false\nfilename: test\n"), 0644)
+	logs.InitLog(logConfFile, dir)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	log, err := logs.NewLogger("", "service")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &ServMG{
+		log:      log,
+		exitOnce: &sync.Once{},
+		exitCh:   make(chan struct{}),
+	}
+}
+
+func TestServMGRestart(t *testing.T) {
+	restartMinDelay = 10 * time.Millisecond
+	restartMaxDelay = 40 * time.Millisecond
+
+	servMG := newTestServMG(t)
+	recovered := newFakeServ(2)
+	once := newFakeServ(1)
+	servMG.register("recovered", recovered, RestartPolicy{Mode: RestartBackoff, MaxRetry: 3, Critical: true})
+	servMG.register("once", once, RestartPolicy{Mode: RestartNever})
+
+	runCh := make(chan error, 1)
+	go func() {
+		runCh <- servMG.Run()
+	}()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for recovered.runs() < 3 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	time.Sleep(50 * time.Millisecond)
+
+	health := servMG.Health()
+	if health.Status != "ok" {
+		t.Fatalf("health status error.status:%s", health.Status)
+	}
+	status := health.Services
+	if status[0].State != StateRunning || status[0].Restarts != 2 {
+		t.Fatalf("recovered service status error.status:%+v", status[0])
+	}
+	if status[1].State != StateFailed || once.runs() != 1 {
+		t.Fatalf("never restart service status error.status:%+v,runs:%d", status[1], once.runs())
+	}
+
+	servMG.Exit()
+	select {
+	case err := <-runCh:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("wait servMG exit timeout")
+	}
+	if status := servMG.Status(); status[0].State != StateStopped {
+		t.Fatalf("service not stopped.status:%+v", status[0])
+	}
+}
+
+func TestServMGCriticalFailed(t *testing.T) {
+	restartMinDelay = 10 * time.Millisecond
+	restartMaxDelay = 40 * time.Millisecond
+
+	servMG := newTestServMG(t)
+	broken := newFakeServ(100)
+	other := newFakeServ(0)
+	servMG.register("broken", broken, RestartPolicy{Mode: RestartBackoff, MaxRetry: 2, Critical: true})
+	servMG.register("other", other, RestartPolicy{Mode: RestartAlways})
+
+	runCh := make(chan error, 1)
+	go func() {
+		runCh <- servMG.Run()
+	}()
+
+	// 关键组件无法恢复时，其余组件退出并返回错误
+	select {
+	case err := <-runCh:
+		if err == nil {
+			t.Fatal("critical service failed but run return nil")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("wait servMG exit timeout")
+	}
+	if broken.runs() != 3 {
+		t.Fatalf("restart times error.runs:%d", broken.runs())
+	}
+	if health := servMG.Health(); health.Status == "ok" || health.Services[0].State != StateFailed {
+		t.Fatalf("health status error.health:%+v", health.Services[0])
+	}
+}
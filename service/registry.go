@@ -0,0 +1,107 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/xuperchain/xupercore/kernel/engines"
+
+	sconf "github.com/xuperchain/xuperos/common/config"
+)
+
+// 创建自定义服务组件实例方法，组件配置通过scfg.GetService获取
+type NewServFunc func(scfg *sconf.ServConf, engine engines.BCEngine) (ServCom, error)
+
+// 内置服务组件名称，自定义组件不能使用
+var builtinServs = map[string]bool{
+	"indexer": true, "rpc": true, "adapter_rpc": true, "adapter_gateway": true,
+	"eth": true, "admin": true, "metric": true,
+}
+
+var (
+	servMu    sync.RWMutex
+	servFuncs = make(map[string]NewServFunc)
+)
+
+// Register 注册自定义服务组件，在组件包的init中调用，由startup通过import开启
+// 注册后还需要在server.yaml的services中按名称配置才会启动
+func Register(name string, f NewServFunc) {
+	servMu.Lock()
+	defer servMu.Unlock()
+
+	if f == nil {
+		panic("service: Register new func is nil")
+	}
+	if builtinServs[name] {
+		panic("service: Register builtin service name " + name)
+	}
+	if _, dup := servFuncs[name]; dup {
+		panic("service: Register called twice for func " + name)
+	}
+	servFuncs[name] = f
+}
+
+// Services 已注册的自定义服务组件
+func Services() []string {
+	servMu.RLock()
+	defer servMu.RUnlock()
+	list := make([]string, 0, len(servFuncs))
+	for name := range servFuncs {
+		list = append(list, name)
+	}
+	sort.Strings(list)
+	return list
+}
+
+func getServFunc(name string) NewServFunc {
+	servMu.RLock()
+	defer servMu.RUnlock()
+	return servFuncs[name]
+}
+
+// 实例化server.yaml中开启的自定义服务组件
+func (t *ServMG) loadServices(engine engines.BCEngine) error {
+	loaded := make(map[string]bool, len(t.scfg.Services))
+	for _, conf := range t.scfg.Services {
+		if loaded[conf.Name] {
+			return fmt.Errorf("service config duplicate.name:%s", conf.Name)
+		}
+		loaded[conf.Name] = true
+
+		f := getServFunc(conf.Name)
+		if f == nil {
+			return fmt.Errorf("service not registered.name:%s,registered:%v", conf.Name, Services())
+		}
+		policy, err := parseRestartPolicy(conf)
+		if err != nil {
+			return err
+		}
+		serv, err := f(t.scfg, engine)
+		if err != nil {
+			return fmt.Errorf("create service failed.name:%s,err:%v", conf.Name, err)
+		}
+		t.register(conf.Name, serv, policy)
+		t.log.Trace("load service", "name", conf.Name, "restart", policy.Mode, "critical", policy.Critical)
+	}
+	return nil
+}
+
+func parseRestartPolicy(conf sconf.ServiceConf) (RestartPolicy, error) {
+	policy := RestartPolicy{
+		Mode:     RestartMode(conf.Restart),
+		MaxRetry: conf.MaxRetry,
+		Critical: conf.Critical,
+	}
+	switch policy.Mode {
+	case "":
+		policy.Mode = RestartBackoff
+	case RestartNever, RestartAlways, RestartBackoff:
+	default:
+		return policy, fmt.Errorf("service restart mode error.name:%s,restart:%s", conf.Name, conf.Restart)
+	}
+	if policy.Mode == RestartBackoff && policy.MaxRetry <= 0 {
+		policy.MaxRetry = backoffPolicy.MaxRetry
+	}
+	return policy, nil
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/xuperchain/xupercore/kernel/engines"
+
+	sconf "github.com/xuperchain/xuperos/common/config"
+)
+
+func TestRegister(t *testing.T) {
+	var gotConf *sconf.ServiceConf
+	Register("test_compliance", func(scfg *sconf.ServConf, engine engines.BCEngine) (ServCom, error) {
+		gotConf = scfg.GetService("test_compliance")
+		return newFakeServ(0), nil
+	})
+
+	// 内置组件名称和重复注册会panic
+	for _, name := range []string{"rpc", "test_compliance"} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("register %s should panic", name)
+				}
+			}()
+			Register(name, func(*sconf.ServConf, engines.BCEngine) (ServCom, error) { return nil, nil })
+		}()
+	}
+
+	servMG := newTestServMG(t)
+	servMG.scfg = &sconf.ServConf{
+		Services: []sconf.ServiceConf{
+			{Name: "test_compliance", Critical: true, Params: map[string]string{"key": "value"}},
+		},
+	}
+	if err := servMG.loadServices(nil); err != nil {
+		t.Fatal(err)
+	}
+	if gotConf == nil || gotConf.Params["key"] != "value" {
+		t.Fatalf("service conf error.conf:%+v", gotConf)
+	}
+	entry := servMG.servers[0]
+	if entry.name != "test_compliance" || entry.policy.Mode != RestartBackoff ||
+		entry.policy.MaxRetry != backoffPolicy.MaxRetry || !entry.policy.Critical {
+		t.Fatalf("service policy error.policy:%+v", entry.policy)
+	}
+
+	// 未注册、重复配置和重启方式错误
+	for _, services := range [][]sconf.ServiceConf{
+		{{Name: "notexist"}},
+		{{Name: "test_compliance"}, {Name: "test_compliance"}},
+		{{Name: "test_compliance", Restart: "sometimes"}},
+	} {
+		servMG := newTestServMG(t)
+		servMG.scfg = &sconf.ServConf{Services: services}
+		if err := servMG.loadServices(nil); err == nil {
+			t.Errorf("load services should fail.services:%+v", services)
+		}
+	}
+}
@@ -3,8 +3,18 @@ package rpc
 import (
 	"context"
 
-	sctx "github.com/xuperchain/xupercore/example/xchain/common/context"
+	"github.com/golang/protobuf/proto"
+	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+	"github.com/xuperchain/xupercore/lib/utils"
+
+	sctx "github.com/xuperchain/xuperos/common/context"
 	pb "github.com/xuperchain/xuperos/common/xupospb"
+	"github.com/xuperchain/xuperos/models"
+)
+
+const (
+	// 单次流式查询的最大区块数
+	MaxBlockRange = 10000
 )
 
 // 注意：
@@ -23,3 +33,116 @@ func (t *RpcServ) CheckAlive(gctx context.Context, req *pb.BaseReq) (*pb.BaseRes
 	rctx.GetLog().Debug("check alive succ")
 	return resp, nil
 }
+
+// 分页查询地址相关交易
+func (t *RpcServ) GetAddressTxs(gctx context.Context, req *pb.AddressTxsReq) (*pb.AddressTxsResp, error) {
+	// 默认响应
+	resp := &pb.AddressTxsResp{}
+	// 获取请求上下文，对内传递rctx
+	rctx := sctx.ValueReqCtx(gctx)
+
+	if req == nil || req.GetBcName() == "" || req.GetAddress() == "" {
+		rctx.GetLog().Warn("param error,some param unset")
+		return resp, ecom.ErrParameter
+	}
+
+	txs, nextCursor, err := t.indexer.GetAddressTxs(req.GetBcName(), req.GetAddress(),
+		req.GetCursor(), int(req.GetLimit()))
+	if err != nil {
+		rctx.GetLog().Warn("get address txs failed", "err", err)
+		return resp, err
+	}
+
+	resp.Txs = make([]*pb.AddressTx, 0, len(txs))
+	for _, tx := range txs {
+		resp.Txs = append(resp.Txs, &pb.AddressTx{
+			Height:    tx.Height,
+			Txid:      tx.Txid,
+			Direction: pb.TxDirection(tx.Direction),
+			Amount:    tx.Amount,
+			Timestamp: tx.Timestamp,
+		})
+	}
+	resp.NextCursor = nextCursor
+
+	rctx.GetLog().SetInfoField("bc_name", req.GetBcName())
+	rctx.GetLog().SetInfoField("address", req.GetAddress())
+	return resp, nil
+}
+
+// 按高度顺序流式返回区块，Send在客户端接收缓慢时阻塞，逐块读取不会堆积
+func (t *RpcServ) GetBlockRange(req *pb.BlockRangeReq, stream pb.XuperOS_GetBlockRangeServer) error {
+	// 获取请求上下文，对内传递rctx
+	rctx := sctx.ValueReqCtx(stream.Context())
+	// 流式接口出错时通过最后一条响应的header返回错误
+	sendErr := func(err error) error {
+		header := t.genRespHeader(rctx.GetLog().GetLogId(), ecom.CastError(err))
+		stream.Send(&pb.BlockRangeResp{Header: header})
+		return err
+	}
+
+	if req == nil || req.GetBcName() == "" || req.GetFrom() < 0 || req.GetTo() < req.GetFrom() {
+		rctx.GetLog().Warn("param error,some param unset or invalid range")
+		return sendErr(ecom.ErrParameter)
+	}
+	if req.GetTo()-req.GetFrom() >= MaxBlockRange {
+		rctx.GetLog().Warn("param error,block range too large", "from", req.GetFrom(), "to", req.GetTo())
+		return sendErr(ecom.ErrParameter.More("block range too large, max %d", MaxBlockRange))
+	}
+
+	handle, err := models.NewChainHandle(req.GetBcName(), rctx)
+	if err != nil {
+		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
+		return sendErr(err)
+	}
+	defer handle.Release()
+
+	rctx.GetLog().SetInfoField("bc_name", req.GetBcName())
+	rctx.GetLog().SetInfoField("from", req.GetFrom())
+	for height := req.GetFrom(); height <= req.GetTo(); height++ {
+		// 客户端断开后停止读取
+		if err := stream.Context().Err(); err != nil {
+			return err
+		}
+
+		// 区块内容用于获取blockid，按需裁剪后返回
+		blockInfo, err := handle.QueryBlockByHeight(height, true)
+		if err != nil {
+			rctx.GetLog().Warn("query block error", "bc", req.GetBcName(), "height", height)
+			return sendErr(err)
+		}
+		// 超过主干高度，结束
+		if blockInfo.GetBlock() == nil {
+			break
+		}
+
+		resp := &pb.BlockRangeResp{
+			Header:  t.genRespHeader(rctx.GetLog().GetLogId(), ecom.ErrSuccess),
+			Height:  height,
+			Blockid: blockInfo.Block.GetBlockid(),
+			Status:  pb.BlockStatus(blockInfo.GetStatus()),
+		}
+		if req.GetNeedContent() {
+			block := blockInfo.Block
+			if req.GetExcludeTx() {
+				// 账本可能缓存区块对象，裁剪时复制一份
+				header := *block
+				header.Transactions = nil
+				block = &header
+			}
+			resp.Block, err = proto.Marshal(block)
+			if err != nil {
+				rctx.GetLog().Warn("marshal block failed", "height", height, "err", err)
+				return sendErr(ecom.ErrInternal)
+			}
+		}
+		if err := stream.Send(resp); err != nil {
+			rctx.GetLog().Warn("send block failed", "height", height,
+				"blockid", utils.F(resp.Blockid), "err", err)
+			return err
+		}
+		rctx.GetLog().SetInfoField("to", height)
+	}
+
+	return nil
+}
@@ -4,11 +4,15 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"path/filepath"
 	"sync"
 
 	sconf "github.com/xuperchain/xuperos/common/config"
 	"github.com/xuperchain/xuperos/common/def"
+	"github.com/xuperchain/xuperos/common/loglevel"
 	pb "github.com/xuperchain/xuperos/common/xupospb"
+	scom "github.com/xuperchain/xuperos/service/common"
+	"github.com/xuperchain/xuperos/service/index"
 
 	"github.com/xuperchain/xupercore/kernel/engines"
 	"github.com/xuperchain/xupercore/kernel/engines/xuperos"
@@ -17,22 +21,26 @@ import (
 
 	middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/reflection"
 )
 
 // rpc server启停控制管理
 type RpcServMG struct {
-	scfg      *sconf.ServConf
-	engine    ecom.Engine
-	log       logs.Logger
-	rpcServ   *RpcServ
-	servHD    *grpc.Server
-	tlsServHD *grpc.Server
-	isInit    bool
-	exitOnce  *sync.Once
+	scfg     *sconf.ServConf
+	engine   ecom.Engine
+	log      logs.Logger
+	rpcServ  *RpcServ
+	servHDs  []*grpc.Server
+	lises    []net.Listener
+	lock     sync.Mutex
+	isExit   bool
+	isInit   bool
+	exitOnce *sync.Once
 }
 
-func NewRpcServMG(scfg *sconf.ServConf, engine engines.BCEngine) (*RpcServMG, error) {
+func NewRpcServMG(scfg *sconf.ServConf, engine engines.BCEngine,
+	indexer *index.Indexer) (*RpcServMG, error) {
 	if scfg == nil || engine == nil {
 		return nil, fmt.Errorf("param error")
 	}
@@ -41,12 +49,12 @@ func NewRpcServMG(scfg *sconf.ServConf, engine engines.BCEngine) (*RpcServMG, er
 		return nil, fmt.Errorf("not xuperos engine")
 	}
 
-	log, _ := logs.NewLogger("", def.SubModName)
+	log, _ := loglevel.NewLogger("", def.SubModName)
 	obj := &RpcServMG{
 		scfg:     scfg,
 		engine:   xosEngine,
 		log:      log,
-		rpcServ:  NewRpcServ(engine.(ecom.Engine), log),
+		rpcServ:  NewRpcServ(engine.(ecom.Engine), indexer, log),
 		isInit:   true,
 		exitOnce: &sync.Once{},
 	}
@@ -82,43 +90,147 @@ func (t *RpcServMG) Exit() {
 	})
 }
 
-// 启动rpc服务，阻塞直到退出
+// 启动rpc服务，每个监听配置对应一个grpc server，阻塞直到退出
 func (t *RpcServMG) runRpcServ() error {
-	rpcOptions := make([]grpc.ServerOption, 0)
-	unaryInterceptors := make([]grpc.UnaryServerInterceptor, 0)
-	unaryInterceptors = append(unaryInterceptors, t.rpcServ.UnaryInterceptor())
-	rpcOptions = append(rpcOptions,
+	listeners := make([]net.Listener, 0, len(t.scfg.RpcListeners)+1)
+	servHDs := make([]*grpc.Server, 0, len(t.scfg.RpcListeners)+1)
+	closeListeners := func() {
+		for _, lis := range listeners {
+			lis.Close()
+		}
+	}
+
+	for _, lc := range t.scfg.RpcListeners {
+		servHD, err := t.newServHD(lc.Tls, lc.AllowMethods)
+		if err != nil {
+			closeListeners()
+			t.log.Error("failed to create grpc server", "addr", lc.Addr(), "err", err)
+			return err
+		}
+		lis, err := net.Listen("tcp", lc.Addr())
+		if err != nil {
+			closeListeners()
+			t.log.Error("failed to listen", "addr", lc.Addr(), "err", err.Error())
+			return fmt.Errorf("failed to listen")
+		}
+		listeners = append(listeners, lis)
+		servHDs = append(servHDs, servHD)
+		t.log.Trace("rpc server listen", "addr", lc.Addr(), "isTls", lc.Tls)
+	}
+
+	// 按配置监听unix socket，供本机工具访问
+	if t.scfg.RpcUnixSocket != "" {
+		sockPath := t.scfg.RpcUnixSocket
+		if !filepath.IsAbs(sockPath) {
+			sockPath = t.engine.Context().EnvCfg.GenDirAbsPath(sockPath)
+		}
+		servHD, _ := t.newServHD(false, nil)
+		lis, err := scom.ListenUnix(sockPath, t.scfg.UnixSocketPerm)
+		if err != nil {
+			closeListeners()
+			t.log.Error("failed to listen unix socket", "path", sockPath, "err", err)
+			return fmt.Errorf("failed to listen unix socket")
+		}
+		listeners = append(listeners, lis)
+		servHDs = append(servHDs, servHD)
+		t.log.Trace("rpc server listen unix socket", "path", sockPath)
+	}
+
+	if len(listeners) == 0 {
+		return fmt.Errorf("no rpc listener configured")
+	}
+	if !t.setServHDs(servHDs, listeners) {
+		closeListeners()
+		return nil
+	}
+
+	serves := make([]func() error, 0, len(listeners))
+	for i := range listeners {
+		lis, servHD := listeners[i], servHDs[i]
+		serves = append(serves, func() error {
+			err := servHD.Serve(lis)
+			if err != nil && err != grpc.ErrServerStopped {
+				t.log.Error("failed to serve", "addr", lis.Addr().String(), "err", err.Error())
+				return err
+			}
+			return nil
+		})
+	}
+	if err := scom.ServeAll(serves, t.closeServHDs); err != nil {
+		return err
+	}
+
+	t.log.Trace("rpc server exit")
+	return nil
+}
+
+func (t *RpcServMG) newServHD(isTls bool, allowMethods []string) (*grpc.Server, error) {
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		scom.AllowMethodsInterceptor(allowMethods),
+		scom.UnixSocketOnlyInterceptor(t.scfg.UnixSocketOnlyMethods),
+		t.rpcServ.UnaryInterceptor(),
+	}
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		scom.AllowMethodsStreamInterceptor(allowMethods),
+		scom.UnixSocketOnlyStreamInterceptor(t.scfg.UnixSocketOnlyMethods),
+		t.rpcServ.StreamInterceptor(),
+	}
+	rpcOptions := []grpc.ServerOption{
 		middleware.WithUnaryServerChain(unaryInterceptors...),
+		middleware.WithStreamServerChain(streamInterceptors...),
 		grpc.MaxMsgSize(t.scfg.MaxMsgSize),
 		grpc.ReadBufferSize(t.scfg.ReadBufSize),
 		grpc.InitialWindowSize(t.scfg.InitWindowSize),
 		grpc.InitialConnWindowSize(t.scfg.InitConnWindowSize),
 		grpc.WriteBufferSize(t.scfg.WriteBufSize),
-	)
+	}
+	if isTls {
+		tlsConf, err := scom.NewTlsConfig(t.engine.Context().EnvCfg, t.scfg.TlsServerName)
+		if err != nil {
+			return nil, err
+		}
+		rpcOptions = append(rpcOptions, grpc.Creds(credentials.NewTLS(tlsConf)))
+	}
 
-	t.servHD = grpc.NewServer(rpcOptions...)
-	pb.RegisterXuperOSServer(t.servHD, t.rpcServ)
+	servHD := grpc.NewServer(rpcOptions...)
+	pb.RegisterXuperOSServer(servHD, t.rpcServ)
+	reflection.Register(servHD)
+	return servHD, nil
+}
 
-	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", t.scfg.RpcPort))
-	if err != nil {
-		t.log.Error("failed to listen", "err", err.Error())
-		return fmt.Errorf("failed to listen")
-	}
+// 记录运行中的grpc server和监听，已退出时返回false
+func (t *RpcServMG) setServHDs(servHDs []*grpc.Server, lises []net.Listener) bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
 
-	reflection.Register(t.servHD)
-	if err := t.servHD.Serve(lis); err != nil {
-		t.log.Error("failed to serve", "err", err.Error())
-		return err
+	if t.isExit {
+		return false
 	}
+	t.servHDs = servHDs
+	t.lises = lises
+	return true
+}
 
-	t.log.Trace("rpc server exit")
-	return nil
+// 监听异常退出时关闭本次运行的grpc server，不标记退出，重启时可以重新Run
+func (t *RpcServMG) closeServHDs() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	for _, servHD := range t.servHDs {
+		servHD.Stop()
+	}
+	t.servHDs = nil
+	t.lises = nil
 }
 
 // 需要幂等
 func (t *RpcServMG) stopRpcServ() {
-	if t.servHD != nil {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.isExit = true
+	for _, servHD := range t.servHDs {
 		// 优雅关闭grpc server
-		t.servHD.GracefulStop()
+		servHD.GracefulStop()
 	}
 }
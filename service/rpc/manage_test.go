@@ -0,0 +1,106 @@
+package rpc
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/xuperchain/xupercore/kernel/engines/xuperos"
+	"github.com/xuperchain/xupercore/lib/logs"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sconf "github.com/xuperchain/xuperos/common/config"
+)
+
+func TestRpcServMGRestart(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rpc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	logConfFile := filepath.Join(dir, "log.yaml")
+	ioutil.WriteFile(logConfFile, []byte("level: warn\nconsole: false\nfilename: test\n"), 0644)
+	logs.InitLog(logConfFile, dir)
+
+	// 获取空闲端口
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := lis.Addr().(*net.TCPAddr)
+	lis.Close()
+
+	scfg := sconf.GetDefServConf()
+	scfg.RpcListeners = []sconf.ListenConf{{Address: "127.0.0.1", Port: addr.Port}}
+	servMG, err := NewRpcServMG(scfg, xuperos.NewEngine(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	runCh := make(chan error, 1)
+	go func() { runCh <- servMG.Run() }()
+	checkServing(t, addr.String())
+
+	// 监听异常关闭后Run返回错误
+	servMG.lock.Lock()
+	servMG.lises[0].Close()
+	servMG.lock.Unlock()
+	select {
+	case err := <-runCh:
+		if err == nil {
+			t.Fatal("listener closed but run return nil")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("wait rpc server exit timeout")
+	}
+
+	// 重新Run可以继续提供服务
+	go func() { runCh <- servMG.Run() }()
+	checkServing(t, addr.String())
+
+	servMG.Exit()
+	select {
+	case err := <-runCh:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("wait rpc server exit timeout")
+	}
+	if err := servMG.Run(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// 调用不存在的方法，返回Unimplemented说明grpc server在服务
+func checkServing(t *testing.T, addr string) {
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		conn, err := grpc.DialContext(ctx, addr, grpc.WithInsecure(), grpc.WithBlock())
+		if err == nil {
+			err = conn.Invoke(ctx, "/xupospb.XuperOS/NotExist", &emptyMsg{}, &emptyMsg{})
+			conn.Close()
+		}
+		cancel()
+		if status.Code(err) == codes.Unimplemented {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("rpc server not serving.addr:%s,err:%v", addr, err)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
+type emptyMsg struct{}
+
+func (m *emptyMsg) Reset()         {}
+func (m *emptyMsg) String() string { return "" }
+func (m *emptyMsg) ProtoMessage()  {}
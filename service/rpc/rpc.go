@@ -8,25 +8,31 @@ import (
 	"strings"
 
 	pb "github.com/xuperchain/xuperos/common/xupospb"
+	"github.com/xuperchain/xuperos/service/index"
 
 	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
 	"github.com/xuperchain/xupercore/lib/logs"
 	"github.com/xuperchain/xupercore/lib/utils"
 	sctx "github.com/xuperchain/xuperos/common/context"
 
+	middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
 )
 
 type RpcServ struct {
-	engine ecom.Engine
-	log    logs.Logger
+	engine  ecom.Engine
+	indexer *index.Indexer
+	log     logs.Logger
 }
 
-func NewRpcServ(engine ecom.Engine, log logs.Logger) *RpcServ {
+func NewRpcServ(engine ecom.Engine, indexer *index.Indexer, log logs.Logger) *RpcServ {
 	return &RpcServ{
-		engine: engine,
-		log:    log,
+		engine:  engine,
+		indexer: indexer,
+		log:     log,
 	}
 }
 
@@ -75,12 +81,7 @@ func (t *RpcServ) UnaryInterceptor() grpc.UnaryServerInterceptor {
 			stdErr = ecom.CastError(err)
 		}
 		// 根据错误统一设置header，对外统一响应err=nil，通过Header.ErrCode判断
-		respHeader := &pb.RespHeader{
-			LogId:   reqHeader.GetLogId(),
-			ErrCode: int64(stdErr.Code),
-			ErrMsg:  stdErr.Msg,
-			TraceId: t.genTraceId(),
-		}
+		respHeader := t.genRespHeader(reqHeader.GetLogId(), stdErr)
 		// 通过反射设置header到response
 		header := reflect.ValueOf(respRes).Elem().FieldByName("Header")
 		if header.IsValid() && header.IsNil() && header.CanSet() {
@@ -97,6 +98,57 @@ func (t *RpcServ) UnaryInterceptor() grpc.UnaryServerInterceptor {
 	}
 }
 
+// StreamInterceptor provides a hook to intercept the execution of a streaming RPC on the server.
+// 流式接口的错误同时通过最后一条响应的header和接口返回值返回，panic响应为Internal错误
+func (t *RpcServ) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler) (err error) {
+
+		// panic recover
+		defer func() {
+			if e := recover(); e != nil {
+				t.log.Error("Rpc server happen panic.", "error", e, "rpc_method", info.FullMethod)
+				err = status.Error(codes.Internal, fmt.Sprintf("rpc server happen panic: %v", e))
+			}
+		}()
+
+		// 请求消息在接口内读取，这里生成新的logid
+		reqCtx, err := t.createReqCtx(ss.Context(), t.defReqHeader())
+		if err != nil {
+			return err
+		}
+		stream := middleware.WrapServerStream(ss)
+		stream.WrappedContext = sctx.WithReqCtx(ss.Context(), reqCtx)
+
+		// output access log
+		logFields := make([]interface{}, 0)
+		logFields = append(logFields, "client_ip", reqCtx.GetClientIp(), "rpc_method", info.FullMethod)
+		reqCtx.GetLog().Trace("access stream request", logFields...)
+
+		stdErr := ecom.ErrSuccess
+		err = handler(srv, stream)
+		if err != nil {
+			stdErr = ecom.CastError(err)
+		}
+
+		// output ending log
+		logFields = append(logFields, "status", stdErr.Status, "err_code", stdErr.Code,
+			"err_msg", stdErr.Msg, "cost_time", reqCtx.GetTimer().Print())
+		reqCtx.GetLog().Info("stream request done", logFields...)
+
+		return err
+	}
+}
+
+func (t *RpcServ) genRespHeader(logId string, stdErr *ecom.Error) *pb.RespHeader {
+	return &pb.RespHeader{
+		LogId:   logId,
+		ErrCode: int64(stdErr.Code),
+		ErrMsg:  stdErr.Msg,
+		TraceId: t.genTraceId(),
+	}
+}
+
 func (t *RpcServ) defReqHeader() *pb.ReqHeader {
 	return &pb.ReqHeader{
 		LogId:    utils.GenLogId(),
@@ -131,6 +183,10 @@ func (t *RpcServ) getClietIP(gctx context.Context) (string, error) {
 	if pr.Addr == nil || pr.Addr == net.Addr(nil) {
 		return "", fmt.Errorf("get client_ip failed because peer.Addr is nil")
 	}
+	// unix socket对端地址为空，统一记为unix
+	if pr.Addr.Network() == "unix" {
+		return "unix", nil
+	}
 
 	addrSlice := strings.Split(pr.Addr.String(), ":")
 	return addrSlice[0], nil
@@ -0,0 +1,74 @@
+package rpc
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/xuperchain/xupercore/kernel/engines/xuperos"
+	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+	"github.com/xuperchain/xupercore/lib/logs"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *testServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestStreamInterceptor(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rpc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	logConfFile := filepath.Join(dir, "log.yaml")
+	ioutil.WriteFile(logConfFile, []byte("level: warn\nconsole: false\nfilename: test\n"), 0644)
+	logs.InitLog(logConfFile, dir)
+	log, err := logs.NewLogger("", "rpc_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	engine, err := xuperos.EngineConvert(xuperos.NewEngine())
+	if err != nil {
+		t.Fatal(err)
+	}
+	interceptor := NewRpcServ(engine, nil, log).StreamInterceptor()
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1)}})
+	stream := &testServerStream{ctx: ctx}
+	info := &grpc.StreamServerInfo{FullMethod: "/xupospb.XuperOS/GetBlockRange"}
+
+	// 接口返回的错误透传给客户端
+	err = interceptor(nil, stream, info, func(srv interface{}, ss grpc.ServerStream) error {
+		return ecom.ErrParameter
+	})
+	if err != ecom.ErrParameter {
+		t.Fatalf("stream interceptor should return handler error.err:%v", err)
+	}
+
+	err = interceptor(nil, stream, info, func(srv interface{}, ss grpc.ServerStream) error {
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// panic转换为Internal错误
+	err = interceptor(nil, stream, info, func(srv interface{}, ss grpc.ServerStream) error {
+		panic("test panic")
+	})
+	if status.Code(err) != codes.Internal {
+		t.Fatalf("stream interceptor should return internal error after panic.err:%v", err)
+	}
+}
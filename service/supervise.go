@@ -0,0 +1,198 @@
+package service
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	prom "github.com/prometheus/client_golang/prometheus"
+)
+
+// RestartMode 服务组件退出后的重启方式
+type RestartMode string
+
+const (
+	// RestartNever 不重启
+	RestartNever RestartMode = "never"
+	// RestartAlways 每次退出都间隔固定时间重启
+	RestartAlways RestartMode = "always"
+	// RestartBackoff 指数退避重启，连续重启超过MaxRetry次后放弃
+	RestartBackoff RestartMode = "backoff"
+)
+
+// RestartPolicy 服务组件重启策略
+type RestartPolicy struct {
+	Mode     RestartMode
+	MaxRetry int
+	// Critical 组件无法恢复时退出进程
+	Critical bool
+}
+
+// 重启间隔，backoff方式每次翻倍直到最大值
+// 组件稳定运行超过stableDuration后重新计算重启次数
+var (
+	restartMinDelay = time.Second
+	restartMaxDelay = 30 * time.Second
+	stableDuration  = time.Minute
+)
+
+// nextDelay 返回第retry次重启前的等待时间，不再重启时返回false
+func (p RestartPolicy) nextDelay(retry int) (time.Duration, bool) {
+	switch p.Mode {
+	case RestartAlways:
+		return restartMinDelay, true
+	case RestartBackoff:
+		if retry >= p.MaxRetry {
+			return 0, false
+		}
+		delay := restartMinDelay
+		for i := 0; i < retry && delay < restartMaxDelay; i++ {
+			delay *= 2
+		}
+		if delay > restartMaxDelay {
+			delay = restartMaxDelay
+		}
+		return delay, true
+	default:
+		return 0, false
+	}
+}
+
+// ServState 服务组件运行状态
+type ServState string
+
+const (
+	StateRunning    ServState = "running"
+	StateRestarting ServState = "restarting"
+	StateStopped    ServState = "stopped"
+	StateFailed     ServState = "failed"
+)
+
+// ServStatus 服务组件状态快照
+type ServStatus struct {
+	Name      string    `json:"name"`
+	State     ServState `json:"state"`
+	Critical  bool      `json:"critical"`
+	Restarts  int       `json:"restarts"`
+	LastError string    `json:"lastError,omitempty"`
+	Since     time.Time `json:"since"`
+}
+
+var (
+	servUpGauge = prom.NewGaugeVec(
+		prom.GaugeOpts{
+			Name: "xuperos_service_up",
+			Help: "service component running state, 1 running 0 not running",
+		},
+		[]string{"service"})
+	servRestartCounter = prom.NewCounterVec(
+		prom.CounterOpts{
+			Name: "xuperos_service_restarts_total",
+			Help: "service component restart times",
+		},
+		[]string{"service"})
+)
+
+func init() {
+	prom.MustRegister(servUpGauge, servRestartCounter)
+}
+
+// 注册到ServMG的服务组件
+type servEntry struct {
+	name   string
+	serv   ServCom
+	policy RestartPolicy
+
+	lock   sync.Mutex
+	status ServStatus
+}
+
+func newServEntry(name string, serv ServCom, policy RestartPolicy) *servEntry {
+	return &servEntry{
+		name:   name,
+		serv:   serv,
+		policy: policy,
+		status: ServStatus{
+			Name:     name,
+			State:    StateStopped,
+			Critical: policy.Critical,
+			Since:    time.Now(),
+		},
+	}
+}
+
+func (e *servEntry) setState(state ServState, err error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if state == StateRestarting {
+		e.status.Restarts++
+		servRestartCounter.WithLabelValues(e.name).Inc()
+	}
+	if err != nil {
+		e.status.LastError = err.Error()
+	}
+	e.status.State = state
+	e.status.Since = time.Now()
+
+	up := 0.0
+	if state == StateRunning {
+		up = 1
+	}
+	servUpGauge.WithLabelValues(e.name).Set(up)
+}
+
+func (e *servEntry) getStatus() ServStatus {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	return e.status
+}
+
+// supervise 运行服务组件，按重启策略处理退出，关键组件无法恢复时返回错误
+func (t *ServMG) supervise(e *servEntry) error {
+	retry := 0
+	for !t.isExiting() {
+		e.setState(StateRunning, nil)
+		start := time.Now()
+		err := e.serv.Run()
+		if t.isExiting() {
+			e.setState(StateStopped, err)
+			return nil
+		}
+		t.log.Warn("service exit", "name", e.name, "err", err)
+
+		if time.Since(start) >= stableDuration {
+			retry = 0
+		}
+		delay, ok := e.policy.nextDelay(retry)
+		if !ok {
+			if err == nil && e.policy.Mode == RestartNever {
+				e.setState(StateStopped, nil)
+				return nil
+			}
+			if err == nil {
+				err = fmt.Errorf("exit unexpectedly")
+			}
+			e.setState(StateFailed, err)
+			if e.policy.Critical {
+				return fmt.Errorf("critical service can not recover.name:%s,restarts:%d,err:%v",
+					e.name, retry, err)
+			}
+			t.log.Error("service can not recover", "name", e.name, "restarts", retry, "err", err)
+			return nil
+		}
+
+		retry++
+		e.setState(StateRestarting, err)
+		t.log.Warn("restart service", "name", e.name, "retry", retry, "delay", delay)
+		select {
+		case <-time.After(delay):
+		case <-t.exitCh:
+			e.setState(StateStopped, nil)
+			return nil
+		}
+	}
+
+	e.setState(StateStopped, nil)
+	return nil
+}